@@ -33,8 +33,12 @@ type Node interface {
 
 func (*Program) node() {}
 
+func (*PackageClause) node()     {}
+func (*ImportDeclaration) node() {}
+
 func (*BlockStatement) node()      {}
 func (*ExpressionStatement) node() {}
+func (*OptionStatement) node()     {}
 func (*ReturnStatement) node()     {}
 func (*VariableDeclaration) node() {}
 func (*VariableDeclarator) node()  {}
@@ -48,8 +52,12 @@ func (*LogicalExpression) node()       {}
 func (*MemberExpression) node()        {}
 func (*PipeExpression) node()          {}
 func (*ObjectExpression) node()        {}
+func (*StringExpression) node()        {}
 func (*UnaryExpression) node()         {}
 
+func (*TextPart) node()         {}
+func (*InterpolatedPart) node() {}
+
 func (*Property) node()   {}
 func (*Identifier) node() {}
 
@@ -69,12 +77,19 @@ type BaseNode struct {
 }
 
 // Location is the source location of the Node
-func (b *BaseNode) Location() *SourceLocation { return b.Loc }
+func (b *BaseNode) Location() *SourceLocation {
+	if b == nil {
+		return nil
+	}
+	return b.Loc
+}
 
 // Program represents a complete program source tree
 type Program struct {
 	*BaseNode
-	Body []Statement `json:"body"`
+	Package *PackageClause       `json:"package,omitempty"`
+	Imports []*ImportDeclaration `json:"imports,omitempty"`
+	Body    []Statement          `json:"body"`
 }
 
 // Type is the abstract type
@@ -83,6 +98,15 @@ func (*Program) Type() string { return "Program" }
 func (p *Program) Copy() Node {
 	np := new(Program)
 	*np = *p
+	if p.Package != nil {
+		np.Package = p.Package.Copy().(*PackageClause)
+	}
+	if len(p.Imports) > 0 {
+		np.Imports = make([]*ImportDeclaration, len(p.Imports))
+		for i, d := range p.Imports {
+			np.Imports[i] = d.Copy().(*ImportDeclaration)
+		}
+	}
 	if len(p.Body) > 0 {
 		np.Body = make([]Statement, len(p.Body))
 		for i, s := range p.Body {
@@ -92,6 +116,53 @@ func (p *Program) Copy() Node {
 	return np
 }
 
+// PackageClause names the package a program belongs to
+type PackageClause struct {
+	*BaseNode
+	Name *Identifier `json:"name"`
+}
+
+// Type is the abstract type
+func (*PackageClause) Type() string { return "PackageClause" }
+
+func (c *PackageClause) Copy() Node {
+	if c == nil {
+		return c
+	}
+	nc := new(PackageClause)
+	*nc = *c
+
+	nc.Name = c.Name.Copy().(*Identifier)
+
+	return nc
+}
+
+// ImportDeclaration imports a package by its path.
+// The package is bound to As if present, otherwise to the name of the package.
+type ImportDeclaration struct {
+	*BaseNode
+	As   *Identifier    `json:"as,omitempty"`
+	Path *StringLiteral `json:"path"`
+}
+
+// Type is the abstract type
+func (*ImportDeclaration) Type() string { return "ImportDeclaration" }
+
+func (d *ImportDeclaration) Copy() Node {
+	if d == nil {
+		return d
+	}
+	nd := new(ImportDeclaration)
+	*nd = *d
+
+	if d.As != nil {
+		nd.As = d.As.Copy().(*Identifier)
+	}
+	nd.Path = d.Path.Copy().(*StringLiteral)
+
+	return nd
+}
+
 // Statement Perhaps we don't even want statements nor expression statements
 type Statement interface {
 	Node
@@ -100,6 +171,7 @@ type Statement interface {
 
 func (*BlockStatement) stmt()      {}
 func (*ExpressionStatement) stmt() {}
+func (*OptionStatement) stmt()     {}
 func (*ReturnStatement) stmt()     {}
 func (*VariableDeclaration) stmt() {}
 
@@ -146,6 +218,27 @@ func (s *ExpressionStatement) Copy() Node {
 	return ns
 }
 
+// OptionStatement sets a query-wide option to the value of an expression.
+type OptionStatement struct {
+	*BaseNode
+	Declaration *VariableDeclarator `json:"declaration"`
+}
+
+// Type is the abstract type
+func (*OptionStatement) Type() string { return "OptionStatement" }
+
+func (s *OptionStatement) Copy() Node {
+	if s == nil {
+		return s
+	}
+	ns := new(OptionStatement)
+	*ns = *s
+
+	ns.Declaration = s.Declaration.Copy().(*VariableDeclarator)
+
+	return ns
+}
+
 // ReturnStatement defines an Expression to return
 type ReturnStatement struct {
 	*BaseNode
@@ -237,6 +330,7 @@ func (*ObjectExpression) expression()        {}
 func (*PipeExpression) expression()          {}
 func (*PipeLiteral) expression()             {}
 func (*RegexpLiteral) expression()           {}
+func (*StringExpression) expression()        {}
 func (*StringLiteral) expression()           {}
 func (*UnaryExpression) expression()         {}
 func (*UnsignedIntegerLiteral) expression()  {}
@@ -369,6 +463,7 @@ const (
 	NotEqualOperator
 	RegexpMatchOperator
 	NotRegexpMatchOperator
+	ExistsOperator
 	opEnd
 )
 
@@ -505,6 +600,81 @@ func (e *LogicalExpression) Copy() Node {
 	return ne
 }
 
+// StringExpression represents a string with interpolated expressions.
+// A string without any interpolated expressions is a StringLiteral.
+type StringExpression struct {
+	*BaseNode
+	Parts []StringExpressionPart `json:"parts"`
+}
+
+// Type is the abstract type
+func (*StringExpression) Type() string { return "StringExpression" }
+
+func (e *StringExpression) Copy() Node {
+	if e == nil {
+		return e
+	}
+	ne := new(StringExpression)
+	*ne = *e
+
+	if len(e.Parts) > 0 {
+		ne.Parts = make([]StringExpressionPart, len(e.Parts))
+		for i, p := range e.Parts {
+			ne.Parts[i] = p.Copy().(StringExpressionPart)
+		}
+	}
+
+	return ne
+}
+
+// StringExpressionPart is either a TextPart or an InterpolatedPart of a StringExpression.
+type StringExpressionPart interface {
+	Node
+	stringPart()
+}
+
+func (*TextPart) stringPart()         {}
+func (*InterpolatedPart) stringPart() {}
+
+// TextPart is the literal text of a string expression, with any escape sequences already replaced.
+type TextPart struct {
+	*BaseNode
+	Value string `json:"value"`
+}
+
+// Type is the abstract type
+func (*TextPart) Type() string { return "TextPart" }
+
+func (p *TextPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(TextPart)
+	*np = *p
+	return np
+}
+
+// InterpolatedPart is an expression enclosed in ${} within a string expression.
+type InterpolatedPart struct {
+	*BaseNode
+	Expression Expression `json:"expression"`
+}
+
+// Type is the abstract type
+func (*InterpolatedPart) Type() string { return "InterpolatedPart" }
+
+func (p *InterpolatedPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(InterpolatedPart)
+	*np = *p
+
+	np.Expression = p.Expression.Copy().(Expression)
+
+	return np
+}
+
 // ArrayExpression is used to create and directly specify the elements of an array object
 type ArrayExpression struct {
 	*BaseNode
@@ -826,6 +996,7 @@ var OperatorTokens = map[OperatorKind]string{
 	NotEqualOperator:         "!=",
 	RegexpMatchOperator:      "=~",
 	NotRegexpMatchOperator:   "!~",
+	ExistsOperator:           "exists",
 }
 
 // LogicalOperatorTokens converts LogicalOperatorKind to string
@@ -21,17 +21,23 @@ var IgnoreBaseNodeOptions = []cmp.Option{
 	cmpopts.IgnoreFields(ast.ExpressionStatement{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.FloatLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.Identifier{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.ImportDeclaration{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.IntegerLiteral{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.InterpolatedPart{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.LogicalExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.MemberExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.ObjectExpression{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.OptionStatement{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.PackageClause{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.PipeExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.PipeLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.Program{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.Property{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.RegexpLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.ReturnStatement{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.StringExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.StringLiteral{}, "BaseNode"),
+	cmpopts.IgnoreFields(ast.TextPart{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.UnaryExpression{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.UnsignedIntegerLiteral{}, "BaseNode"),
 	cmpopts.IgnoreFields(ast.VariableDeclaration{}, "BaseNode"),
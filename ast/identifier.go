@@ -0,0 +1,29 @@
+package ast
+
+import "unicode"
+
+// keywords are reserved and may not be used as identifiers.
+var keywords = map[string]bool{
+	"import": true,
+	"option": true,
+	"if":     true,
+	"then":   true,
+	"else":   true,
+	"in":     true,
+	"empty":  true,
+	"exists": true,
+}
+
+// IsIdentifier reports whether the name is a valid identifier,
+// a letter or underscore followed by letters, digits or underscores that is not a keyword.
+func IsIdentifier(name string) bool {
+	if name == "" || keywords[name] {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !unicode.IsLetter(r) && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return true
+}
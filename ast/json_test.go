@@ -29,6 +29,24 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"Program","body":[{"type":"ExpressionStatement","expression":{"type":"StringLiteral","value":"hello"}}]}`,
 		},
+		{
+			name: "program with package and imports",
+			node: &ast.Program{
+				Package: &ast.PackageClause{
+					Name: &ast.Identifier{Name: "alerts"},
+				},
+				Imports: []*ast.ImportDeclaration{{
+					As:   &ast.Identifier{Name: "s"},
+					Path: &ast.StringLiteral{Value: "strings"},
+				}},
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringLiteral{Value: "hello"},
+					},
+				},
+			},
+			want: `{"type":"Program","package":{"type":"PackageClause","name":{"type":"Identifier","name":"alerts"}},"imports":[{"type":"ImportDeclaration","as":{"type":"Identifier","name":"s"},"path":{"type":"StringLiteral","value":"strings"}}],"body":[{"type":"ExpressionStatement","expression":{"type":"StringLiteral","value":"hello"}}]}`,
+		},
 		{
 			name: "block statement",
 			node: &ast.BlockStatement{
@@ -142,6 +160,16 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"ArrayExpression","elements":[{"type":"StringLiteral","value":"hello"}]}`,
 		},
+		{
+			name: "string expression",
+			node: &ast.StringExpression{
+				Parts: []ast.StringExpressionPart{
+					&ast.TextPart{Value: "a is "},
+					&ast.InterpolatedPart{Expression: &ast.Identifier{Name: "a"}},
+				},
+			},
+			want: `{"type":"StringExpression","parts":[{"type":"TextPart","value":"a is "},{"type":"InterpolatedPart","expression":{"type":"Identifier","name":"a"}}]}`,
+		},
 		{
 			name: "object expression",
 			node: &ast.ObjectExpression{
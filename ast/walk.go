@@ -0,0 +1,177 @@
+package ast
+
+// Walk traverses the AST depth first, calling v.Visit for each node.
+// If v.Visit returns nil the children of the node are not walked.
+func Walk(v Visitor, node Node) {
+	walk(v, node)
+}
+
+type Visitor interface {
+	Visit(node Node) Visitor
+	Done()
+}
+
+func walk(v Visitor, n Node) {
+	defer v.Done()
+	switch n := n.(type) {
+	case *Program:
+		w := v.Visit(n)
+		if w != nil {
+			if n.Package != nil {
+				walk(w, n.Package)
+			}
+			for _, d := range n.Imports {
+				walk(w, d)
+			}
+			for _, s := range n.Body {
+				walk(w, s)
+			}
+		}
+	case *PackageClause:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Name)
+		}
+	case *ImportDeclaration:
+		w := v.Visit(n)
+		if w != nil {
+			if n.As != nil {
+				walk(w, n.As)
+			}
+			walk(w, n.Path)
+		}
+	case *BlockStatement:
+		w := v.Visit(n)
+		if w != nil {
+			for _, s := range n.Body {
+				walk(w, s)
+			}
+		}
+	case *ExpressionStatement:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Expression)
+		}
+	case *OptionStatement:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Declaration)
+		}
+	case *ReturnStatement:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Argument)
+		}
+	case *VariableDeclaration:
+		w := v.Visit(n)
+		if w != nil {
+			for _, d := range n.Declarations {
+				walk(w, d)
+			}
+		}
+	case *VariableDeclarator:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.ID)
+			walk(w, n.Init)
+		}
+	case *ArrayExpression:
+		w := v.Visit(n)
+		if w != nil {
+			for _, e := range n.Elements {
+				walk(w, e)
+			}
+		}
+	case *ArrowFunctionExpression:
+		w := v.Visit(n)
+		if w != nil {
+			for _, p := range n.Params {
+				walk(w, p)
+			}
+			walk(w, n.Body)
+		}
+	case *BinaryExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Left)
+			walk(w, n.Right)
+		}
+	case *CallExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Callee)
+			for _, a := range n.Arguments {
+				walk(w, a)
+			}
+		}
+	case *ConditionalExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Test)
+			walk(w, n.Consequent)
+			walk(w, n.Alternate)
+		}
+	case *LogicalExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Left)
+			walk(w, n.Right)
+		}
+	case *MemberExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Object)
+			walk(w, n.Property)
+		}
+	case *PipeExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Argument)
+			walk(w, n.Call)
+		}
+	case *ObjectExpression:
+		w := v.Visit(n)
+		if w != nil {
+			for _, p := range n.Properties {
+				walk(w, p)
+			}
+		}
+	case *StringExpression:
+		w := v.Visit(n)
+		if w != nil {
+			for _, p := range n.Parts {
+				walk(w, p)
+			}
+		}
+	case *InterpolatedPart:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Expression)
+		}
+	case *UnaryExpression:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Argument)
+		}
+	case *Property:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Key)
+			if n.Value != nil {
+				walk(w, n.Value)
+			}
+		}
+	case *TextPart,
+		*Identifier,
+		*BooleanLiteral,
+		*DateTimeLiteral,
+		*DurationLiteral,
+		*FloatLiteral,
+		*IntegerLiteral,
+		*PipeLiteral,
+		*RegexpLiteral,
+		*StringLiteral,
+		*UnsignedIntegerLiteral:
+		v.Visit(n)
+	}
+}
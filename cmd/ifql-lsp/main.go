@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	_ "github.com/influxdata/ifql"
+	"github.com/influxdata/ifql/lsp"
+)
+
+var searchPath = make(pathList, 0)
+
+func init() {
+	flag.Var(&searchPath, "path", "A directory to search for imported IFQL packages. Can be provided multiple times.")
+}
+
+type pathList []string
+
+func (l *pathList) String() string {
+	return "<dir>..."
+}
+
+func (l *pathList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: ifql-lsp [OPTIONS]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Runs a Language Server Protocol server for IFQL, communicating over stdin and stdout.")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Options:")
+
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	// Stdout is reserved for the protocol.
+	log.SetOutput(os.Stderr)
+
+	if err := lsp.NewServer(searchPath).Serve(os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
@@ -9,6 +11,7 @@ import (
 	"runtime"
 
 	"github.com/influxdata/ifql"
+	"github.com/influxdata/ifql/format"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/functions/storage"
 	"github.com/influxdata/ifql/functions/storage/pb"
@@ -18,11 +21,16 @@ import (
 )
 
 var verbose = flag.Bool("v", false, "print verbose output")
+var formatQuery = flag.Bool("fmt", false, "print the query in its canonical format instead of running it")
+var explain = flag.String("explain", "", "print the query spec and its plans as json or dot instead of running it")
+var analyze = flag.Bool("analyze", false, "with -explain, run the query and also print the statistics of each procedure")
 
 var hosts = make(hostList, 0)
+var searchPath = make(pathList, 0)
 
 func init() {
 	flag.Var(&hosts, "host", "An InfluxDB host to connect to. Can be provided multiple times.")
+	flag.Var(&searchPath, "path", "A directory to search for imported IFQL packages. Can be provided multiple times.")
 }
 
 type hostList []string
@@ -36,6 +44,17 @@ func (l *hostList) Set(s string) error {
 	return nil
 }
 
+type pathList []string
+
+func (l *pathList) String() string {
+	return "<dir>..."
+}
+
+func (l *pathList) Set(s string) error {
+	*l = append(*l, s)
+	return nil
+}
+
 var defaultStorageHosts = []string{"localhost:8082"}
 
 var (
@@ -53,6 +72,13 @@ func usage() {
 	fmt.Println()
 	fmt.Println("If no query is provided an interactive REPL will be run.")
 	fmt.Println()
+	fmt.Println("With -fmt the query is formatted and printed to stdout, comments are preserved.")
+	fmt.Println()
+	fmt.Println("With -explain the query spec, the logical plan and the physical plan are printed")
+	fmt.Println("to stdout as JSON or as a Graphviz DOT graph, e.g. ifql -explain dot @query.ifql | dot -Tsvg.")
+	fmt.Println("With -analyze the query is also run, its results are discarded and the statistics")
+	fmt.Println("of each procedure are reported.")
+	fmt.Println()
 	fmt.Println("The query argument is either a literal query, - indicating to read from stdin,")
 	fmt.Println("or a path to a file prefixed with an '@'.")
 	fmt.Println()
@@ -65,6 +91,19 @@ func main() {
 	flag.Usage = usage
 	flag.Parse()
 
+	if *formatQuery {
+		if err := formatMain(flag.Args()); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if *explain != "" && !*analyze {
+		if err := explainMain(flag.Args(), nil); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	if len(hosts) == 0 {
 		hosts = defaultStorageHosts
 	}
@@ -74,6 +113,7 @@ func main() {
 		ConcurrencyQuota: runtime.NumCPU() * 2,
 		MemoryBytesQuota: math.MaxInt64,
 		Verbose:          *verbose,
+		SearchPath:       searchPath,
 	}
 
 	if err := injectDeps(config.Dependencies, hosts); err != nil {
@@ -84,6 +124,12 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	if *explain != "" {
+		if err := explainMain(flag.Args(), c); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 	replCmd := repl.New(c, orgID)
 
 	args := flag.Args()
@@ -104,6 +150,53 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// formatMain prints the canonical format of the query argument.
+func formatMain(args []string) error {
+	if len(args) != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	q, err := repl.LoadQuery(args[0])
+	if err != nil {
+		return err
+	}
+	src, err := format.Source([]byte(q))
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(src)
+	return err
+}
+
+// explainMain prints how the query argument is planned, in the format of the explain flag.
+// The query is run to collect statistics if a controller is given.
+func explainMain(args []string, c *ifql.Controller) error {
+	if len(args) != 1 || (*explain != "json" && *explain != "dot") {
+		flag.Usage()
+		os.Exit(1)
+	}
+	q, err := repl.LoadQuery(args[0])
+	if err != nil {
+		return err
+	}
+	var e *ifql.Explanation
+	if c != nil {
+		e, err = ifql.Analyze(context.Background(), c, orgID, q)
+	} else {
+		e, err = ifql.Explain(context.Background(), q, searchPath)
+	}
+	if err != nil {
+		return err
+	}
+	if *explain == "dot" {
+		return e.WriteDOT(os.Stdout)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
+
 func injectDeps(deps execute.Dependencies, hosts []string) error {
 	sr, err := pb.NewReader(storage.NewStaticLookup(hosts))
 	if err != nil {
@@ -1,8 +1,8 @@
 /*
 IFQLD is a basic HTTP server that exposes a sinle endpoint
 for processing IFQL queries to 1 or more InfluxDB servers.
-It can return data in either line protocol or a new JSON
-lines format. Requests go here:
+It can return data in either line protocol, a new JSON
+lines format or annotated CSV (Accept: text/csv). Requests go here:
 
 http://localhost:8080/query?q=...&verbose=true&trace=true&format=line|json
 
@@ -10,5 +10,32 @@ q is the IFQL query string. Format specifies what the response
 format should be. JSON is the default. verbose and trace are optional
 parameters that will make the server output additional log
 information.
+
+A query may also be posted as a JSON body (Content-type: application/json)
+with either a "query" string or a "spec", and an optional "dialect"
+object controlling the CSV encoding, see the SPEC for the dialect options.
+The dialect may also be passed as a JSON encoded dialect parameter.
+
+The explain parameter returns the compiled query spec together with the
+logical plan and the physical plan after pushdowns, as JSON, instead of
+executing the query. With explain=analyze the query is executed, its
+results are discarded and the response also reports the statistics of
+each procedure: the blocks and rows in and out, the bytes allocated and
+the wall time spent executing it. The same explanation is served on
+
+http://localhost:8080/explain?q=...&analyze=true&format=dot
+
+where format=dot renders the spec and the plans as clusters of a single
+Graphviz DOT graph, annotated with the pushed down procedures, the
+resource quotas and, when analyzed, the statistics of each procedure.
+
+The fromCSV function may only read files from the directory given by
+the csv-dir option, with paths relative to it. Without the option
+fromCSV may only decode the CSV data passed in the query.
+
+Errors are encoded in the response format of the request together with
+a reference code that is also written to the server log. If some
+results have already been sent, the error follows them and the status
+remains 200 OK.
 */
 package main
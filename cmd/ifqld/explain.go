@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/influxdata/ifql"
+	opentracing "github.com/opentracing/opentracing-go"
+)
+
+// HandleExplain explains how a query is planned, without returning its results.
+func HandleExplain(w http.ResponseWriter, req *http.Request) {
+	span, ctx := opentracing.StartSpanFromContext(req.Context(), "explain")
+	defer span.Finish()
+
+	rw := &trackingResponseWriter{ResponseWriter: w}
+	queryStr := req.FormValue("q")
+	if queryStr == "" {
+		writeError(rw, jsonFormat{}, http.StatusBadRequest, errors.New("must pass query in q parameter"))
+		return
+	}
+	writeExplanation(ctx, rw, queryStr, req.FormValue("analyze") != "", req.FormValue("format") == "dot")
+}
+
+// writeExplanation explains the query, executing it when analyze is set.
+// The explanation is encoded as JSON, or as a Graphviz DOT graph when dot is set.
+func writeExplanation(ctx context.Context, w *trackingResponseWriter, queryStr string, analyze, dot bool) {
+	var (
+		e   *ifql.Explanation
+		err error
+	)
+	if analyze {
+		e, err = ifql.Analyze(ctx, controller, orgID, queryStr)
+	} else {
+		e, err = ifql.Explain(ctx, queryStr, opts.SearchPath)
+	}
+	if err != nil {
+		writeError(w, jsonFormat{}, http.StatusInternalServerError, err)
+		return
+	}
+	if dot {
+		w.Header().Set("Content-Type", "text/vnd.graphviz")
+		if err := e.WriteDOT(w); err != nil {
+			log.Println(err)
+		}
+		return
+	}
+	encodeJSON(w, http.StatusOK, e)
+}
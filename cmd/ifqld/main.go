@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
@@ -13,11 +15,13 @@ import (
 	"time"
 
 	"github.com/influxdata/ifql"
+	"github.com/influxdata/ifql/csv"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/functions/storage"
 	"github.com/influxdata/ifql/functions/storage/pb"
 	"github.com/influxdata/ifql/id"
 	"github.com/influxdata/ifql/idfile"
+	"github.com/influxdata/ifql/parser"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/tracing"
@@ -45,6 +49,8 @@ type options struct {
 	Verbose           bool           `short:"v" long:"verbose" description:"Log more verbose debugging output"`
 	ConcurrencyQuota  int            `short:"c" long:"concurrency-quota" description:"Maximum concurrency allowed" env:"CONCURRENCY_QUOTA"`
 	MemoryBytesQuota  int            `short:"m" long:"memory-quota" description:"Approximate maximum memory usage allowed in bytes" env:"MEMORY_BYTES_QUOTA"`
+	SearchPath        []string       `long:"ifql-path" description:"Directory to search for imported IFQL packages. Can be specified more than once." env:"IFQL_PATH" env-delim:":"`
+	CSVDir            string         `long:"csv-dir" description:"Directory of the files fromCSV may read. If not set fromCSV may not read files." env:"CSV_DIR"`
 }
 
 var (
@@ -96,6 +102,7 @@ func main() {
 		Dependencies:     make(execute.Dependencies),
 		ConcurrencyQuota: opts.ConcurrencyQuota,
 		MemoryBytesQuota: opts.MemoryBytesQuota,
+		SearchPath:       opts.SearchPath,
 	}
 
 	if err := injectDeps(config.Dependencies); err != nil {
@@ -111,6 +118,7 @@ func main() {
 	http.Handle("/metrics", promhttp.Handler())
 	http.Handle("/query", http.HandlerFunc(HandleQuery))
 	http.Handle("/queries", http.HandlerFunc(HandleQueries))
+	http.Handle("/explain", http.HandlerFunc(HandleExplain))
 
 	if !opts.ReportingDisabled {
 		id := ID(string(opts.IDFile))
@@ -131,8 +139,13 @@ func injectDeps(deps execute.Dependencies) error {
 		return err
 	}
 
-	return functions.InjectFromDependencies(deps, storage.Dependencies{
+	if err := functions.InjectFromDependencies(deps, storage.Dependencies{
 		Reader: sr,
+	}); err != nil {
+		return err
+	}
+	return functions.InjectFromCSVDependencies(deps, functions.FromCSVDependencies{
+		Dir: opts.CSVDir,
 	})
 }
 
@@ -144,56 +157,97 @@ func HandleQuery(w http.ResponseWriter, req *http.Request) {
 	atomic.AddInt64(&queryCount, 1)
 	queryCounter.Inc()
 
+	rw := &trackingResponseWriter{ResponseWriter: w}
+	accept := req.Header.Get("Accept")
+	csvConfig, _ := csvEncoderConfig(nil)
+	format := newResponseFormat(accept, nil, csvConfig)
+
 	var (
-		q   *ifql.Query
-		err error
+		q       *ifql.Query
+		dialect *csv.Dialect
+		err     error
 	)
 	if req.Header.Get("Content-type") == "application/json" {
-		spec := new(query.Spec)
-		if err := json.NewDecoder(req.Body).Decode(spec); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-			w.Write([]byte(fmt.Sprintf("Error parsing query spec %s", err.Error())))
-			log.Println("Error:", err)
+		var qr *QueryRequest
+		qr, err = decodeQueryRequest(req.Body)
+		if err != nil {
+			writeError(rw, format, http.StatusBadRequest, fmt.Errorf("error parsing query request: %v", err))
 			return
 		}
+		dialect = qr.Dialect
+		csvConfig, err = csvEncoderConfig(dialect)
+		if err != nil {
+			writeError(rw, format, http.StatusBadRequest, fmt.Errorf("invalid dialect: %v", err))
+			return
+		}
+		format = newResponseFormat(accept, dialect, csvConfig)
 
-		q, err = controller.Query(ctx, orgID, spec)
+		if qr.Spec != nil {
+			q, err = controller.Query(ctx, orgID, qr.Spec)
+		} else {
+			if opts.Verbose {
+				log.Print(qr.Query)
+			}
+			q, err = controller.QueryWithCompile(ctx, orgID, qr.Query)
+		}
 	} else {
 		queryStr := req.FormValue("q")
 		if queryStr == "" {
-			w.WriteHeader(http.StatusBadRequest)
-			w.Write([]byte("must pass query in q parameter"))
+			writeError(rw, format, http.StatusBadRequest, errors.New("must pass query in q parameter"))
 			return
 		}
 		if opts.Verbose {
 			log.Print(queryStr)
 		}
 
+		if d := req.FormValue("dialect"); d != "" {
+			dialect = new(csv.Dialect)
+			if err := json.Unmarshal([]byte(d), dialect); err != nil {
+				writeError(rw, format, http.StatusBadRequest, fmt.Errorf("error parsing dialect: %v", err))
+				return
+			}
+		}
+		csvConfig, err = csvEncoderConfig(dialect)
+		if err != nil {
+			writeError(rw, format, http.StatusBadRequest, fmt.Errorf("invalid dialect: %v", err))
+			return
+		}
+		format = newResponseFormat(accept, dialect, csvConfig)
+
 		analyze := req.FormValue("analyze") != ""
 		if analyze {
-			spec, err := query.Compile(ctx, queryStr)
+			// Report every syntax error at once, so editors can mark all of them.
+			if _, diagnostics := parser.NewASTWithDiagnostics(queryStr); len(diagnostics) > 0 {
+				encodeJSON(w, http.StatusBadRequest, struct {
+					Diagnostics parser.Diagnostics `json:"diagnostics"`
+				}{diagnostics})
+				return
+			}
+			spec, err := query.Compile(ctx, queryStr, query.SearchPath(opts.SearchPath))
 			if err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-				w.Write([]byte(fmt.Sprintf("Error compiling query %s", err.Error())))
+				writeError(rw, format, http.StatusInternalServerError, fmt.Errorf("error compiling query: %v", err))
 				return
 			}
 			encodeJSON(w, http.StatusOK, spec)
 			return
 		}
 
+		if explain := req.FormValue("explain"); explain != "" {
+			writeExplanation(ctx, rw, queryStr, explain == "analyze", req.FormValue("format") == "dot")
+			return
+		}
+
 		q, err = controller.QueryWithCompile(ctx, orgID, queryStr)
 	}
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("Error constructing query %s", err.Error())))
+		writeError(rw, format, http.StatusInternalServerError, fmt.Errorf("error constructing query: %v", err))
 		return
 	}
 	defer q.Done()
 
 	funcs, err := q.Spec().Functions()
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("Error analyzing query %s", err.Error())))
+		writeError(rw, format, http.StatusInternalServerError, fmt.Errorf("error analyzing query: %v", err))
 		return
 	}
 
@@ -209,19 +263,52 @@ func HandleQuery(w http.ResponseWriter, req *http.Request) {
 
 	results, ok := <-q.Ready()
 	if !ok {
-		err := q.Err()
-		w.WriteHeader(http.StatusInternalServerError)
-		w.Write([]byte(fmt.Sprintf("Error executing query %s", err.Error())))
+		writeError(rw, format, http.StatusInternalServerError, fmt.Errorf("error executing query: %v", q.Err()))
 		return
 	}
-	switch req.Header.Get("Accept") {
-	case "application/json":
-		writeJSONChunks(results, w)
-	default:
-		writeLineResults(results, w)
+	rw.Header().Set("Content-Type", format.contentType())
+	if err := format.writeResults(rw, results); err != nil {
+		// Any results already sent remain valid, the error is encoded after them.
+		writeError(rw, format, http.StatusInternalServerError, fmt.Errorf("error executing query: %v", err))
 	}
 }
 
+// QueryRequest is the JSON request body of a query.
+// Exactly one of Query or Spec must be set.
+// The Dialect controls the CSV encoding of the results.
+type QueryRequest struct {
+	Query   string       `json:"query"`
+	Spec    *query.Spec  `json:"spec"`
+	Dialect *csv.Dialect `json:"dialect"`
+}
+
+// decodeQueryRequest decodes a QueryRequest.
+// A body that is a bare query spec is also accepted for compatibility.
+func decodeQueryRequest(r io.Reader) (*QueryRequest, error) {
+	body, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	qr := new(QueryRequest)
+	if err := json.Unmarshal(body, qr); err != nil {
+		return nil, err
+	}
+	switch {
+	case qr.Query != "" && qr.Spec != nil:
+		return nil, errors.New("must specify only one of query or spec")
+	case qr.Query == "" && qr.Spec == nil:
+		spec := new(query.Spec)
+		if err := json.Unmarshal(body, spec); err != nil {
+			return nil, err
+		}
+		if len(spec.Operations) == 0 {
+			return nil, errors.New("must specify one of query or spec")
+		}
+		qr.Spec = spec
+	}
+	return qr, nil
+}
+
 type QueriesResponse struct {
 	Queries []Query
 }
@@ -248,10 +335,10 @@ func HandleQueries(w http.ResponseWriter, req *http.Request) {
 	}
 }
 
-func iterateResults(r execute.Result, f func(measurement, fieldName string, tags map[string]string, value interface{}, t time.Time)) {
+func iterateResults(r execute.Result, f func(measurement, fieldName string, tags map[string]string, value interface{}, t time.Time) error) error {
 	blocks := r.Blocks()
 
-	err := blocks.Do(func(b execute.Block) error {
+	return blocks.Do(func(b execute.Block) error {
 		timeIdx := execute.ColIdx("_time", b.Cols())
 		if timeIdx < 0 {
 			return errors.New("missing _time column")
@@ -299,14 +386,13 @@ func iterateResults(r execute.Result, f func(measurement, fieldName string, tags
 				if fieldName == "" {
 					fieldName = "value"
 				}
-				f(measurement, fieldName, tags, value, time.Time())
+				if err := f(measurement, fieldName, tags, value, time.Time()); err != nil {
+					return err
+				}
 			}
 			return nil
 		})
 	})
-	if err != nil {
-		log.Println("Error iterating through results:", err)
-	}
 }
 
 type header struct {
@@ -325,7 +411,7 @@ type point struct {
 	Context map[string]string `json:"context,omitempty"`
 }
 
-func writeJSONChunks(results map[string]execute.Result, w http.ResponseWriter) {
+func writeJSONChunks(results map[string]execute.Result, w http.ResponseWriter) error {
 	seriesID := int64(0)
 	for name, r := range results {
 		blocks := r.Blocks()
@@ -409,23 +495,49 @@ func writeJSONChunks(results map[string]execute.Result, w http.ResponseWriter) {
 			})
 		})
 		if err != nil {
-			log.Println("Error iterating through results:", err)
+			return err
 		}
 	}
+	return nil
 }
 
-func writeLineResults(results map[string]execute.Result, w http.ResponseWriter) {
+// csvAnnotations are the annotations needed to fully encode a table with its partition key.
+var csvAnnotations = []string{
+	csv.DatatypeAnnotation,
+	csv.PartitionAnnotation,
+	csv.DefaultAnnotation,
+}
+
+// csvEncoderConfig returns the encoder config for the requested dialect.
+// Without a dialect all annotations are encoded.
+func csvEncoderConfig(d *csv.Dialect) (csv.ResultEncoderConfig, error) {
+	if d == nil {
+		config := csv.DefaultEncoderConfig()
+		config.Annotations = csvAnnotations
+		return config, nil
+	}
+	return d.EncoderConfig()
+}
+
+func writeLineResults(results map[string]execute.Result, w http.ResponseWriter) error {
 	for _, r := range results {
-		iterateResults(r, func(m, f string, tags map[string]string, val interface{}, t time.Time) {
+		err := iterateResults(r, func(m, f string, tags map[string]string, val interface{}, t time.Time) error {
 			p, err := models.NewPoint(m, models.NewTags(tags), map[string]interface{}{f: val}, t)
 			if err != nil {
 				log.Println("error creating new point", err)
-				return
+				return nil
+			}
+			if _, err := w.Write([]byte(p.String())); err != nil {
+				return err
 			}
-			w.Write([]byte(p.String()))
-			w.Write([]byte("\n"))
+			_, err = w.Write([]byte("\n"))
+			return err
 		})
+		if err != nil {
+			return err
+		}
 	}
+	return nil
 }
 
 // ID returns the id of the running ifqld process
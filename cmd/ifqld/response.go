@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/influxdata/ifql/csv"
+	"github.com/influxdata/ifql/query/execute"
+)
+
+// errorReference is the last reference code handed out for an error.
+// The reference code is included in both the response and the server log,
+// so that a client error can be matched with the server log entry.
+var errorReference int64
+
+// responseFormat encodes results and errors in a response content type.
+type responseFormat interface {
+	contentType() string
+	// writeResults writes all results, stopping at the first error.
+	writeResults(w http.ResponseWriter, results map[string]execute.Result) error
+	// writeError writes the error after anything already written.
+	writeError(w http.ResponseWriter, err error, reference int64) error
+}
+
+// newResponseFormat returns the format for the Accept header of a request.
+// The accepted media type with the highest quality is used, and line protocol if neither JSON nor CSV is accepted.
+// A request with a dialect and no Accept header is answered with CSV.
+func newResponseFormat(accept string, dialect *csv.Dialect, config csv.ResultEncoderConfig) responseFormat {
+	if accept == "" && dialect != nil {
+		accept = csv.ContentType
+	}
+	switch acceptedMediaType(accept, "application/json", "text/csv") {
+	case "application/json":
+		return jsonFormat{}
+	case "text/csv":
+		return &csvFormat{
+			encoder: csv.NewMultiResultEncoder(config),
+		}
+	default:
+		return lineFormat{}
+	}
+}
+
+// acceptedMediaType returns the media type of the Accept header with the highest quality among the supported types,
+// or an empty string if none of them is accepted.
+// Media types that cannot be parsed are ignored.
+func acceptedMediaType(accept string, supported ...string) string {
+	best, bestQuality := "", 0.0
+	for _, r := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(r)
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		for _, s := range supported {
+			if mediaType == s && quality > bestQuality {
+				best, bestQuality = s, quality
+			}
+		}
+	}
+	return best
+}
+
+// writeError logs the error with a new reference code and encodes it in the response.
+// The HTTP status is only used if nothing has been written yet,
+// otherwise the error is encoded after the data that has already been sent.
+func writeError(w *trackingResponseWriter, f responseFormat, status int, err error) {
+	ref := atomic.AddInt64(&errorReference, 1)
+	log.Printf("Error (reference %d): %v", ref, err)
+	if !w.written {
+		w.Header().Set("Content-Type", f.contentType())
+		w.WriteHeader(status)
+	}
+	if err := f.writeError(w, err, ref); err != nil {
+		log.Printf("Error writing error (reference %d): %v", ref, err)
+	}
+}
+
+// trackingResponseWriter records whether any of the response body has been written.
+type trackingResponseWriter struct {
+	http.ResponseWriter
+	written bool
+}
+
+func (w *trackingResponseWriter) Write(p []byte) (int, error) {
+	w.written = true
+	return w.ResponseWriter.Write(p)
+}
+
+func (w *trackingResponseWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+type csvFormat struct {
+	encoder *csv.MultiResultEncoder
+}
+
+func (f *csvFormat) contentType() string {
+	return csv.ContentType
+}
+
+func (f *csvFormat) writeResults(w http.ResponseWriter, results map[string]execute.Result) error {
+	return f.encoder.Encode(w, results)
+}
+
+func (f *csvFormat) writeError(w http.ResponseWriter, err error, reference int64) error {
+	return f.encoder.EncodeError(w, err, reference)
+}
+
+type jsonFormat struct{}
+
+// jsonError is the final JSON line of a response that failed.
+type jsonError struct {
+	Error     string `json:"error"`
+	Reference int64  `json:"reference"`
+}
+
+func (jsonFormat) contentType() string {
+	return "application/json"
+}
+
+func (jsonFormat) writeResults(w http.ResponseWriter, results map[string]execute.Result) error {
+	return writeJSONChunks(results, w)
+}
+
+func (jsonFormat) writeError(w http.ResponseWriter, err error, reference int64) error {
+	return json.NewEncoder(w).Encode(jsonError{
+		Error:     err.Error(),
+		Reference: reference,
+	})
+}
+
+type lineFormat struct{}
+
+func (lineFormat) contentType() string {
+	return "text/plain; charset=utf-8"
+}
+
+func (lineFormat) writeResults(w http.ResponseWriter, results map[string]execute.Result) error {
+	return writeLineResults(results, w)
+}
+
+// writeError writes the error as a comment line, which line protocol parsers ignore.
+func (lineFormat) writeError(w http.ResponseWriter, err error, reference int64) error {
+	_, werr := fmt.Fprintf(w, "# error: %s, reference: %d\n", err.Error(), reference)
+	return werr
+}
@@ -3,12 +3,20 @@ package compiler
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
+	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/semantic"
 	"github.com/influxdata/ifql/values"
 )
 
 func Compile(f *semantic.FunctionExpression, inTypes map[string]semantic.Type, builtinScope Scope, builtinDeclarations semantic.DeclarationScope) (Func, error) {
+	return compileFunction(f, inTypes, builtinScope, builtinDeclarations, nil)
+}
+
+// compileFunction compiles f for the given types of its parameters.
+// Functions called by f are compiled with the variables and functions declared in the outer compiler in scope.
+func compileFunction(f *semantic.FunctionExpression, inTypes map[string]semantic.Type, builtinScope Scope, builtinDeclarations semantic.DeclarationScope, outer *compiler) (Func, error) {
 	if builtinDeclarations == nil {
 		builtinDeclarations = make(semantic.DeclarationScope)
 	}
@@ -16,6 +24,11 @@ func Compile(f *semantic.FunctionExpression, inTypes map[string]semantic.Type, b
 		builtinDeclarations[k] = semantic.NewExternalVariableDeclaration(k, t)
 	}
 	semantic.SolveTypes(f, builtinDeclarations)
+	// Inferring the types of the body for the types of the parameters checks the function,
+	// e.g. the verbs of formats are checked against the types of the arguments they format.
+	if _, err := semantic.InferTypes(f.Body, builtinDeclarations); err != nil {
+		return nil, err
+	}
 	declarations := make(map[string]semantic.VariableDeclaration, len(inTypes))
 	for k, t := range inTypes {
 		declarations[k] = semantic.NewExternalVariableDeclaration(k, t)
@@ -23,7 +36,15 @@ func Compile(f *semantic.FunctionExpression, inTypes map[string]semantic.Type, b
 	f = f.Copy().(*semantic.FunctionExpression)
 	semantic.ApplyNewDeclarations(f, declarations)
 
-	root, err := compile(f.Body, builtinScope)
+	c := outer
+	if c == nil {
+		c = &compiler{
+			builtIns: builtinScope,
+			decls:    builtinDeclarations,
+			lambdas:  make(map[*semantic.FunctionExpression]*CompilationCache),
+		}
+	}
+	root, err := c.nested(inTypes).compile(f.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -37,25 +58,64 @@ func Compile(f *semantic.FunctionExpression, inTypes map[string]semantic.Type, b
 	}, nil
 }
 
-func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
+// compiler compiles the nodes of a function body.
+// The types of evaluators are determined from the compiled operands,
+// so that the results of monomorphized function calls are typed correctly.
+type compiler struct {
+	builtIns Scope
+	decls    semantic.DeclarationScope
+
+	// types are the types of the parameters and variables in scope.
+	types map[string]semantic.Type
+	// funcs are the functions declared in scope, they are compiled when called.
+	funcs map[string]*semantic.FunctionExpression
+	// lambdas caches the compiled functions by the types of their arguments.
+	lambdas map[*semantic.FunctionExpression]*CompilationCache
+}
+
+// nested returns a compiler for the body of a function with parameters of the given types.
+func (c *compiler) nested(inTypes map[string]semantic.Type) *compiler {
+	n := &compiler{
+		builtIns: c.builtIns,
+		decls:    c.decls,
+		types:    make(map[string]semantic.Type, len(c.types)+len(inTypes)),
+		funcs:    make(map[string]*semantic.FunctionExpression, len(c.funcs)),
+		lambdas:  c.lambdas,
+	}
+	for k, t := range c.types {
+		n.types[k] = t
+	}
+	for k, f := range c.funcs {
+		n.funcs[k] = f
+	}
+	for k, t := range inTypes {
+		n.types[k] = t
+		delete(n.funcs, k)
+	}
+	return n
+}
+
+func (c *compiler) compile(n semantic.Node) (Evaluator, error) {
 	switch n := n.(type) {
 	case *semantic.BlockStatement:
-		body := make([]Evaluator, len(n.Body))
-		for i, s := range n.Body {
-			node, err := compile(s, builtIns)
+		body := make([]Evaluator, 0, len(n.Body))
+		for _, s := range n.Body {
+			node, err := c.compile(s)
 			if err != nil {
 				return nil, err
 			}
-			body[i] = node
+			if node != nil {
+				body = append(body, node)
+			}
 		}
 		return &blockEvaluator{
-			t:    n.ReturnStatement().Argument.Type(),
+			t:    body[len(body)-1].Type(),
 			body: body,
 		}, nil
 	case *semantic.ExpressionStatement:
 		return nil, errors.New("statement does nothing, sideffects are not supported by the compiler")
 	case *semantic.ReturnStatement:
-		node, err := compile(n.Argument, builtIns)
+		node, err := c.compile(n.Argument)
 		if err != nil {
 			return nil, err
 		}
@@ -63,12 +123,20 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 			Evaluator: node,
 		}, nil
 	case *semantic.NativeVariableDeclaration:
-		node, err := compile(n.Init, builtIns)
+		if f, ok := n.Init.(*semantic.FunctionExpression); ok {
+			// Declared functions are compiled for the types of the arguments of each call.
+			c.funcs[n.Identifier.Name] = f
+			delete(c.types, n.Identifier.Name)
+			return nil, nil
+		}
+		node, err := c.compile(n.Init)
 		if err != nil {
 			return nil, err
 		}
+		c.types[n.Identifier.Name] = node.Type()
+		delete(c.funcs, n.Identifier.Name)
 		return &declarationEvaluator{
-			t:    n.Init.Type(),
+			t:    node.Type(),
 			id:   n.Identifier.Name,
 			init: node,
 		}, nil
@@ -76,7 +144,7 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 		properties := make(map[string]Evaluator, len(n.Properties))
 		propertyTypes := make(map[string]semantic.Type, len(n.Properties))
 		for _, p := range n.Properties {
-			node, err := compile(p.Value, builtIns)
+			node, err := c.compile(p.Value)
 			if err != nil {
 				return nil, err
 			}
@@ -87,8 +155,35 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 			t:          semantic.NewObjectType(propertyTypes),
 			properties: properties,
 		}, nil
+	case *semantic.ArrayExpression:
+		elements := make([]Evaluator, len(n.Elements))
+		for i, el := range n.Elements {
+			node, err := c.compile(el)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = node
+		}
+		t := semantic.EmptyArrayType
+		if len(elements) > 0 {
+			t = semantic.NewArrayType(elements[0].Type())
+		}
+		return &arrayEvaluator{
+			t:        t,
+			elements: elements,
+		}, nil
 	case *semantic.IdentifierExpression:
-		if v, ok := builtIns[n.Name]; ok {
+		if f, ok := c.funcs[n.Name]; ok {
+			// A declared function used as a value, its parameters are typed by their defaults.
+			return c.compile(f)
+		}
+		if t, ok := c.types[n.Name]; ok {
+			return &identifierEvaluator{
+				t:    t,
+				name: n.Name,
+			}, nil
+		}
+		if v, ok := c.builtIns[n.Name]; ok {
 			//Resolve any built in identifiers now
 			return &valueEvaluator{
 				value: v,
@@ -99,14 +194,28 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 			name: n.Name,
 		}, nil
 	case *semantic.MemberExpression:
-		object, err := compile(n.Object, builtIns)
+		object, err := c.compile(n.Object)
 		if err != nil {
 			return nil, err
 		}
+		var t semantic.Type = semantic.Invalid
+		index := 0
+		switch ot := object.Type(); ot.Kind() {
+		case semantic.Object:
+			t = ot.PropertyType(n.Property)
+		case semantic.Array:
+			// Integer properties index into arrays.
+			index, err = strconv.Atoi(n.Property)
+			if err != nil {
+				return nil, fmt.Errorf("invalid array index %q", n.Property)
+			}
+			t = ot.ElementType()
+		}
 		return &memberEvaluator{
-			t:        n.Type(),
+			t:        t,
 			object:   object,
 			property: n.Property,
+			index:    index,
 		}, nil
 	case *semantic.BooleanLiteral:
 		return &booleanEvaluator{
@@ -128,6 +237,32 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 			t: n.Type(),
 			s: n.Value,
 		}, nil
+	case *semantic.StringExpression:
+		parts := make([]Evaluator, len(n.Parts))
+		for i, p := range n.Parts {
+			switch p := p.(type) {
+			case *semantic.TextPart:
+				parts[i] = &stringEvaluator{
+					t: semantic.String,
+					s: p.Value,
+				}
+			case *semantic.InterpolatedPart:
+				node, err := c.compile(p.Expression)
+				if err != nil {
+					return nil, err
+				}
+				if k := node.Type().Kind(); !semantic.IsStringConvertible(k) {
+					return nil, fmt.Errorf("cannot interpolate value of kind %v into a string", k)
+				}
+				parts[i] = node
+			default:
+				return nil, fmt.Errorf("unknown string expression part of type %T", p)
+			}
+		}
+		return &stringExpressionEvaluator{
+			t:     n.Type(),
+			parts: parts,
+		}, nil
 	case *semantic.RegexpLiteral:
 		return &regexpEvaluator{
 			t: n.Type(),
@@ -138,41 +273,91 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 			t:    n.Type(),
 			time: values.ConvertTime(n.Value),
 		}, nil
+	case *semantic.DurationLiteral:
+		return &durationEvaluator{
+			t:        n.Type(),
+			duration: values.Duration(n.Value),
+		}, nil
 	case *semantic.UnaryExpression:
-		node, err := compile(n.Argument, builtIns)
+		node, err := c.compile(n.Argument)
 		if err != nil {
 			return nil, err
 		}
+		t := node.Type()
+		switch n.Operator {
+		case ast.EmptyOperator, ast.NotEmptyOperator:
+			if k := node.Type().Kind(); k != semantic.String && k != semantic.Array {
+				return nil, fmt.Errorf("operand to %v must be a string or an array, got kind %v", n.Operator, k)
+			}
+			t = semantic.Bool
+		case ast.ExistsOperator:
+			t = semantic.Bool
+		}
 		return &unaryEvaluator{
-			t:    n.Type(),
-			node: node,
+			t:        t,
+			operator: n.Operator,
+			node:     node,
 		}, nil
 	case *semantic.LogicalExpression:
-		l, err := compile(n.Left, builtIns)
+		l, err := c.compile(n.Left)
 		if err != nil {
 			return nil, err
 		}
-		r, err := compile(n.Right, builtIns)
+		r, err := c.compile(n.Right)
 		if err != nil {
 			return nil, err
 		}
 		return &logicalEvaluator{
-			t:        n.Type(),
+			t:        semantic.Bool,
 			operator: n.Operator,
 			left:     l,
 			right:    r,
 		}, nil
+	case *semantic.ConditionalExpression:
+		test, err := c.compile(n.Test)
+		if err != nil {
+			return nil, err
+		}
+		if k := test.Type().Kind(); k != semantic.Bool {
+			return nil, fmt.Errorf("test of conditional expression is not a boolean, got kind %v", k)
+		}
+		consequent, err := c.compile(n.Consequent)
+		if err != nil {
+			return nil, err
+		}
+		alternate, err := c.compile(n.Alternate)
+		if err != nil {
+			return nil, err
+		}
+		if ct, at := consequent.Type(), alternate.Type(); ct != at {
+			return nil, fmt.Errorf("branches of conditional expression have different types %v and %v", ct, at)
+		}
+		return &conditionalEvaluator{
+			t:          consequent.Type(),
+			test:       test,
+			consequent: consequent,
+			alternate:  alternate,
+		}, nil
 	case *semantic.BinaryExpression:
-		l, err := compile(n.Left, builtIns)
+		l, err := c.compile(n.Left)
 		if err != nil {
 			return nil, err
 		}
 		lt := l.Type()
-		r, err := compile(n.Right, builtIns)
+		r, err := c.compile(n.Right)
 		if err != nil {
 			return nil, err
 		}
 		rt := r.Type()
+		// An operand that is always null, e.g. a column that does not exist, makes the result null.
+		// The result has the type of the expression with the other operand on both sides.
+		if lt == semantic.Nil && rt != semantic.Nil {
+			lt = rt
+			l = &nullEvaluator{t: rt}
+		} else if rt == semantic.Nil && lt != semantic.Nil {
+			rt = lt
+			r = &nullEvaluator{t: lt}
+		}
 		f, err := values.LookupBinaryFunction(values.BinaryFuncSignature{
 			Operator: n.Operator,
 			Left:     lt,
@@ -182,43 +367,61 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 			return nil, err
 		}
 		return &binaryEvaluator{
-			t:     n.Type(),
+			t:     semantic.BinaryType(n.Operator, lt.Kind(), rt.Kind()),
 			left:  l,
 			right: r,
 			f:     f,
 		}, nil
 	case *semantic.CallExpression:
-		callee, err := compile(n.Callee, builtIns)
+		args, err := c.compile(n.Arguments)
+		if err != nil {
+			return nil, err
+		}
+		if f := c.lambda(n.Callee); f != nil {
+			return c.compileLambdaCall(f, args.(*objEvaluator))
+		}
+		callee, err := c.compile(n.Callee)
 		if err != nil {
 			return nil, err
 		}
-		args, err := compile(n.Arguments, builtIns)
+		ct := callee.Type()
+		if k := ct.Kind(); k != semantic.Function {
+			return nil, fmt.Errorf("cannot call value of kind %v", k)
+		}
+		argTypes := make(map[string]semantic.Type)
+		for k, a := range args.(*objEvaluator).properties {
+			argTypes[k] = a.Type()
+		}
+		t, err := semantic.CallReturnType(ct, argTypes)
 		if err != nil {
 			return nil, err
 		}
 		return &callEvaluator{
-			t:      n.Type(),
+			t:      t,
 			callee: callee,
 			args:   args,
 		}, nil
 	case *semantic.FunctionExpression:
-		body, err := compile(n.Body, builtIns)
-		if err != nil {
-			return nil, err
-		}
 		params := make([]functionParam, len(n.Params))
+		types := make(map[string]semantic.Type, len(n.Params))
 		for i, param := range n.Params {
 			params[i] = functionParam{
 				Key:  param.Key.Name,
 				Type: param.Type(),
 			}
 			if param.Default != nil {
-				d, err := compile(param.Default, builtIns)
+				d, err := c.compile(param.Default)
 				if err != nil {
 					return nil, err
 				}
 				params[i].Default = d
+				params[i].Type = d.Type()
 			}
+			types[param.Key.Name] = params[i].Type
+		}
+		body, err := c.nested(types).compile(n.Body)
+		if err != nil {
+			return nil, err
 		}
 		return &functionEvaluator{
 			t:      n.Type(),
@@ -230,6 +433,68 @@ func compile(n semantic.Node, builtIns Scope) (Evaluator, error) {
 	}
 }
 
+// lambda returns the IFQL function called by callee, or nil if the callee is not an IFQL function.
+func (c *compiler) lambda(callee semantic.Expression) *semantic.FunctionExpression {
+	switch callee := callee.(type) {
+	case *semantic.FunctionExpression:
+		return callee
+	case *semantic.IdentifierExpression:
+		return c.funcs[callee.Name]
+	default:
+		return nil
+	}
+}
+
+// compileLambdaCall compiles a call to f, f is compiled for the types of the arguments.
+// Missing arguments take the value of their defaults.
+func (c *compiler) compileLambdaCall(f *semantic.FunctionExpression, args *objEvaluator) (Evaluator, error) {
+	params := make([]functionParam, len(f.Params))
+	types := make(map[string]semantic.Type, len(f.Params))
+	for i, p := range f.Params {
+		params[i].Key = p.Key.Name
+		if arg, ok := args.properties[p.Key.Name]; ok {
+			params[i].Type = arg.Type()
+		} else if p.Default != nil {
+			d, err := c.compile(p.Default)
+			if err != nil {
+				return nil, err
+			}
+			params[i].Default = d
+			params[i].Type = d.Type()
+		} else {
+			return nil, fmt.Errorf("missing required argument %q", p.Key.Name)
+		}
+		types[p.Key.Name] = params[i].Type
+	}
+	for k := range args.properties {
+		if _, ok := types[k]; !ok {
+			return nil, fmt.Errorf("unexpected argument %q", k)
+		}
+	}
+	cache, ok := c.lambdas[f]
+	if !ok {
+		cache = &CompilationCache{
+			fn: f,
+			root: &compilationCacheNode{
+				scope: c.builtIns,
+				decls: c.decls,
+				outer: c,
+			},
+		}
+		c.lambdas[f] = cache
+	}
+	fn, err := cache.Compile(types)
+	if err != nil {
+		return nil, err
+	}
+	return &lambdaCallEvaluator{
+		t:      fn.Type(),
+		fn:     fn,
+		params: params,
+		args:   args,
+	}, nil
+}
+
 // CompilationCache caches compilation results based on the types of the input parameters.
 type CompilationCache struct {
 	fn   *semantic.FunctionExpression
@@ -255,6 +520,8 @@ func (c *CompilationCache) Compile(types map[string]semantic.Type) (Func, error)
 type compilationCacheNode struct {
 	scope Scope
 	decls semantic.DeclarationScope
+	// outer is the compiler of the function calling fn, if any.
+	outer *compiler
 
 	children map[semantic.Type]*compilationCacheNode
 
@@ -268,7 +535,7 @@ func (c *compilationCacheNode) compile(fn *semantic.FunctionExpression, idx int,
 	if idx == len(fn.Params) {
 		// We are the matching child, return the cached result or do the compilation.
 		if c.fn == nil && c.err == nil {
-			c.fn, c.err = Compile(fn, types, c.scope, c.decls)
+			c.fn, c.err = compileFunction(fn, types, c.scope, c.decls, c.outer)
 		}
 		return c.fn, c.err
 	}
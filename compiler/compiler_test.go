@@ -23,6 +23,8 @@ func ValueEqual(x, y values.Value) bool {
 		return false
 	}
 	switch k := x.Type().Kind(); k {
+	case semantic.Nil:
+		return true
 	case semantic.Bool:
 		return x.Bool() == y.Bool()
 	case semantic.UInt:
@@ -36,7 +38,16 @@ func ValueEqual(x, y values.Value) bool {
 	case semantic.Time:
 		return x.Time() == y.Time()
 	case semantic.Object:
-		return cmp.Equal(x.Object(), y.Object(), CmpOptions...)
+		xo, yo := x.Object(), y.Object()
+		if xo.Len() != yo.Len() {
+			return false
+		}
+		equal := true
+		xo.Range(func(k string, xv values.Value) {
+			yv, ok := yo.Get(k)
+			equal = equal && ok && ValueEqual(xv, yv)
+		})
+		return equal
 	default:
 		return false
 	}
@@ -240,6 +251,409 @@ func TestCompileAndEval(t *testing.T) {
 			want:    values.NewIntValue(5),
 			wantErr: false,
 		},
+		{
+			name: "call untyped function with different types",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BlockStatement{
+					Body: []semantic.Statement{
+						&semantic.NativeVariableDeclaration{
+							Identifier: &semantic.Identifier{Name: "double"}, Init: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{
+									{Key: &semantic.Identifier{Name: "x"}},
+								},
+								Body: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left:     &semantic.IdentifierExpression{Name: "x"},
+									Right:    &semantic.IdentifierExpression{Name: "x"},
+								},
+							},
+						},
+						&semantic.NativeVariableDeclaration{
+							Identifier: &semantic.Identifier{Name: "n"}, Init: &semantic.CallExpression{
+								Callee: &semantic.IdentifierExpression{Name: "double"},
+								Arguments: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{
+										{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.IntegerLiteral{Value: 2}},
+									},
+								},
+							},
+						},
+						&semantic.ReturnStatement{
+							Argument: &semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{Key: &semantic.Identifier{Name: "n"}, Value: &semantic.IdentifierExpression{Name: "n"}},
+									{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.BinaryExpression{
+										Operator: ast.MultiplicationOperator,
+										Left: &semantic.CallExpression{
+											Callee: &semantic.IdentifierExpression{Name: "double"},
+											Arguments: &semantic.ObjectExpression{
+												Properties: []*semantic.Property{
+													{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.MemberExpression{
+														Object:   &semantic.IdentifierExpression{Name: "r"},
+														Property: "_value",
+													}},
+												},
+											},
+										},
+										Right: &semantic.FloatLiteral{Value: 10},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Float,
+				}),
+			},
+			scope: map[string]values.Value{
+				"r": func() values.Value {
+					r := values.NewObject()
+					r.Set("_value", values.NewFloatValue(1.5))
+					return r
+				}(),
+			},
+			want: func() values.Value {
+				r := values.NewObject()
+				r.Set("n", values.NewIntValue(4))
+				r.Set("v", values.NewFloatValue(30))
+				return r
+			}(),
+		},
+		{
+			name: "call function using a local variable",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BlockStatement{
+					Body: []semantic.Statement{
+						&semantic.NativeVariableDeclaration{
+							Identifier: &semantic.Identifier{Name: "offset"},
+							Init:       &semantic.IdentifierExpression{Name: "r"},
+						},
+						&semantic.ReturnStatement{
+							Argument: &semantic.CallExpression{
+								Callee: &semantic.FunctionExpression{
+									Params: []*semantic.FunctionParam{
+										{Key: &semantic.Identifier{Name: "x"}},
+									},
+									Body: &semantic.BinaryExpression{
+										Operator: ast.SubtractionOperator,
+										Left:     &semantic.IdentifierExpression{Name: "x"},
+										Right:    &semantic.IdentifierExpression{Name: "offset"},
+									},
+								},
+								Arguments: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{
+										{Key: &semantic.Identifier{Name: "x"}, Value: &semantic.IntegerLiteral{Value: 10}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Int,
+			},
+			scope: map[string]values.Value{
+				"r": values.NewIntValue(4),
+			},
+			want: values.NewIntValue(6),
+		},
+		{
+			name: "call function missing argument",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.CallExpression{
+					Callee: &semantic.FunctionExpression{
+						Params: []*semantic.FunctionParam{
+							{Key: &semantic.Identifier{Name: "x"}},
+						},
+						Body: &semantic.IdentifierExpression{Name: "x"},
+					},
+					Arguments: &semantic.ObjectExpression{},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Int,
+			},
+			wantErr: true,
+		},
+		{
+			name: "string interpolation",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.StringExpression{
+					Parts: []semantic.StringExpressionPart{
+						&semantic.TextPart{Value: "cpu "},
+						&semantic.InterpolatedPart{
+							Expression: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "host",
+							},
+						},
+						&semantic.TextPart{Value: " is at "},
+						&semantic.InterpolatedPart{
+							Expression: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"host":   semantic.String,
+					"_value": semantic.Float,
+				}),
+			},
+			scope: map[string]values.Value{
+				"r": func() values.Value {
+					r := values.NewObject()
+					r.Set("host", values.NewStringValue("server01"))
+					r.Set("_value", values.NewFloatValue(97.5))
+					return r
+				}(),
+			},
+			want: values.NewStringValue("cpu server01 is at 97.5"),
+		},
+		{
+			name: "conditional expression",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.ConditionalExpression{
+					Test: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanOperator,
+						Left:     &semantic.IdentifierExpression{Name: "r"},
+						Right:    &semantic.FloatLiteral{Value: 90},
+					},
+					Consequent: &semantic.StringLiteral{Value: "crit"},
+					Alternate:  &semantic.StringLiteral{Value: "ok"},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Float,
+			},
+			scope: map[string]values.Value{
+				"r": values.NewFloatValue(95),
+			},
+			want: values.NewStringValue("crit"),
+		},
+		{
+			name: "conditional expression with mismatched branches",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.ConditionalExpression{
+					Test:       &semantic.BooleanLiteral{Value: true},
+					Consequent: &semantic.IdentifierExpression{Name: "r"},
+					Alternate:  &semantic.StringLiteral{Value: "ok"},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Float,
+			},
+			wantErr: true,
+		},
+		{
+			name: "in operator",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.InOperator,
+					Left:     &semantic.IdentifierExpression{Name: "r"},
+					Right: &semantic.ArrayExpression{
+						Elements: []semantic.Expression{
+							&semantic.StringLiteral{Value: "a"},
+							&semantic.StringLiteral{Value: "b"},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.String,
+			},
+			scope: map[string]values.Value{
+				"r": values.NewStringValue("b"),
+			},
+			want: values.NewBoolValue(true),
+		},
+		{
+			name: "not empty operator",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.UnaryExpression{
+					Operator: ast.NotEmptyOperator,
+					Argument: &semantic.IdentifierExpression{Name: "r"},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.String,
+			},
+			scope: map[string]values.Value{
+				"r": values.NewStringValue(""),
+			},
+			want: values.NewBoolValue(false),
+		},
+		{
+			name: "empty operator on float",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.UnaryExpression{
+					Operator: ast.EmptyOperator,
+					Argument: &semantic.IdentifierExpression{Name: "r"},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.Float,
+			},
+			wantErr: true,
+		},
+		{
+			name: "exists operator on null property",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.UnaryExpression{
+					Operator: ast.ExistsOperator,
+					Argument: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_value",
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Nil,
+				}),
+			},
+			scope: map[string]values.Value{
+				"r": func() values.Value {
+					r := values.NewObject()
+					r.Set("_value", values.Null)
+					return r
+				}(),
+			},
+			want: values.NewBoolValue(false),
+		},
+		{
+			name: "binary expression with null property",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.BinaryExpression{
+					Operator: ast.AdditionOperator,
+					Left: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "r"},
+						Property: "_value",
+					},
+					Right: &semantic.FloatLiteral{Value: 1},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"_value": semantic.Nil,
+				}),
+			},
+			scope: map[string]values.Value{
+				"r": func() values.Value {
+					r := values.NewObject()
+					r.Set("_value", values.Null)
+					return r
+				}(),
+			},
+			want: values.Null,
+		},
+		{
+			name: "array index",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "a"}},
+				},
+				Body: &semantic.MemberExpression{
+					Object:   &semantic.IdentifierExpression{Name: "a"},
+					Property: "1",
+				},
+			},
+			types: map[string]semantic.Type{
+				"a": semantic.NewArrayType(semantic.String),
+			},
+			scope: map[string]values.Value{
+				"a": values.NewArrayWithBacking(semantic.String, []values.Value{
+					values.NewStringValue("east"),
+					values.NewStringValue("west"),
+				}),
+			},
+			want: values.NewStringValue("west"),
+		},
+		{
+			name: "exists operator on array index out of bounds",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "a"}},
+				},
+				Body: &semantic.UnaryExpression{
+					Operator: ast.ExistsOperator,
+					Argument: &semantic.MemberExpression{
+						Object:   &semantic.IdentifierExpression{Name: "a"},
+						Property: "2",
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"a": semantic.NewArrayType(semantic.String),
+			},
+			scope: map[string]values.Value{
+				"a": values.NewArrayWithBacking(semantic.String, []values.Value{
+					values.NewStringValue("east"),
+				}),
+			},
+			want: values.NewBoolValue(false),
+		},
+		{
+			name: "string interpolation of object",
+			fn: &semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{
+					{Key: &semantic.Identifier{Name: "r"}},
+				},
+				Body: &semantic.StringExpression{
+					Parts: []semantic.StringExpressionPart{
+						&semantic.InterpolatedPart{
+							Expression: &semantic.IdentifierExpression{Name: "r"},
+						},
+					},
+				},
+			},
+			types: map[string]semantic.Type{
+				"r": semantic.NewObjectType(map[string]semantic.Type{
+					"host": semantic.String,
+				}),
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -249,6 +663,9 @@ func TestCompileAndEval(t *testing.T) {
 			if tc.wantErr != (err != nil) {
 				t.Fatalf("unexpected error %s", err)
 			}
+			if err != nil {
+				return
+			}
 
 			got, err := f.Eval(tc.scope)
 			if tc.wantErr != (err != nil) {
@@ -261,3 +678,77 @@ func TestCompileAndEval(t *testing.T) {
 		})
 	}
 }
+
+func TestCompile_Format(t *testing.T) {
+	ft := semantic.NewFunctionType(semantic.FunctionSignature{
+		Params:      map[string]semantic.Type{"format": semantic.String, "args": semantic.Array},
+		ReturnType:  semantic.String,
+		FormatParam: "format",
+		FormatArgs:  "args",
+	})
+	format := values.NewFunction("format", ft, func(args values.Object) (values.Value, error) {
+		f, _ := args.Get("format")
+		return f, nil
+	})
+	scope := compiler.Scope{"format": format}
+	declarations := semantic.DeclarationScope{
+		"format": semantic.NewExternalVariableDeclaration("format", ft),
+	}
+	call := func(format string) *semantic.FunctionExpression {
+		return &semantic.FunctionExpression{
+			Params: []*semantic.FunctionParam{
+				{Key: &semantic.Identifier{Name: "r"}},
+			},
+			Body: &semantic.CallExpression{
+				Callee: &semantic.IdentifierExpression{Name: "format"},
+				Arguments: &semantic.ObjectExpression{
+					Properties: []*semantic.Property{
+						{Key: &semantic.Identifier{Name: "format"}, Value: &semantic.StringLiteral{Value: format}},
+						{Key: &semantic.Identifier{Name: "args"}, Value: &semantic.ArrayExpression{
+							Elements: []semantic.Expression{
+								&semantic.StringLiteral{Value: "a"},
+								&semantic.IdentifierExpression{Name: "r"},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+	types := map[string]semantic.Type{"r": semantic.Float}
+
+	f, err := compiler.Compile(call("%s %f"), types, scope, declarations.Copy())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := f.Eval(compiler.Scope{"r": values.NewFloatValue(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := values.NewStringValue("%s %f"); !cmp.Equal(want, got, CmpOptions...) {
+		t.Errorf("unexpected value -want/+got\n%s", cmp.Diff(want, got, CmpOptions...))
+	}
+
+	if _, err := compiler.Compile(call("%s %d"), types, scope, declarations.Copy()); err == nil {
+		t.Error("expected error formatting a float as an integer")
+	}
+
+	// The elements of arguments that are not an array literal are checked by their type.
+	args := &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{
+			{Key: &semantic.Identifier{Name: "a"}},
+		},
+		Body: &semantic.CallExpression{
+			Callee: &semantic.IdentifierExpression{Name: "format"},
+			Arguments: &semantic.ObjectExpression{
+				Properties: []*semantic.Property{
+					{Key: &semantic.Identifier{Name: "format"}, Value: &semantic.StringLiteral{Value: "%d"}},
+					{Key: &semantic.Identifier{Name: "args"}, Value: &semantic.IdentifierExpression{Name: "a"}},
+				},
+			},
+		},
+	}
+	if _, err := compiler.Compile(args, map[string]semantic.Type{"a": semantic.NewArrayType(semantic.String)}, scope, declarations.Copy()); err == nil {
+		t.Error("expected error formatting a string as an integer")
+	}
+}
@@ -3,6 +3,7 @@ package compiler
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/semantic"
@@ -59,10 +60,12 @@ func (c compiledFn) Type() semantic.Type {
 	return c.root.Type()
 }
 
-func (c compiledFn) Eval(scope Scope) (values.Value, error) {
+func (c compiledFn) Eval(scope Scope) (v values.Value, err error) {
 	if err := c.validate(scope); err != nil {
 		return nil, err
 	}
+	defer recoverCallError(&err)
+	defer recoverNull(&v)
 	switch c.Type().Kind() {
 	case semantic.String:
 		return values.NewStringValue(c.root.EvalString(scope)), nil
@@ -238,6 +241,57 @@ func eval(e Evaluator, scope Scope) values.Value {
 	}
 }
 
+// nullError is raised as a panic when a null value is used where a value is required.
+// It is recovered where a null value is permitted, i.e. as the value of an object property
+// or as the result of a function, making that value null.
+type nullError struct{}
+
+func (nullError) Error() string {
+	return "unexpected null value"
+}
+
+// checkNull panics with a nullError if v is null.
+func checkNull(v values.Value) values.Value {
+	if values.IsNull(v) {
+		panic(nullError{})
+	}
+	return v
+}
+
+// recoverNull sets v to null if a null value was used during evaluation.
+// It must be deferred.
+func recoverNull(v *values.Value) {
+	if r := recover(); r != nil {
+		if _, ok := r.(nullError); !ok {
+			panic(r)
+		}
+		*v = values.Null
+	}
+}
+
+// callError is raised as a panic when a function called during evaluation returns an error.
+type callError struct {
+	err error
+}
+
+// recoverCallError sets err to the error of a failed function call.
+// It must be deferred.
+func recoverCallError(err *error) {
+	if r := recover(); r != nil {
+		e, ok := r.(callError)
+		if !ok {
+			panic(r)
+		}
+		*err = e.err
+	}
+}
+
+// evalNullable evaluates e, the result is null if a null value was used during evaluation.
+func evalNullable(e Evaluator, scope Scope) (v values.Value) {
+	defer recoverNull(&v)
+	return eval(e, scope)
+}
+
 type blockEvaluator struct {
 	t     semantic.Type
 	body  []Evaluator
@@ -373,6 +427,53 @@ func (e *declarationEvaluator) EvalFunction(scope Scope) values.Function {
 	return scope.GetFunction(e.id)
 }
 
+type arrayEvaluator struct {
+	t        semantic.Type
+	elements []Evaluator
+}
+
+func (e *arrayEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *arrayEvaluator) EvalString(scope Scope) string {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.String))
+}
+func (e *arrayEvaluator) EvalInt(scope Scope) int64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Int))
+}
+func (e *arrayEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.UInt))
+}
+func (e *arrayEvaluator) EvalFloat(scope Scope) float64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Float))
+}
+func (e *arrayEvaluator) EvalBool(scope Scope) bool {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Bool))
+}
+func (e *arrayEvaluator) EvalTime(scope Scope) values.Time {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Time))
+}
+func (e *arrayEvaluator) EvalDuration(scope Scope) values.Duration {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Duration))
+}
+func (e *arrayEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Regexp))
+}
+func (e *arrayEvaluator) EvalArray(scope Scope) values.Array {
+	elements := make([]values.Value, len(e.elements))
+	for i, el := range e.elements {
+		elements[i] = eval(el, scope)
+	}
+	return values.NewArrayWithBacking(e.t.ElementType(), elements)
+}
+func (e *arrayEvaluator) EvalObject(scope Scope) values.Object {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Object))
+}
+func (e *arrayEvaluator) EvalFunction(scope Scope) values.Function {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Function))
+}
+
 type objEvaluator struct {
 	t          semantic.Type
 	properties map[string]Evaluator
@@ -412,7 +513,7 @@ func (e *objEvaluator) EvalArray(scope Scope) values.Array {
 func (e *objEvaluator) EvalObject(scope Scope) values.Object {
 	obj := values.NewObject()
 	for k, node := range e.properties {
-		v := eval(node, scope)
+		v := evalNullable(node, scope)
 		obj.Set(k, v)
 	}
 	return obj
@@ -472,6 +573,68 @@ func (e *logicalEvaluator) EvalFunction(scope Scope) values.Function {
 	panic(values.UnexpectedKind(e.t.Kind(), semantic.Function))
 }
 
+type conditionalEvaluator struct {
+	t                           semantic.Type
+	test, consequent, alternate Evaluator
+}
+
+func (e *conditionalEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+// branch evaluates the test and returns the branch to evaluate.
+func (e *conditionalEvaluator) branch(scope Scope) Evaluator {
+	if e.test.EvalBool(scope) {
+		return e.consequent
+	}
+	return e.alternate
+}
+
+func (e *conditionalEvaluator) EvalString(scope Scope) string {
+	values.CheckKind(e.t.Kind(), semantic.String)
+	return e.branch(scope).EvalString(scope)
+}
+func (e *conditionalEvaluator) EvalInt(scope Scope) int64 {
+	values.CheckKind(e.t.Kind(), semantic.Int)
+	return e.branch(scope).EvalInt(scope)
+}
+func (e *conditionalEvaluator) EvalUInt(scope Scope) uint64 {
+	values.CheckKind(e.t.Kind(), semantic.UInt)
+	return e.branch(scope).EvalUInt(scope)
+}
+func (e *conditionalEvaluator) EvalFloat(scope Scope) float64 {
+	values.CheckKind(e.t.Kind(), semantic.Float)
+	return e.branch(scope).EvalFloat(scope)
+}
+func (e *conditionalEvaluator) EvalBool(scope Scope) bool {
+	values.CheckKind(e.t.Kind(), semantic.Bool)
+	return e.branch(scope).EvalBool(scope)
+}
+func (e *conditionalEvaluator) EvalTime(scope Scope) values.Time {
+	values.CheckKind(e.t.Kind(), semantic.Time)
+	return e.branch(scope).EvalTime(scope)
+}
+func (e *conditionalEvaluator) EvalDuration(scope Scope) values.Duration {
+	values.CheckKind(e.t.Kind(), semantic.Duration)
+	return e.branch(scope).EvalDuration(scope)
+}
+func (e *conditionalEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	values.CheckKind(e.t.Kind(), semantic.Regexp)
+	return e.branch(scope).EvalRegexp(scope)
+}
+func (e *conditionalEvaluator) EvalArray(scope Scope) values.Array {
+	values.CheckKind(e.t.Kind(), semantic.Array)
+	return e.branch(scope).EvalArray(scope)
+}
+func (e *conditionalEvaluator) EvalObject(scope Scope) values.Object {
+	values.CheckKind(e.t.Kind(), semantic.Object)
+	return e.branch(scope).EvalObject(scope)
+}
+func (e *conditionalEvaluator) EvalFunction(scope Scope) values.Function {
+	values.CheckKind(e.t.Kind(), semantic.Function)
+	return e.branch(scope).EvalFunction(scope)
+}
+
 type binaryFunc func(scope Scope, left, right Evaluator) values.Value
 
 type binarySignature struct {
@@ -528,8 +691,9 @@ func (e *binaryEvaluator) EvalFunction(scope Scope) values.Function {
 }
 
 type unaryEvaluator struct {
-	t    semantic.Type
-	node Evaluator
+	t        semantic.Type
+	operator ast.OperatorKind
+	node     Evaluator
 }
 
 func (e *unaryEvaluator) Type() semantic.Type {
@@ -551,8 +715,29 @@ func (e *unaryEvaluator) EvalFloat(scope Scope) float64 {
 	return -e.node.EvalFloat(scope)
 }
 func (e *unaryEvaluator) EvalBool(scope Scope) bool {
-	// There is only one boolean unary operator
-	return !e.node.EvalBool(scope)
+	switch e.operator {
+	case ast.EmptyOperator:
+		return e.empty(scope)
+	case ast.NotEmptyOperator:
+		return !e.empty(scope)
+	case ast.ExistsOperator:
+		return e.exists(scope)
+	default:
+		return !e.node.EvalBool(scope)
+	}
+}
+func (e *unaryEvaluator) empty(scope Scope) bool {
+	if e.node.Type().Kind() == semantic.Array {
+		return e.node.EvalArray(scope).Len() == 0
+	}
+	return e.node.EvalString(scope) == ""
+}
+func (e *unaryEvaluator) exists(scope Scope) bool {
+	if m, ok := e.node.(*memberEvaluator); ok {
+		v, ok := m.get(scope)
+		return ok && !values.IsNull(v)
+	}
+	return !values.IsNull(evalNullable(e.node, scope))
 }
 func (e *unaryEvaluator) EvalTime(scope Scope) values.Time {
 	panic(values.UnexpectedKind(e.t.Kind(), semantic.Time))
@@ -660,6 +845,57 @@ func (e *stringEvaluator) EvalFunction(scope Scope) values.Function {
 	panic(values.UnexpectedKind(e.t.Kind(), semantic.Function))
 }
 
+type stringExpressionEvaluator struct {
+	t     semantic.Type
+	parts []Evaluator
+}
+
+func (e *stringExpressionEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *stringExpressionEvaluator) EvalString(scope Scope) string {
+	var b strings.Builder
+	for _, p := range e.parts {
+		s, err := values.ToString(eval(p, scope))
+		if err != nil {
+			panic(err)
+		}
+		b.WriteString(s)
+	}
+	return b.String()
+}
+func (e *stringExpressionEvaluator) EvalInt(scope Scope) int64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Int))
+}
+func (e *stringExpressionEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.UInt))
+}
+func (e *stringExpressionEvaluator) EvalFloat(scope Scope) float64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Float))
+}
+func (e *stringExpressionEvaluator) EvalBool(scope Scope) bool {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Bool))
+}
+func (e *stringExpressionEvaluator) EvalTime(scope Scope) values.Time {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Time))
+}
+func (e *stringExpressionEvaluator) EvalDuration(scope Scope) values.Duration {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Duration))
+}
+func (e *stringExpressionEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Regexp))
+}
+func (e *stringExpressionEvaluator) EvalArray(scope Scope) values.Array {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Array))
+}
+func (e *stringExpressionEvaluator) EvalObject(scope Scope) values.Object {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Object))
+}
+func (e *stringExpressionEvaluator) EvalFunction(scope Scope) values.Function {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Function))
+}
+
 type regexpEvaluator struct {
 	t semantic.Type
 	r *regexp.Regexp
@@ -832,6 +1068,92 @@ func (e *timeEvaluator) EvalFunction(scope Scope) values.Function {
 	panic(values.UnexpectedKind(e.t.Kind(), semantic.Function))
 }
 
+type durationEvaluator struct {
+	t        semantic.Type
+	duration values.Duration
+}
+
+func (e *durationEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *durationEvaluator) EvalString(scope Scope) string {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.String))
+}
+func (e *durationEvaluator) EvalInt(scope Scope) int64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Int))
+}
+func (e *durationEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.UInt))
+}
+func (e *durationEvaluator) EvalFloat(scope Scope) float64 {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Float))
+}
+func (e *durationEvaluator) EvalBool(scope Scope) bool {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Bool))
+}
+func (e *durationEvaluator) EvalTime(scope Scope) values.Time {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Time))
+}
+func (e *durationEvaluator) EvalDuration(scope Scope) values.Duration {
+	return e.duration
+}
+func (e *durationEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Regexp))
+}
+func (e *durationEvaluator) EvalArray(scope Scope) values.Array {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Array))
+}
+func (e *durationEvaluator) EvalObject(scope Scope) values.Object {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Object))
+}
+func (e *durationEvaluator) EvalFunction(scope Scope) values.Function {
+	panic(values.UnexpectedKind(e.t.Kind(), semantic.Function))
+}
+
+// nullEvaluator is an expression of type t whose value is always null.
+type nullEvaluator struct {
+	t semantic.Type
+}
+
+func (e *nullEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *nullEvaluator) EvalString(scope Scope) string {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalInt(scope Scope) int64 {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalUInt(scope Scope) uint64 {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalFloat(scope Scope) float64 {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalBool(scope Scope) bool {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalTime(scope Scope) values.Time {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalDuration(scope Scope) values.Duration {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalArray(scope Scope) values.Array {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalObject(scope Scope) values.Object {
+	panic(nullError{})
+}
+func (e *nullEvaluator) EvalFunction(scope Scope) values.Function {
+	panic(nullError{})
+}
+
 type identifierEvaluator struct {
 	t    semantic.Type
 	name string
@@ -921,6 +1243,7 @@ type memberEvaluator struct {
 	t        semantic.Type
 	object   Evaluator
 	property string
+	index    int
 }
 
 func (e *memberEvaluator) Type() semantic.Type {
@@ -928,48 +1251,56 @@ func (e *memberEvaluator) Type() semantic.Type {
 }
 
 func (e *memberEvaluator) EvalString(scope Scope) string {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Str()
+	return e.value(scope).Str()
 }
 func (e *memberEvaluator) EvalInt(scope Scope) int64 {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Int()
+	return e.value(scope).Int()
 }
 func (e *memberEvaluator) EvalUInt(scope Scope) uint64 {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.UInt()
+	return e.value(scope).UInt()
 }
 func (e *memberEvaluator) EvalFloat(scope Scope) float64 {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Float()
+	return e.value(scope).Float()
 }
 func (e *memberEvaluator) EvalBool(scope Scope) bool {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Bool()
+	return e.value(scope).Bool()
 }
 func (e *memberEvaluator) EvalTime(scope Scope) values.Time {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Time()
+	return e.value(scope).Time()
 }
 func (e *memberEvaluator) EvalDuration(scope Scope) values.Duration {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Duration()
+	return e.value(scope).Duration()
 }
 func (e *memberEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Regexp()
+	return e.value(scope).Regexp()
 }
 func (e *memberEvaluator) EvalArray(scope Scope) values.Array {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Array()
+	return e.value(scope).Array()
 }
 func (e *memberEvaluator) EvalObject(scope Scope) values.Object {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Object()
+	return e.value(scope).Object()
 }
 func (e *memberEvaluator) EvalFunction(scope Scope) values.Function {
-	v, _ := e.object.EvalObject(scope).Get(e.property)
-	return v.Function()
+	return e.value(scope).Function()
+}
+
+// value returns the value of the property, a missing property is null.
+func (e *memberEvaluator) value(scope Scope) values.Value {
+	v, _ := e.get(scope)
+	return checkNull(v)
+}
+
+// get returns the value of the property and whether it exists.
+// The property of an array is the index of an element, an index out of bounds does not exist.
+func (e *memberEvaluator) get(scope Scope) (values.Value, bool) {
+	if e.object.Type().Kind() == semantic.Array {
+		a := e.object.EvalArray(scope)
+		if e.index < 0 || e.index >= a.Len() {
+			return nil, false
+		}
+		return a.Get(e.index), true
+	}
+	return e.object.EvalObject(scope).Get(e.property)
 }
 
 type callEvaluator struct {
@@ -985,9 +1316,11 @@ func (e *callEvaluator) Type() semantic.Type {
 func (e *callEvaluator) eval(scope Scope) values.Value {
 	args := e.args.EvalObject(scope)
 	f := e.callee.EvalFunction(scope)
-	//TODO(nathanielc): What to do about error when calling functions?
-	v, _ := f.Call(args)
-	return v
+	v, err := f.Call(args)
+	if err != nil {
+		panic(callError{err: err})
+	}
+	return checkNull(v)
 }
 
 func (e *callEvaluator) EvalString(scope Scope) string {
@@ -1024,6 +1357,70 @@ func (e *callEvaluator) EvalFunction(scope Scope) values.Function {
 	return e.eval(scope).Function()
 }
 
+// lambdaCallEvaluator calls an IFQL function compiled for the types of the arguments of the call.
+type lambdaCallEvaluator struct {
+	t      semantic.Type
+	fn     Func
+	params []functionParam
+	args   Evaluator
+}
+
+func (e *lambdaCallEvaluator) Type() semantic.Type {
+	return e.t
+}
+
+func (e *lambdaCallEvaluator) eval(scope Scope) values.Value {
+	args := e.args.EvalObject(scope)
+	fnScope := scope.Copy()
+	for _, p := range e.params {
+		v, ok := args.Get(p.Key)
+		if !ok {
+			v = eval(p.Default, scope)
+		}
+		// The function was compiled for non null arguments, so a null argument makes the result null.
+		fnScope.Set(p.Key, checkNull(v))
+	}
+	v, err := e.fn.Eval(fnScope)
+	if err != nil {
+		panic(callError{err: err})
+	}
+	return checkNull(v)
+}
+
+func (e *lambdaCallEvaluator) EvalString(scope Scope) string {
+	return e.eval(scope).Str()
+}
+func (e *lambdaCallEvaluator) EvalInt(scope Scope) int64 {
+	return e.eval(scope).Int()
+}
+func (e *lambdaCallEvaluator) EvalUInt(scope Scope) uint64 {
+	return e.eval(scope).UInt()
+}
+func (e *lambdaCallEvaluator) EvalFloat(scope Scope) float64 {
+	return e.eval(scope).Float()
+}
+func (e *lambdaCallEvaluator) EvalBool(scope Scope) bool {
+	return e.eval(scope).Bool()
+}
+func (e *lambdaCallEvaluator) EvalTime(scope Scope) values.Time {
+	return e.eval(scope).Time()
+}
+func (e *lambdaCallEvaluator) EvalDuration(scope Scope) values.Duration {
+	return e.eval(scope).Duration()
+}
+func (e *lambdaCallEvaluator) EvalRegexp(scope Scope) *regexp.Regexp {
+	return e.eval(scope).Regexp()
+}
+func (e *lambdaCallEvaluator) EvalArray(scope Scope) values.Array {
+	return e.eval(scope).Array()
+}
+func (e *lambdaCallEvaluator) EvalObject(scope Scope) values.Object {
+	return e.eval(scope).Object()
+}
+func (e *lambdaCallEvaluator) EvalFunction(scope Scope) values.Function {
+	return e.eval(scope).Function()
+}
+
 type functionEvaluator struct {
 	t      semantic.Type
 	body   Evaluator
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/semantic"
@@ -82,7 +83,7 @@ func (c Completer) FunctionSuggestion(name string) (FunctionSuggestion, error) {
 	params := map[string]string{}
 
 	for k, v := range funcType.Params() {
-		params[k] = v.Kind().String()
+		params[k] = typeString(v)
 	}
 
 	s = FunctionSuggestion{
@@ -92,6 +93,19 @@ func (c Completer) FunctionSuggestion(name string) (FunctionSuggestion, error) {
 	return s, nil
 }
 
+// typeString returns the kind of t, constrained type variables are the kinds they may be.
+func typeString(t semantic.Type) string {
+	kinds := semantic.TypeVarKinds(t)
+	if len(kinds) == 0 {
+		return t.Kind().String()
+	}
+	names := make([]string, len(kinds))
+	for i, k := range kinds {
+		names[i] = k.String()
+	}
+	return strings.Join(names, "|")
+}
+
 func isFunction(d semantic.VariableDeclaration) bool {
 	return d.InitType().Kind() == semantic.Function
 }
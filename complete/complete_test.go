@@ -78,8 +78,8 @@ func TestFunctionSuggestion(t *testing.T) {
 
 	expected := FunctionSuggestion{
 		Params: map[string]string{
-			"start": semantic.Time.String(),
-			"stop":  semantic.Time.String(),
+			"start": semantic.Time.String() + "|" + semantic.Duration.String(),
+			"stop":  semantic.Time.String() + "|" + semantic.Duration.String(),
 			"table": query.TableObjectType.Kind().String(),
 		},
 	}
@@ -0,0 +1,877 @@
+// Package csv contains the csv result encoders and decoders.
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultDelimiter = ','
+	commentPrefix    = "#"
+
+	resultLabel    = "result"
+	tableLabel     = "table"
+	errorLabel     = "error"
+	referenceLabel = "reference"
+
+	DatatypeAnnotation  = "datatype"
+	PartitionAnnotation = "partition"
+	DefaultAnnotation   = "default"
+
+	boolDatatype   = "boolean"
+	intDatatype    = "long"
+	uintDatatype   = "unsignedlong"
+	floatDatatype  = "double"
+	stringDatatype = "string"
+	timeDatatype   = "dateTime"
+
+	RFC3339Format     = "RFC3339"
+	RFC3339NanoFormat = "RFC3339Nano"
+
+	// fixedWidthTimeFmt is used for the RFC3339Nano format, so that all values have the same width.
+	fixedWidthTimeFmt = "2006-01-02T15:04:05.000000000Z07:00"
+)
+
+// ResultDecoderConfig are options that can be specified on the ResultDecoder.
+type ResultDecoderConfig struct {
+	// Delimiter is the character that delimites columns.
+	Delimiter rune
+	// CommentPrefix is the prefix of annotation rows, defaults to "#".
+	CommentPrefix string
+	// Allocator is used to allocate the decoded blocks.
+	// If nil, an unlimited allocator is used.
+	Allocator *execute.Allocator
+}
+
+// ResultDecoder decodes the annotated CSV format defined in the SPEC into results.
+// The datatype annotation and the header row are required in order to decode the data.
+type ResultDecoder struct {
+	c ResultDecoderConfig
+}
+
+// NewResultDecoder creates a new decoder with the provided configuration.
+func NewResultDecoder(c ResultDecoderConfig) *ResultDecoder {
+	if c.Delimiter == 0 {
+		c.Delimiter = defaultDelimiter
+	}
+	if c.CommentPrefix == "" {
+		c.CommentPrefix = commentPrefix
+	}
+	if c.Allocator == nil {
+		c.Allocator = &execute.Allocator{Limit: math.MaxInt64}
+	}
+	return &ResultDecoder{
+		c: c,
+	}
+}
+
+// Result is a decoded result.
+type Result struct {
+	Name   string
+	blocks []execute.Block
+}
+
+func (r *Result) Blocks() execute.BlockIterator {
+	return r
+}
+
+func (r *Result) Do(f func(execute.Block) error) error {
+	for _, b := range r.blocks {
+		if err := f(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Decode reads all data from r and returns the results in the order they were encoded.
+func (d *ResultDecoder) Decode(r io.Reader) ([]*Result, error) {
+	reader := csv.NewReader(r)
+	reader.Comma = d.c.Delimiter
+	// Annotation rows and records for different schemas may have differing lengths.
+	reader.FieldsPerRecord = -1
+
+	state := &decodeState{
+		alloc:         d.c.Allocator,
+		commentPrefix: d.c.CommentPrefix,
+	}
+	line := 0
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		line++
+		if err != nil {
+			return nil, err
+		}
+		if err := state.decodeRecord(record); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode record %d", line)
+		}
+	}
+	if err := state.finishSchema(); err != nil {
+		return nil, err
+	}
+	return state.results, nil
+}
+
+type decodeState struct {
+	alloc         *execute.Allocator
+	commentPrefix string
+	results       []*Result
+
+	// inAnnotations is true while annotation rows are being read.
+	inAnnotations bool
+	// needHeader is true once annotations have been read and the header row is expected.
+	needHeader  bool
+	annotations map[string][]string
+	cols        []colMeta
+	defaults    []string
+	// records counts the number of records decoded for the current schema.
+	records int
+
+	resultName string
+	tableID    string
+	builder    *execute.ColListBlockBuilder
+}
+
+func (s *decodeState) decodeRecord(record []string) error {
+	if strings.HasPrefix(record[0], s.commentPrefix) {
+		if !s.inAnnotations {
+			// Annotations always start a new schema
+			if err := s.finishSchema(); err != nil {
+				return err
+			}
+			s.inAnnotations = true
+			s.annotations = make(map[string][]string)
+		}
+		s.annotations[strings.TrimPrefix(record[0], s.commentPrefix)] = record[1:]
+		return nil
+	}
+	if s.inAnnotations {
+		s.inAnnotations = false
+		s.needHeader = true
+	}
+	if s.needHeader {
+		s.needHeader = false
+		return s.decodeHeader(record[1:])
+	}
+	if s.cols == nil {
+		return errors.New("missing annotations and header, cannot decode records")
+	}
+	return s.decodeData(record[1:])
+}
+
+func (s *decodeState) decodeHeader(labels []string) error {
+	types, ok := s.annotations[DatatypeAnnotation]
+	if !ok {
+		return errors.New("missing datatype annotation")
+	}
+	if len(labels) < 2 || labels[0] != resultLabel || labels[1] != tableLabel {
+		return fmt.Errorf("header must start with the %q and %q columns", resultLabel, tableLabel)
+	}
+	if len(types) != len(labels) {
+		return fmt.Errorf("datatype annotation has %d columns, header has %d", len(types), len(labels))
+	}
+	partitions := s.annotations[PartitionAnnotation]
+	s.defaults = s.annotations[DefaultAnnotation]
+	if s.defaults != nil && len(s.defaults) != len(labels) {
+		return fmt.Errorf("default annotation has %d columns, header has %d", len(s.defaults), len(labels))
+	}
+
+	s.cols = make([]colMeta, len(labels)-2)
+	for j, label := range labels[2:] {
+		typ, err := decodeType(types[j+2])
+		if err != nil {
+			return errors.Wrapf(err, "column %q", label)
+		}
+		s.cols[j] = colMeta{
+			ColMeta: execute.ColMeta{
+				Label: label,
+				Type:  typ,
+			},
+			partition: len(partitions) > j+2 && partitions[j+2] == "true",
+		}
+	}
+	return nil
+}
+
+func (s *decodeState) value(record []string, j int) string {
+	if record[j] == "" && s.defaults != nil {
+		return s.defaults[j]
+	}
+	return record[j]
+}
+
+func (s *decodeState) decodeData(record []string) error {
+	if len(record) != len(s.cols)+2 {
+		return fmt.Errorf("record has %d columns, expected %d", len(record), len(s.cols)+2)
+	}
+	s.records++
+	name := s.value(record, 0)
+	tableID := s.value(record, 1)
+	if s.builder == nil || name != s.resultName || tableID != s.tableID {
+		if err := s.finishBlock(); err != nil {
+			return err
+		}
+		key, err := s.partitionKey(record[2:])
+		if err != nil {
+			return err
+		}
+		s.startBlock(name, tableID, key)
+	}
+	for j, c := range s.cols {
+		if err := appendValue(s.builder, j, c.Type, s.value(record, j+2)); err != nil {
+			return errors.Wrapf(err, "column %q", c.Label)
+		}
+	}
+	return nil
+}
+
+// partitionKey creates the partition key using the values from the record.
+func (s *decodeState) partitionKey(values []string) (execute.PartitionKey, error) {
+	var cols []execute.ColMeta
+	var vs []interface{}
+	for j, c := range s.cols {
+		if !c.partition {
+			continue
+		}
+		v := values[j]
+		if v == "" && s.defaults != nil {
+			v = s.defaults[j+2]
+		}
+		var value interface{}
+		if !isNullValue(c.Type, v) {
+			var err error
+			value, err = decodeValue(c.Type, v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "column %q", c.Label)
+			}
+		}
+		cols = append(cols, c.ColMeta)
+		vs = append(vs, value)
+	}
+	return execute.NewPartitionKey(cols, vs), nil
+}
+
+func (s *decodeState) startBlock(name, tableID string, key execute.PartitionKey) {
+	s.resultName = name
+	s.tableID = tableID
+	s.builder = execute.NewColListBlockBuilder(key, s.alloc)
+	for _, c := range s.cols {
+		s.builder.AddCol(c.ColMeta)
+	}
+}
+
+func (s *decodeState) finishBlock() error {
+	if s.builder == nil {
+		return nil
+	}
+	b, err := s.builder.Block()
+	if err != nil {
+		return err
+	}
+	s.builder = nil
+
+	var r *Result
+	if l := len(s.results); l > 0 && s.results[l-1].Name == s.resultName {
+		r = s.results[l-1]
+	} else {
+		r = &Result{Name: s.resultName}
+		s.results = append(s.results, r)
+	}
+	r.blocks = append(r.blocks, b)
+	return nil
+}
+
+// finishSchema completes any block using the current schema.
+// A schema without records but with defaults represents an empty table.
+func (s *decodeState) finishSchema() error {
+	if s.cols != nil && s.records == 0 && s.defaults != nil {
+		key, err := s.partitionKey(make([]string, len(s.cols)))
+		if err != nil {
+			return err
+		}
+		s.startBlock(s.defaults[0], s.defaults[1], key)
+	}
+	if err := s.finishBlock(); err != nil {
+		return err
+	}
+	s.cols = nil
+	s.defaults = nil
+	s.records = 0
+	return nil
+}
+
+func decodeType(datatype string) (execute.DataType, error) {
+	// Remove any format description, i.e. dateTime:RFC3339
+	if i := strings.IndexRune(datatype, ':'); i >= 0 {
+		datatype = datatype[:i]
+	}
+	switch datatype {
+	case boolDatatype:
+		return execute.TBool, nil
+	case intDatatype:
+		return execute.TInt, nil
+	case uintDatatype:
+		return execute.TUInt, nil
+	case floatDatatype:
+		return execute.TFloat, nil
+	case stringDatatype:
+		return execute.TString, nil
+	case timeDatatype:
+		return execute.TTime, nil
+	default:
+		return execute.TInvalid, fmt.Errorf("unsupported datatype %q", datatype)
+	}
+}
+
+func decodeValue(typ execute.DataType, value string) (interface{}, error) {
+	switch typ {
+	case execute.TBool:
+		return strconv.ParseBool(value)
+	case execute.TInt:
+		return strconv.ParseInt(value, 10, 64)
+	case execute.TUInt:
+		return strconv.ParseUint(value, 10, 64)
+	case execute.TFloat:
+		return strconv.ParseFloat(value, 64)
+	case execute.TString:
+		return value, nil
+	case execute.TTime:
+		return decodeTime(value)
+	default:
+		return nil, fmt.Errorf("unknown type %v", typ)
+	}
+}
+
+// isNullValue reports whether an encoded value represents null.
+// Only non string columns can be null, since an empty string is a valid string value.
+func isNullValue(typ execute.DataType, value string) bool {
+	return value == "" && typ != execute.TString
+}
+
+func appendValue(builder execute.BlockBuilder, j int, typ execute.DataType, value string) error {
+	if isNullValue(typ, value) {
+		builder.AppendNil(j)
+		return nil
+	}
+	v, err := decodeValue(typ, value)
+	if err != nil {
+		return err
+	}
+	switch typ {
+	case execute.TBool:
+		builder.AppendBool(j, v.(bool))
+	case execute.TInt:
+		builder.AppendInt(j, v.(int64))
+	case execute.TUInt:
+		builder.AppendUInt(j, v.(uint64))
+	case execute.TFloat:
+		builder.AppendFloat(j, v.(float64))
+	case execute.TString:
+		builder.AppendString(j, v.(string))
+	case execute.TTime:
+		builder.AppendTime(j, v.(execute.Time))
+	}
+	return nil
+}
+
+func decodeTime(value string) (execute.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, err
+	}
+	return execute.Time(t.UnixNano()), nil
+}
+
+// Dialect describes the options for encoding CSV data as defined by the SPEC request format.
+// Unset options use the defaults from the SPEC.
+type Dialect struct {
+	// Header indicates whether the header row is included, defaults to true.
+	Header *bool `json:"header,omitempty"`
+	// Delimiter is the single character used to delimit columns, defaults to ",".
+	Delimiter string `json:"delimiter,omitempty"`
+	// QuoteChar is the character used to quote values, only `"` is supported.
+	QuoteChar string `json:"quoteChar,omitempty"`
+	// Annotations is the list of annotations to encode, defaults to no annotations.
+	Annotations []string `json:"annotations,omitempty"`
+	// CommentPrefix is the prefix of annotation rows, defaults to "#".
+	CommentPrefix string `json:"commentPrefix,omitempty"`
+	// DateTimeFormat is the format of dateTime values, one of RFC3339 or RFC3339Nano, defaults to RFC3339.
+	DateTimeFormat string `json:"dateTimeFormat,omitempty"`
+}
+
+// EncoderConfig validates the dialect and returns the equivalent encoder configuration.
+func (d Dialect) EncoderConfig() (ResultEncoderConfig, error) {
+	c := DefaultEncoderConfig()
+	if d.Header != nil {
+		c.NoHeader = !*d.Header
+	}
+	if d.Delimiter != "" {
+		r := []rune(d.Delimiter)
+		if len(r) != 1 {
+			return c, fmt.Errorf("delimiter must be a single character, got %q", d.Delimiter)
+		}
+		if r[0] == '\r' || r[0] == '\n' || r[0] == '"' || r[0] == utf8.RuneError {
+			return c, fmt.Errorf("invalid delimiter %q", d.Delimiter)
+		}
+		c.Delimiter = r[0]
+	}
+	if d.QuoteChar != "" && d.QuoteChar != `"` {
+		return c, fmt.Errorf("unsupported quote character %q", d.QuoteChar)
+	}
+	for _, a := range d.Annotations {
+		switch a {
+		case DatatypeAnnotation, PartitionAnnotation, DefaultAnnotation:
+		default:
+			return c, fmt.Errorf("unsupported annotation %q", a)
+		}
+	}
+	c.Annotations = d.Annotations
+	if d.CommentPrefix != "" {
+		if strings.ContainsAny(d.CommentPrefix, "\r\n") || strings.ContainsRune(d.CommentPrefix, c.Delimiter) {
+			return c, fmt.Errorf("invalid comment prefix %q", d.CommentPrefix)
+		}
+		c.CommentPrefix = d.CommentPrefix
+	}
+	switch d.DateTimeFormat {
+	case "":
+	case RFC3339Format, RFC3339NanoFormat:
+		c.DateTimeFormat = d.DateTimeFormat
+	default:
+		return c, fmt.Errorf("unsupported dateTime format %q", d.DateTimeFormat)
+	}
+	return c, nil
+}
+
+// DecoderConfig validates the dialect and returns the decoder configuration that reads data encoded with the dialect.
+// The data can only be decoded if it includes the datatype annotation and the header row.
+func (d Dialect) DecoderConfig() (ResultDecoderConfig, error) {
+	c, err := d.EncoderConfig()
+	if err != nil {
+		return ResultDecoderConfig{}, err
+	}
+	return ResultDecoderConfig{
+		Delimiter:     c.Delimiter,
+		CommentPrefix: c.CommentPrefix,
+	}, nil
+}
+
+// ContentType is the MIME type of the CSV response format.
+const ContentType = "text/csv; charset=utf-8"
+
+// ResultEncoderConfig are options that can be specified on the ResultEncoder.
+type ResultEncoderConfig struct {
+	// Annotations is a list of annotations to include.
+	// If the list is empty the annotation column is omitted entirely.
+	Annotations []string
+	// NoHeader indicates whether a header row should be omitted.
+	NoHeader bool
+	// Delimiter is the character to delimite columns.
+	// It must not be \r, \n, or the Unicode replacement character (0xFFFD).
+	Delimiter rune
+	// CommentPrefix is the prefix of annotation rows.
+	CommentPrefix string
+	// DateTimeFormat is the format used to encode time values, either RFC3339 or RFC3339Nano.
+	DateTimeFormat string
+}
+
+// DefaultEncoderConfig returns a config that encodes the header row and uses a comma delimiter.
+func DefaultEncoderConfig() ResultEncoderConfig {
+	return ResultEncoderConfig{
+		Delimiter:      defaultDelimiter,
+		CommentPrefix:  commentPrefix,
+		DateTimeFormat: RFC3339Format,
+	}
+}
+
+// ResultEncoder encodes a result into the annotated CSV format defined in the SPEC.
+type ResultEncoder struct {
+	c ResultEncoderConfig
+	// written is true once any data has been flushed to the writer.
+	written bool
+	// unflushed is true when data has been written to the csv writer since it was last flushed.
+	unflushed bool
+}
+
+// NewResultEncoder creates a new encoder with the provided configuration.
+func NewResultEncoder(c ResultEncoderConfig) *ResultEncoder {
+	if c.Delimiter == 0 {
+		c.Delimiter = defaultDelimiter
+	}
+	if c.CommentPrefix == "" {
+		c.CommentPrefix = commentPrefix
+	}
+	if c.DateTimeFormat == "" {
+		c.DateTimeFormat = RFC3339Format
+	}
+	return &ResultEncoder{
+		c: c,
+	}
+}
+
+// Written reports whether the encoder has written any data.
+func (e *ResultEncoder) Written() bool {
+	return e.written
+}
+
+func (e *ResultEncoder) csvWriter(w io.Writer) *csv.Writer {
+	writer := csv.NewWriter(w)
+	writer.Comma = e.c.Delimiter
+	writer.UseCRLF = true
+	return writer
+}
+
+func (e *ResultEncoder) hasAnnotation(a string) bool {
+	for _, an := range e.c.Annotations {
+		if an == a {
+			return true
+		}
+	}
+	return false
+}
+
+// Encode writes the blocks of the named result to w.
+// Tables that share a schema with the preceding table are written without repeating the annotations and header.
+func (e *ResultEncoder) Encode(w io.Writer, name string, result execute.Result) error {
+	writer := e.csvWriter(w)
+
+	tableID := int64(0)
+	var lastCols []colMeta
+	// The annotation column only exists if there are annotations
+	offset := 0
+	if len(e.c.Annotations) > 0 {
+		offset = 1
+	}
+
+	err := result.Blocks().Do(func(b execute.Block) error {
+		cols := newColMetas(b)
+		key := b.Key()
+
+		// Defaults encode the partition key values, so each table needs its own annotations.
+		newSchema := lastCols == nil || !equalCols(cols, lastCols) || e.hasAnnotation(DefaultAnnotation)
+		if newSchema {
+			if e.written {
+				// Write empty row to delimit the new table
+				if err := writeEmptyRow(writer); err != nil {
+					return err
+				}
+			}
+			if err := e.writeSchema(writer, offset, name, key, cols); err != nil {
+				return err
+			}
+			lastCols = cols
+		}
+
+		row := make([]string, offset+2+len(cols))
+		row[offset] = name
+		row[offset+1] = strconv.FormatInt(tableID, 10)
+		err := b.Do(func(cr execute.ColReader) error {
+			l := cr.Len()
+			for i := 0; i < l; i++ {
+				for j, c := range cols {
+					v, err := e.encodeValue(i, j, c, cr)
+					if err != nil {
+						return err
+					}
+					row[offset+2+j] = v
+				}
+				if err := writer.Write(row); err != nil {
+					return err
+				}
+				e.unflushed = true
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		tableID++
+		return e.flush(writer)
+	})
+	if err != nil {
+		// Flush the rows written before the error, so an error table can follow them.
+		e.flush(writer)
+		return err
+	}
+	return e.flush(writer)
+}
+
+// flush flushes the csv writer and records whether any data has been written.
+func (e *ResultEncoder) flush(writer *csv.Writer) error {
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return err
+	}
+	if e.unflushed {
+		e.written = true
+		e.unflushed = false
+	}
+	return nil
+}
+
+func (e *ResultEncoder) writeSchema(writer *csv.Writer, offset int, name string, key execute.PartitionKey, cols []colMeta) error {
+	row := make([]string, offset+2+len(cols))
+	for _, a := range e.c.Annotations {
+		row[0] = e.c.CommentPrefix + a
+		switch a {
+		case DatatypeAnnotation:
+			row[offset] = stringDatatype
+			row[offset+1] = intDatatype
+			for j, c := range cols {
+				row[offset+2+j] = e.datatype(c)
+			}
+		case PartitionAnnotation:
+			row[offset] = "false"
+			row[offset+1] = "false"
+			for j, c := range cols {
+				row[offset+2+j] = strconv.FormatBool(c.partition)
+			}
+		case DefaultAnnotation:
+			row[offset] = name
+			row[offset+1] = ""
+			for j, c := range cols {
+				row[offset+2+j] = ""
+				if c.partition {
+					v, err := e.encodeKeyValue(key, c.Label, c.Type)
+					if err != nil {
+						return err
+					}
+					row[offset+2+j] = v
+				}
+			}
+		default:
+			return fmt.Errorf("unsupported annotation %q", a)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+		e.unflushed = true
+	}
+	if !e.c.NoHeader {
+		if offset > 0 {
+			row[0] = ""
+		}
+		row[offset] = resultLabel
+		row[offset+1] = tableLabel
+		for j, c := range cols {
+			row[offset+2+j] = c.Label
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+		e.unflushed = true
+	}
+	return nil
+}
+
+// EncodeError writes an error table to w.
+// The error table follows any tables already written and is preceded by the configured annotations.
+// The header row is always written, since it identifies the table as an error.
+func (e *ResultEncoder) EncodeError(w io.Writer, err error, reference int64) error {
+	writer := e.csvWriter(w)
+	if e.written {
+		if err := writeEmptyRow(writer); err != nil {
+			return err
+		}
+	}
+
+	offset := 0
+	if len(e.c.Annotations) > 0 {
+		offset = 1
+	}
+	row := make([]string, offset+2)
+	for _, a := range e.c.Annotations {
+		row[0] = e.c.CommentPrefix + a
+		switch a {
+		case DatatypeAnnotation:
+			row[offset] = stringDatatype
+			row[offset+1] = intDatatype
+		case PartitionAnnotation:
+			row[offset] = "false"
+			row[offset+1] = "false"
+		case DefaultAnnotation:
+			row[offset] = ""
+			row[offset+1] = ""
+		default:
+			return fmt.Errorf("unsupported annotation %q", a)
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	if offset > 0 {
+		row[0] = ""
+	}
+	row[offset] = errorLabel
+	row[offset+1] = referenceLabel
+	if err := writer.Write(row); err != nil {
+		return err
+	}
+	row[offset] = err.Error()
+	row[offset+1] = strconv.FormatInt(reference, 10)
+	if err := writer.Write(row); err != nil {
+		return err
+	}
+	e.unflushed = true
+	return e.flush(writer)
+}
+
+func writeEmptyRow(writer *csv.Writer) error {
+	// A record with a single empty field is written as an empty line.
+	return writer.Write([]string{""})
+}
+
+// MultiResultEncoder encodes multiple named results into a single CSV stream.
+// Results are written in order of their names and are delimited by an empty row.
+type MultiResultEncoder struct {
+	enc *ResultEncoder
+}
+
+// NewMultiResultEncoder creates a new encoder with the provided configuration.
+func NewMultiResultEncoder(c ResultEncoderConfig) *MultiResultEncoder {
+	return &MultiResultEncoder{
+		enc: NewResultEncoder(c),
+	}
+}
+
+// Written reports whether the encoder has written any data.
+func (e *MultiResultEncoder) Written() bool {
+	return e.enc.Written()
+}
+
+// EncodeError writes an error table after any results already written.
+func (e *MultiResultEncoder) EncodeError(w io.Writer, err error, reference int64) error {
+	return e.enc.EncodeError(w, err, reference)
+}
+
+// Encode writes all results to w.
+func (e *MultiResultEncoder) Encode(w io.Writer, results map[string]execute.Result) error {
+	names := make([]string, 0, len(results))
+	for name := range results {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Each result starts with a new schema, which is preceded by an empty row
+	// once any data has been written.
+	for _, name := range names {
+		if err := e.enc.Encode(w, name, results[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type colMeta struct {
+	execute.ColMeta
+	partition bool
+}
+
+func newColMetas(b execute.Block) []colMeta {
+	key := b.Key()
+	cols := b.Cols()
+	metas := make([]colMeta, len(cols))
+	for j, c := range cols {
+		metas[j] = colMeta{
+			ColMeta:   c,
+			partition: key.HasCol(c.Label),
+		}
+	}
+	return metas
+}
+
+func equalCols(a, b []colMeta) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for j := range a {
+		if a[j] != b[j] {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *ResultEncoder) datatype(c colMeta) string {
+	switch c.Type {
+	case execute.TBool:
+		return boolDatatype
+	case execute.TInt:
+		return intDatatype
+	case execute.TUInt:
+		return uintDatatype
+	case execute.TFloat:
+		return floatDatatype
+	case execute.TString:
+		return stringDatatype
+	case execute.TTime:
+		return timeDatatype + ":" + e.c.DateTimeFormat
+	default:
+		return ""
+	}
+}
+
+func (e *ResultEncoder) encodeValue(i, j int, c colMeta, cr execute.ColReader) (string, error) {
+	if execute.IsNull(cr, i, j) {
+		return "", nil
+	}
+	switch c.Type {
+	case execute.TBool:
+		return strconv.FormatBool(cr.Bools(j)[i]), nil
+	case execute.TInt:
+		return strconv.FormatInt(cr.Ints(j)[i], 10), nil
+	case execute.TUInt:
+		return strconv.FormatUint(cr.UInts(j)[i], 10), nil
+	case execute.TFloat:
+		return strconv.FormatFloat(cr.Floats(j)[i], 'f', -1, 64), nil
+	case execute.TString:
+		return cr.Strings(j)[i], nil
+	case execute.TTime:
+		return e.encodeTime(cr.Times(j)[i]), nil
+	default:
+		return "", fmt.Errorf("unknown type %v", c.Type)
+	}
+}
+
+func (e *ResultEncoder) encodeKeyValue(key execute.PartitionKey, label string, typ execute.DataType) (string, error) {
+	j := execute.ColIdx(label, key.Cols())
+	if key.Value(j) == nil {
+		return "", nil
+	}
+	switch typ {
+	case execute.TBool:
+		return strconv.FormatBool(key.ValueBool(j)), nil
+	case execute.TInt:
+		return strconv.FormatInt(key.ValueInt(j), 10), nil
+	case execute.TUInt:
+		return strconv.FormatUint(key.ValueUInt(j), 10), nil
+	case execute.TFloat:
+		return strconv.FormatFloat(key.ValueFloat(j), 'f', -1, 64), nil
+	case execute.TString:
+		return key.ValueString(j), nil
+	case execute.TTime:
+		return e.encodeTime(key.ValueTime(j)), nil
+	default:
+		return "", fmt.Errorf("unknown type %v", typ)
+	}
+}
+
+func (e *ResultEncoder) encodeTime(t execute.Time) string {
+	if e.c.DateTimeFormat == RFC3339NanoFormat {
+		return t.Time().Format(fixedWidthTimeFmt)
+	}
+	return t.Time().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,623 @@
+package csv_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/csv"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+)
+
+func toCRLF(data string) string {
+	return strings.Replace(data, "\n", "\r\n", -1)
+}
+
+var meanCols = []execute.ColMeta{
+	{Label: "_start", Type: execute.TTime},
+	{Label: "_stop", Type: execute.TTime},
+	{Label: "_time", Type: execute.TTime},
+	{Label: "region", Type: execute.TString},
+	{Label: "host", Type: execute.TString},
+	{Label: "_value", Type: execute.TFloat},
+}
+
+func meanBlocks() []*executetest.Block {
+	return []*executetest.Block{
+		{
+			KeyCols: []string{"_start", "_stop", "region"},
+			ColMeta: meanCols,
+			Data: [][]interface{}{
+				{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), mustParseTime("2018-05-08T20:50:00Z"), "east", "A", 15.43},
+				{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), mustParseTime("2018-05-08T20:50:20Z"), "east", "B", 59.25},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop", "region"},
+			ColMeta: meanCols,
+			Data: [][]interface{}{
+				{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), mustParseTime("2018-05-08T20:50:00Z"), "west", "A", 62.73},
+			},
+		},
+	}
+}
+
+func TestResultEncoder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  csv.ResultEncoderConfig
+		results map[string]execute.Result
+		encoded string
+	}{
+		{
+			name:   "no annotations",
+			config: csv.DefaultEncoderConfig(),
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult(meanBlocks()),
+			},
+			encoded: toCRLF(`result,table,_start,_stop,_time,region,host,_value
+mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,east,A,15.43
+mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:20Z,east,B,59.25
+mean,1,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+`),
+		},
+		{
+			name: "no header",
+			config: csv.ResultEncoderConfig{
+				NoHeader: true,
+			},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult(meanBlocks()[1:]),
+			},
+			encoded: toCRLF(`mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+`),
+		},
+		{
+			name: "datatype and partition annotations",
+			config: csv.ResultEncoderConfig{
+				Annotations: []string{csv.DatatypeAnnotation, csv.PartitionAnnotation},
+			},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult(meanBlocks()),
+			},
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,east,A,15.43
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:20Z,east,B,59.25
+,mean,1,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+`),
+		},
+		{
+			name: "default annotation",
+			config: csv.ResultEncoderConfig{
+				Annotations: []string{csv.DatatypeAnnotation, csv.PartitionAnnotation, csv.DefaultAnnotation},
+			},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult([]*executetest.Block{
+					meanBlocks()[1],
+					{
+						KeyCols:   []string{"_start", "_stop", "region"},
+						KeyValues: []interface{}{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), "north"},
+						ColMeta:   meanCols,
+					},
+				}),
+			},
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+#default,mean,,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,,west,,
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+
+#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+#default,mean,,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,,north,,
+,result,table,_start,_stop,_time,region,host,_value
+`),
+		},
+		{
+			name: "null values",
+			config: csv.ResultEncoderConfig{
+				Annotations: []string{csv.DatatypeAnnotation},
+			},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult([]*executetest.Block{{
+					KeyCols: []string{"_start", "_stop", "region"},
+					ColMeta: meanCols,
+					Data: [][]interface{}{
+						{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), mustParseTime("2018-05-08T20:50:00Z"), "east", "A", nil},
+						{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), nil, "east", "B", 59.25},
+					},
+				}}),
+			},
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,east,A,
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,,east,B,59.25
+`),
+		},
+		{
+			name: "differing schemas",
+			config: csv.ResultEncoderConfig{
+				Annotations: []string{csv.DatatypeAnnotation},
+			},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult([]*executetest.Block{
+					meanBlocks()[1],
+					{
+						KeyCols: []string{"_start", "_stop"},
+						ColMeta: []execute.ColMeta{
+							{Label: "_start", Type: execute.TTime},
+							{Label: "_stop", Type: execute.TTime},
+							{Label: "count", Type: execute.TInt},
+							{Label: "ok", Type: execute.TBool},
+							{Label: "n", Type: execute.TUInt},
+						},
+						Data: [][]interface{}{
+							{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), int64(-3), true, uint64(7)},
+						},
+					},
+				}),
+			},
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+
+#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,long,boolean,unsignedlong
+,result,table,_start,_stop,count,ok,n
+,mean,1,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,-3,true,7
+`),
+		},
+		{
+			name:   "multiple results",
+			config: csv.DefaultEncoderConfig(),
+			results: map[string]execute.Result{
+				"b": executetest.NewResult(meanBlocks()[1:]),
+				"a": executetest.NewResult(meanBlocks()[1:]),
+			},
+			encoded: toCRLF(`result,table,_start,_stop,_time,region,host,_value
+a,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+
+result,table,_start,_stop,_time,region,host,_value
+b,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			encoder := csv.NewMultiResultEncoder(tc.config)
+			if err := encoder.Encode(&buf, tc.results); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := buf.String(), tc.encoded; got != want {
+				t.Errorf("unexpected encoding -want/+got:\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func mustParseTime(s string) execute.Time {
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		panic(err)
+	}
+	return execute.Time(t.UnixNano())
+}
+
+func TestResultDecoder(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  csv.ResultDecoderConfig
+		encoded string
+		want    map[string][]*executetest.Block
+		wantErr bool
+	}{
+		{
+			name: "multiple tables",
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,east,A,15.43
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:20Z,east,B,59.25
+,mean,1,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+`),
+			want: map[string][]*executetest.Block{
+				"mean": meanBlocks(),
+			},
+		},
+		{
+			name: "empty table with defaults",
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+#default,mean,,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,,west,,
+,result,table,_start,_stop,_time,region,host,_value
+,,0,,,2018-05-08T20:50:00Z,,A,62.73
+
+#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+#default,mean,,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,,north,,
+,result,table,_start,_stop,_time,region,host,_value
+`),
+			want: map[string][]*executetest.Block{
+				"mean": {
+					meanBlocks()[1],
+					{
+						KeyCols:   []string{"_start", "_stop", "region"},
+						KeyValues: []interface{}{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), "north"},
+						ColMeta:   meanCols,
+					},
+				},
+			},
+		},
+		{
+			name: "null values",
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+#partition,false,false,true,true,false,true,false,false
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,east,A,
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,,east,B,59.25
+`),
+			want: map[string][]*executetest.Block{
+				"mean": {{
+					KeyCols: []string{"_start", "_stop", "region"},
+					ColMeta: meanCols,
+					Data: [][]interface{}{
+						{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), mustParseTime("2018-05-08T20:50:00Z"), "east", "A", nil},
+						{mustParseTime("2018-05-08T20:50:00Z"), mustParseTime("2018-05-08T20:51:00Z"), nil, "east", "B", 59.25},
+					},
+				}},
+			},
+		},
+		{
+			name: "comment prefix and delimiter",
+			config: csv.ResultDecoderConfig{
+				Delimiter:     ';',
+				CommentPrefix: "//",
+			},
+			encoded: toCRLF(`//datatype;string;long;dateTime:RFC3339;dateTime:RFC3339;dateTime:RFC3339;string;string;double
+//partition;false;false;true;true;false;true;false;false
+;result;table;_start;_stop;_time;region;host;_value
+;mean;0;2018-05-08T20:50:00Z;2018-05-08T20:51:00Z;2018-05-08T20:50:00Z;west;A;62.73
+`),
+			want: map[string][]*executetest.Block{
+				"mean": meanBlocks()[1:],
+			},
+		},
+		{
+			name: "missing datatype",
+			encoded: toCRLF(`#partition,false,false,true
+,result,table,_start
+,mean,0,2018-05-08T20:50:00Z
+`),
+			wantErr: true,
+		},
+		{
+			name: "unknown datatype",
+			encoded: toCRLF(`#datatype,string,long,bytes
+,result,table,_value
+,mean,0,abc
+`),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			decoder := csv.NewResultDecoder(tc.config)
+			results, err := decoder.Decode(strings.NewReader(tc.encoded))
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			got := make(map[string][]*executetest.Block, len(results))
+			for _, r := range results {
+				err := r.Blocks().Do(func(b execute.Block) error {
+					blk, err := executetest.ConvertBlock(b)
+					if err != nil {
+						return err
+					}
+					got[r.Name] = append(got[r.Name], blk)
+					return nil
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, blocks := range got {
+				executetest.NormalizeBlocks(blocks)
+			}
+			for _, blocks := range tc.want {
+				executetest.NormalizeBlocks(blocks)
+			}
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected blocks -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
+
+func TestDialect_EncoderConfig(t *testing.T) {
+	noHeader := false
+	testCases := []struct {
+		name    string
+		dialect csv.Dialect
+		results map[string]execute.Result
+		encoded string
+		wantErr bool
+	}{
+		{
+			name:    "defaults",
+			dialect: csv.Dialect{},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult(meanBlocks()[1:]),
+			},
+			encoded: toCRLF(`result,table,_start,_stop,_time,region,host,_value
+mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+`),
+		},
+		{
+			name: "all options",
+			dialect: csv.Dialect{
+				Header:         &noHeader,
+				Delimiter:      ";",
+				QuoteChar:      `"`,
+				Annotations:    []string{csv.DatatypeAnnotation},
+				CommentPrefix:  "//",
+				DateTimeFormat: csv.RFC3339NanoFormat,
+			},
+			results: map[string]execute.Result{
+				"mean": executetest.NewResult(meanBlocks()[1:]),
+			},
+			encoded: toCRLF(`//datatype;string;long;dateTime:RFC3339Nano;dateTime:RFC3339Nano;dateTime:RFC3339Nano;string;string;double
+;mean;0;2018-05-08T20:50:00.000000000Z;2018-05-08T20:51:00.000000000Z;2018-05-08T20:50:00.000000000Z;west;A;62.73
+`),
+		},
+		{
+			name:    "multi character delimiter",
+			dialect: csv.Dialect{Delimiter: "||"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported quote character",
+			dialect: csv.Dialect{QuoteChar: "'"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown annotation",
+			dialect: csv.Dialect{Annotations: []string{"group"}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown date format",
+			dialect: csv.Dialect{DateTimeFormat: "unix"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := tc.dialect.EncoderConfig()
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			var buf bytes.Buffer
+			encoder := csv.NewMultiResultEncoder(config)
+			if err := encoder.Encode(&buf, tc.results); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := buf.String(), tc.encoded; got != want {
+				t.Errorf("unexpected encoding -want/+got:\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+func TestDialect_DecoderConfig(t *testing.T) {
+	dialect := csv.Dialect{
+		Delimiter:     "\t",
+		Annotations:   []string{csv.DatatypeAnnotation, csv.PartitionAnnotation},
+		CommentPrefix: "//",
+	}
+	encoderConfig, err := dialect.EncoderConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	encoder := csv.NewResultEncoder(encoderConfig)
+	if err := encoder.Encode(&buf, "mean", executetest.NewResult(meanBlocks())); err != nil {
+		t.Fatal(err)
+	}
+
+	decoderConfig, err := dialect.DecoderConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := csv.NewResultDecoder(decoderConfig).Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []*executetest.Block
+	for _, r := range results {
+		err := r.Blocks().Do(func(b execute.Block) error {
+			blk, err := executetest.ConvertBlock(b)
+			if err != nil {
+				return err
+			}
+			got = append(got, blk)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := meanBlocks()
+	executetest.NormalizeBlocks(want)
+	executetest.NormalizeBlocks(got)
+	if !cmp.Equal(want, got) {
+		t.Errorf("unexpected blocks -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+func TestResultEncoder_EncodeError(t *testing.T) {
+	testCases := []struct {
+		name    string
+		config  csv.ResultEncoderConfig
+		results map[string]execute.Result
+		encoded string
+	}{
+		{
+			name:   "no annotations",
+			config: csv.DefaultEncoderConfig(),
+			encoded: toCRLF(`error,reference
+failed to parse query,897
+`),
+		},
+		{
+			name: "no header",
+			config: csv.ResultEncoderConfig{
+				NoHeader: true,
+			},
+			encoded: toCRLF(`error,reference
+failed to parse query,897
+`),
+		},
+		{
+			name: "datatype annotation",
+			config: csv.ResultEncoderConfig{
+				Annotations: []string{csv.DatatypeAnnotation},
+			},
+			encoded: toCRLF(`#datatype,string,long
+,error,reference
+,failed to parse query,897
+`),
+		},
+		{
+			name: "after results",
+			config: csv.ResultEncoderConfig{
+				Annotations: []string{csv.DatatypeAnnotation},
+			},
+			results: map[string]execute.Result{
+				"mean": &executetest.Result{
+					Blks: meanBlocks()[1:],
+					Err:  errors.New("query terminated: reached maximum allowed memory limits"),
+				},
+			},
+			encoded: toCRLF(`#datatype,string,long,dateTime:RFC3339,dateTime:RFC3339,dateTime:RFC3339,string,string,double
+,result,table,_start,_stop,_time,region,host,_value
+,mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+
+#datatype,string,long
+,error,reference
+,query terminated: reached maximum allowed memory limits,897
+`),
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			encoder := csv.NewMultiResultEncoder(tc.config)
+			err := encoder.Encode(&buf, tc.results)
+			if err == nil {
+				err = errors.New("failed to parse query")
+			}
+			if err := encoder.EncodeError(&buf, err, 897); err != nil {
+				t.Fatal(err)
+			}
+			if got, want := buf.String(), tc.encoded; got != want {
+				t.Errorf("unexpected encoding -want/+got:\n%s", cmp.Diff(want, got))
+			}
+		})
+	}
+}
+
+// failingBlock is a block that fails after its rows have been read.
+type failingBlock struct {
+	*executetest.Block
+	err error
+}
+
+func (b failingBlock) Do(f func(execute.ColReader) error) error {
+	if err := b.Block.Do(f); err != nil {
+		return err
+	}
+	return b.err
+}
+
+type blocksResult []execute.Block
+
+func (r blocksResult) Blocks() execute.BlockIterator {
+	return r
+}
+
+func (r blocksResult) Do(f func(execute.Block) error) error {
+	for _, b := range r {
+		if err := f(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestResultEncoder_EncodeErrorMidBlock(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := csv.NewMultiResultEncoder(csv.DefaultEncoderConfig())
+	err := encoder.Encode(&buf, map[string]execute.Result{
+		"mean": blocksResult{failingBlock{
+			Block: meanBlocks()[1],
+			err:   errors.New("query terminated: reached maximum allowed memory limits"),
+		}},
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !encoder.Written() {
+		t.Fatal("expected rows before the error to be written")
+	}
+	if err := encoder.EncodeError(&buf, err, 897); err != nil {
+		t.Fatal(err)
+	}
+	want := toCRLF(`result,table,_start,_stop,_time,region,host,_value
+mean,0,2018-05-08T20:50:00Z,2018-05-08T20:51:00Z,2018-05-08T20:50:00Z,west,A,62.73
+
+error,reference
+query terminated: reached maximum allowed memory limits,897
+`)
+	if got := buf.String(); got != want {
+		t.Errorf("unexpected encoding -want/+got:\n%s", cmp.Diff(want, got))
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestResultEncoder_WrittenAfterFlush(t *testing.T) {
+	encoder := csv.NewMultiResultEncoder(csv.DefaultEncoderConfig())
+	err := encoder.Encode(failingWriter{}, map[string]execute.Result{
+		"mean": executetest.NewResult(meanBlocks()),
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if encoder.Written() {
+		t.Error("expected nothing to be written")
+	}
+}
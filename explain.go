@@ -0,0 +1,120 @@
+package ifql
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/influxdata/ifql/id"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+)
+
+// Explanation reports how a query is planned and, when it is analyzed, how it was executed.
+type Explanation struct {
+	Spec *query.Spec `json:"spec"`
+	// LogicalPlan is the logical plan before the physical planner pushed down procedures.
+	LogicalPlan *plan.LogicalPlanSpec `json:"logical_plan"`
+	Plan        *plan.PlanSpec        `json:"plan"`
+	// Statistics are only reported when the query is analyzed.
+	Statistics *execute.Statistics `json:"statistics,omitempty"`
+}
+
+// Explain compiles and plans the query without executing it.
+func Explain(ctx context.Context, queryStr string, searchPath []string) (*Explanation, error) {
+	spec, err := query.Compile(ctx, queryStr, query.SearchPath(searchPath))
+	if err != nil {
+		return nil, fmt.Errorf("error compiling query: %v", err)
+	}
+	lp, err := plan.NewLogicalPlanner().Plan(spec)
+	if err != nil {
+		return nil, fmt.Errorf("error creating logical plan: %v", err)
+	}
+	// Keep the logical plan, the physical planner modifies its procedures.
+	logical := lp.Copy()
+	now := spec.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+	p, err := plan.NewPlanner().Plan(lp, nil, now)
+	if err != nil {
+		return nil, fmt.Errorf("error creating physical plan: %v", err)
+	}
+	return &Explanation{
+		Spec:        spec,
+		LogicalPlan: logical,
+		Plan:        p,
+	}, nil
+}
+
+// Analyze executes the query and reports its plans together with the statistics of the execution.
+// The results of the query are discarded.
+func Analyze(ctx context.Context, c *Controller, orgID id.ID, queryStr string) (*Explanation, error) {
+	q, err := c.AnalyzeWithCompile(ctx, orgID, queryStr)
+	if err != nil {
+		return nil, fmt.Errorf("error constructing query: %v", err)
+	}
+	defer q.Done()
+
+	results, ok := <-q.Ready()
+	if !ok {
+		return nil, fmt.Errorf("error executing query: %v", q.Err())
+	}
+	for _, r := range results {
+		err := r.Blocks().Do(func(b execute.Block) error {
+			return b.Do(func(execute.ColReader) error {
+				return nil
+			})
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error executing query: %v", err)
+		}
+	}
+	stats := <-q.Statistics()
+	return &Explanation{
+		Spec:        q.Spec(),
+		LogicalPlan: q.LogicalPlan(),
+		Plan:        q.Plan(),
+		Statistics:  &stats,
+	}, nil
+}
+
+// WriteDOT writes a Graphviz DOT graph with the query spec, the logical plan and the physical plan as clusters.
+// The procedures of the physical plan are annotated with their statistics, if any.
+func (e *Explanation) WriteDOT(w io.Writer) error {
+	if _, err := fmt.Fprint(w, "digraph Explanation {\n"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, query.Formatted(e.Spec, query.FmtDOTCluster("spec"))); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprint(w, plan.Formatted(e.LogicalPlan, plan.FmtDOTCluster("logical"))); err != nil {
+		return err
+	}
+	opts := []plan.FormatOption{plan.FmtDOTCluster("physical")}
+	if e.Statistics != nil {
+		stats := make(map[plan.ProcedureID]execute.ProcedureStatistics, len(e.Statistics.Procedures))
+		for _, ps := range e.Statistics.Procedures {
+			stats[ps.ID] = ps
+		}
+		opts = append(opts, plan.Annotate(func(pr *plan.Procedure) []string {
+			ps, ok := stats[pr.ID]
+			if !ok {
+				return nil
+			}
+			return []string{
+				fmt.Sprintf("blocks: %d in, %d out", ps.BlocksIn, ps.BlocksOut),
+				fmt.Sprintf("rows: %d in, %d out", ps.RowsIn, ps.RowsOut),
+				fmt.Sprintf("allocated: %d bytes, %d max", ps.BytesAllocated, ps.MaxBytesAllocated),
+				fmt.Sprintf("wall time: %v", ps.WallTime),
+			}
+		}))
+	}
+	if _, err := fmt.Fprint(w, plan.Formatted(e.Plan, opts...)); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w, "}")
+	return err
+}
@@ -0,0 +1,200 @@
+// Package format prints IFQL programs in their canonical form.
+//
+// The canonical form indents blocks with four spaces, places every stage of a pipe chain on its own line
+// and uses a single space around binary operators and after commas and colons.
+package format
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/parser"
+)
+
+// Source parses the IFQL source and returns it in its canonical form.
+// Comments are preserved, as are single blank lines between statements.
+func Source(src []byte) ([]byte, error) {
+	program, err := parser.NewAST(string(src))
+	if err != nil {
+		return nil, err
+	}
+	p := &printer{
+		src: newSource(src, program),
+	}
+	p.program(program)
+	return p.bytes(), nil
+}
+
+// Node returns the canonical IFQL source of the node.
+func Node(n ast.Node) string {
+	p := new(printer)
+	p.node(n)
+	if _, ok := n.(*ast.Program); ok {
+		return string(p.bytes())
+	}
+	return p.buf.String()
+}
+
+// comment is a single line comment in the source.
+type comment struct {
+	text     string
+	start    int
+	end      int
+	line     int
+	trailing bool // trailing reports whether the comment follows code on the same line.
+}
+
+// source maps node locations to offsets in the source and holds its comments.
+type source struct {
+	src        []byte
+	lineStarts []int
+	comments   []comment
+}
+
+func newSource(src []byte, program *ast.Program) *source {
+	s := &source{
+		src:        src,
+		lineStarts: []int{0},
+	}
+	for i, c := range src {
+		if c == '\n' {
+			s.lineStarts = append(s.lineStarts, i+1)
+		}
+	}
+
+	// Find the string and regular expression literals, any // within them does not start a comment.
+	var literals [][2]int
+	ast.Walk(visitor(func(n ast.Node) {
+		switch n.(type) {
+		case *ast.StringLiteral, *ast.StringExpression, *ast.RegexpLiteral:
+			if start, end, ok := s.rawSpan(n); ok {
+				literals = append(literals, [2]int{start, end})
+			}
+		}
+	}), program)
+	sort.Slice(literals, func(i, j int) bool { return literals[i][0] < literals[j][0] })
+
+	lit := 0
+	for i := 0; i+1 < len(src); i++ {
+		for lit < len(literals) && literals[lit][1] <= i {
+			lit++
+		}
+		if lit < len(literals) && literals[lit][0] <= i {
+			i = literals[lit][1] - 1
+			continue
+		}
+		if src[i] != '/' || src[i+1] != '/' {
+			continue
+		}
+		end := bytes.IndexByte(src[i:], '\n')
+		if end < 0 {
+			end = len(src)
+		} else {
+			end += i
+		}
+		line := s.line(i)
+		s.comments = append(s.comments, comment{
+			text:     strings.TrimRight(string(src[i:end]), " \t\r"),
+			start:    i,
+			end:      end,
+			line:     line,
+			trailing: len(bytes.TrimSpace(src[s.lineStarts[line-1]:i])) > 0,
+		})
+		i = end
+	}
+	return s
+}
+
+// offset returns the byte offset of the position, whose column counts characters.
+func (s *source) offset(pos ast.Position) int {
+	if pos.Line < 1 || pos.Line > len(s.lineStarts) {
+		return -1
+	}
+	o := s.lineStarts[pos.Line-1]
+	for col := 1; col < pos.Column && o < len(s.src); col++ {
+		_, size := utf8.DecodeRune(s.src[o:])
+		o += size
+	}
+	return o
+}
+
+// line returns the line number of the offset.
+func (s *source) line(offset int) int {
+	return sort.Search(len(s.lineStarts), func(i int) bool { return s.lineStarts[i] > offset })
+}
+
+// rawSpan returns the offsets of the source text of the node as reported by the parser.
+func (s *source) rawSpan(n ast.Node) (start, end int, ok bool) {
+	loc := n.Location()
+	if loc == nil || loc.Source == nil {
+		return 0, 0, false
+	}
+	start = s.offset(loc.Start)
+	if start < 0 {
+		return 0, 0, false
+	}
+	return start, start + len(*loc.Source), true
+}
+
+// span returns the offsets of the node without any surrounding whitespace or comments,
+// which the parser may include in the source text of a node.
+func (s *source) span(n ast.Node) (start, end int, ok bool) {
+	start, end, ok = s.rawSpan(n)
+	if !ok {
+		return
+	}
+	for start < end {
+		if isSpace(s.src[start]) {
+			start++
+		} else if c, ok := s.commentAt(start); ok {
+			start = c.end
+		} else {
+			break
+		}
+	}
+	for end > start {
+		if isSpace(s.src[end-1]) {
+			end--
+		} else if c, ok := s.commentEndingAt(end); ok {
+			end = c.start
+		} else {
+			break
+		}
+	}
+	return start, end, true
+}
+
+func (s *source) commentAt(offset int) (comment, bool) {
+	for _, c := range s.comments {
+		if c.start == offset {
+			return c, true
+		}
+	}
+	return comment{}, false
+}
+
+func (s *source) commentEndingAt(offset int) (comment, bool) {
+	for _, c := range s.comments {
+		if c.end == offset || c.start < offset && offset < c.end {
+			return c, true
+		}
+	}
+	return comment{}, false
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
+
+// visitor calls a function for every node it visits.
+type visitor func(n ast.Node)
+
+func (v visitor) Visit(n ast.Node) ast.Visitor {
+	v(n)
+	return v
+}
+
+func (v visitor) Done() {}
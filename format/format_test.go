@@ -0,0 +1,247 @@
+package format_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/ast/asttest"
+	"github.com/influxdata/ifql/format"
+	"github.com/influxdata/ifql/parser"
+)
+
+func TestSource(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "pipe chain",
+			src:  `from(db:"telegraf")|>range(start:-1h)|>filter(fn:(r)=>r._measurement=="cpu"AND r.host!="a")|>sum()`,
+			want: `from(db: "telegraf")
+    |> range(start: -1h)
+    |> filter(fn: (r) => r._measurement == "cpu" and r.host != "a")
+    |> sum()
+`,
+		},
+		{
+			name: "comments",
+			src: `// leading
+a = 1 // trailing a
+b = from(db:"telegraf") // trailing from
+    // before range
+    |> range(start:-1h)
+// final
+`,
+			want: `// leading
+a = 1 // trailing a
+b = from(db: "telegraf") // trailing from
+    // before range
+    |> range(start: -1h)
+// final
+`,
+		},
+		{
+			name: "blank lines",
+			src: `
+
+a = 1
+
+
+b = 2
+c = 3
+
+`,
+			want: `a = 1
+
+b = 2
+c = 3
+`,
+		},
+		{
+			name: "block body",
+			src: `f = (r,n=1,tables=<-)=>{
+a = r + n
+  // inside
+      return a*2
+}`,
+			want: `f = (r, n=1, tables=<-) => {
+    a = r + n
+    // inside
+    return a * 2
+}
+`,
+		},
+		{
+			name: "precedence",
+			src: `a = (1 + 2) * 3
+b = 1 + (2 * 3)
+c = 1 - (2 - 3)
+d = (1 - 2) - 3
+e = not (a and b) or c
+f = (if a then 1 else 2) + 1
+g = -(1 + 2)`,
+			want: `a = (1 + 2) * 3
+b = 1 + 2 * 3
+c = 1 - (2 - 3)
+d = 1 - 2 - 3
+e = not (a and b) or c
+f = (if a then 1 else 2) + 1
+g = -(1 + 2)
+`,
+		},
+		{
+			name: "literals",
+			src: `a = ["a\"b\\c\n", "${a} and \${b}", /a\/b/, 90m, 1.50, 10, 2018-05-22T19:53:26.000Z, true]
+b = {x: "// not a comment", y: r["_value"]}
+c = exists r.x and not empty r.y and r.z in [1, 2]
+d = (r) => ({v: r._value})`,
+			want: `a = ["a\"b\\c\n", "${a} and \${b}", /a\/b/, 1h30m, 1.5, 10, 2018-05-22T19:53:26Z, true]
+b = {x: "// not a comment", y: r["_value"]}
+c = exists r.x and not empty r.y and r.z in [1, 2]
+d = (r) => ({v: r._value})
+`,
+		},
+		{
+			name: "options",
+			src: `option now=2018-01-01T00:00:00Z
+option task={every:1m}
+from(db:"telegraf")|>range(start:-task.every)`,
+			want: `option now = 2018-01-01T00:00:00Z
+option task = {every: 1m}
+from(db: "telegraf")
+    |> range(start: -task.every)
+`,
+		},
+		{
+			name: "package and imports",
+			src: `package foo
+import "strings"
+import s "strings"
+x = strings.title(v: "a")`,
+			want: `package foo
+
+import "strings"
+import s "strings"
+
+x = strings.title(v: "a")
+`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := format.Source([]byte(tc.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Fatalf("unexpected source -want/+got:\n%s", cmp.Diff(tc.want, string(got)))
+			}
+
+			// Formatting is idempotent
+			again, err := format.Source(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(again) != string(got) {
+				t.Errorf("formatting is not idempotent -want/+got:\n%s", cmp.Diff(string(got), string(again)))
+			}
+
+			// Formatting does not change the program
+			want, err := parser.NewAST(tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			program, err := parser.NewAST(string(got))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(want, program, asttest.CompareOptions...) {
+				t.Errorf("unexpected program -want/+got:\n%s", cmp.Diff(want, program, asttest.CompareOptions...))
+			}
+		})
+	}
+}
+
+func TestNode(t *testing.T) {
+	testCases := []struct {
+		name string
+		node ast.Node
+		want string
+	}{
+		{
+			name: "pipe expression",
+			node: &ast.PipeExpression{
+				Argument: &ast.CallExpression{
+					Callee: &ast.Identifier{Name: "from"},
+					Arguments: []ast.Expression{&ast.ObjectExpression{
+						Properties: []*ast.Property{{
+							Key:   &ast.Identifier{Name: "db"},
+							Value: &ast.StringLiteral{Value: "telegraf"},
+						}},
+					}},
+				},
+				Call: &ast.CallExpression{
+					Callee: &ast.Identifier{Name: "count"},
+				},
+			},
+			want: `from(db: "telegraf")
+    |> count()`,
+		},
+		{
+			name: "binary expression",
+			node: &ast.BinaryExpression{
+				Operator: ast.MultiplicationOperator,
+				Left: &ast.BinaryExpression{
+					Operator: ast.AdditionOperator,
+					Left:     &ast.FloatLiteral{Value: 1},
+					Right:    &ast.DurationLiteral{Value: time.Second + time.Millisecond},
+				},
+				Right: &ast.UnaryExpression{
+					Operator: ast.SubtractionOperator,
+					Argument: &ast.IntegerLiteral{Value: 2},
+				},
+			},
+			want: `(1.0 + 1s1ms) * -2`,
+		},
+		{
+			name: "string with interpolation syntax",
+			node: &ast.StringLiteral{Value: "${x}"},
+			want: `"\${x}"`,
+		},
+		{
+			name: "regular expression",
+			node: &ast.RegexpLiteral{Value: regexp.MustCompile(`^a/b\d$`)},
+			want: `/^a\/b\d$/`,
+		},
+		{
+			name: "program",
+			node: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "a"},
+							Init: &ast.BooleanLiteral{Value: true},
+						}},
+					},
+					&ast.ExpressionStatement{
+						Expression: &ast.Identifier{Name: "a"},
+					},
+				},
+			},
+			want: "a = true\na\n",
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			if got := format.Node(tc.node); got != tc.want {
+				t.Errorf("unexpected source -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
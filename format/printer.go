@@ -0,0 +1,539 @@
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/influxdata/ifql/ast"
+)
+
+const indentation = "    "
+
+// Precedence levels of expressions, from the loosest to the tightest binding.
+const (
+	precLowest = iota // conditional expressions and function literals extend as far right as possible
+	precLogical
+	precEquality
+	precRelational
+	precAdditive
+	precMultiplicative
+	precUnary
+	precPrimary
+)
+
+type printer struct {
+	buf    bytes.Buffer
+	indent int
+
+	// src is the source being formatted, it is nil when formatting an AST without source.
+	src *source
+	// next is the index of the next comment to print.
+	next int
+	// lastLine is the source line of the last statement or comment printed.
+	lastLine int
+	// lineComment reports whether the current output line ends with a comment.
+	lineComment bool
+	// blank forces a blank line before the next line.
+	blank bool
+}
+
+func (p *printer) bytes() []byte {
+	b := bytes.TrimRight(p.buf.Bytes(), "\n")
+	if len(b) == 0 {
+		return nil
+	}
+	return append(b, '\n')
+}
+
+func (p *printer) write(s string) {
+	p.buf.WriteString(s)
+}
+
+func (p *printer) newline() {
+	p.buf.WriteByte('\n')
+	for i := 0; i < p.indent; i++ {
+		p.buf.WriteString(indentation)
+	}
+	p.lineComment = false
+}
+
+// startLine starts a new output line for a statement or comment found on the given source line.
+// A single blank line is kept where the source has one.
+func (p *printer) startLine(line int) {
+	if p.buf.Len() > 0 {
+		if p.blank || p.src != nil && p.lastLine > 0 && line > p.lastLine+1 {
+			p.buf.WriteByte('\n')
+		}
+		p.newline()
+	}
+	p.blank = false
+	p.lastLine = line
+}
+
+// commentsBefore prints the comments that start before the offset.
+// A comment that follows code in the source follows the last printed code, otherwise it is printed on its own line.
+func (p *printer) commentsBefore(offset int) {
+	if p.src == nil {
+		return
+	}
+	for ; p.next < len(p.src.comments); p.next++ {
+		c := p.src.comments[p.next]
+		if c.start >= offset {
+			return
+		}
+		if c.trailing && !p.lineComment && p.buf.Len() > 0 {
+			p.write(" ")
+		} else {
+			p.startLine(c.line)
+		}
+		p.write(c.text)
+		p.lineComment = true
+	}
+}
+
+// start returns the offset and line where the node starts in the source.
+func (p *printer) start(n ast.Node) (offset, line int) {
+	if p.src == nil {
+		return -1, 0
+	}
+	start, _, ok := p.src.span(n)
+	if !ok {
+		return -1, 0
+	}
+	return start, p.src.line(start)
+}
+
+// end returns the offset and line where the node ends in the source.
+func (p *printer) end(n ast.Node) (offset, line int) {
+	if p.src == nil {
+		return -1, 0
+	}
+	_, end, ok := p.src.span(n)
+	if !ok || end == 0 {
+		return -1, 0
+	}
+	return end, p.src.line(end - 1)
+}
+
+// statements prints a list of statements each on its own line, end is the offset where the list ends in the source.
+func (p *printer) statements(body []ast.Statement, end int) {
+	for _, s := range body {
+		offset, line := p.start(s)
+		p.commentsBefore(offset)
+		p.startLine(line)
+		p.statement(s)
+		if _, line := p.end(s); line > 0 {
+			p.lastLine = line
+		}
+	}
+	p.commentsBefore(end)
+}
+
+// program prints the package clause, the imports and the body of the program separated by blank lines.
+func (p *printer) program(n *ast.Program) {
+	if n.Package != nil {
+		offset, line := p.start(n.Package)
+		p.commentsBefore(offset)
+		p.startLine(line)
+		p.write("package ")
+		p.write(n.Package.Name.Name)
+		p.blank = true
+	}
+	for _, d := range n.Imports {
+		offset, line := p.start(d)
+		p.commentsBefore(offset)
+		p.startLine(line)
+		p.importDeclaration(d)
+		if _, line := p.end(d); line > 0 {
+			p.lastLine = line
+		}
+		p.blank = false
+	}
+	if len(n.Imports) > 0 {
+		p.blank = true
+	}
+	end := -1
+	if p.src != nil {
+		end = len(p.src.src)
+	}
+	p.statements(n.Body, end)
+}
+
+func (p *printer) node(n ast.Node) {
+	switch n := n.(type) {
+	case *ast.Program:
+		p.program(n)
+	case *ast.PackageClause:
+		p.write("package ")
+		p.write(n.Name.Name)
+	case *ast.ImportDeclaration:
+		p.importDeclaration(n)
+	case ast.Statement:
+		p.statement(n)
+	case ast.Expression:
+		p.expression(n, precLowest)
+	case *ast.Property:
+		p.property(n, ": ")
+	case *ast.TextPart:
+		p.write(escapeString(n.Value))
+	case *ast.InterpolatedPart:
+		p.write("${")
+		p.expression(n.Expression, precLowest)
+		p.write("}")
+	}
+}
+
+func (p *printer) importDeclaration(n *ast.ImportDeclaration) {
+	p.write("import ")
+	if n.As != nil {
+		p.write(n.As.Name)
+		p.write(" ")
+	}
+	p.stringLiteral(n.Path)
+}
+
+func (p *printer) statement(s ast.Statement) {
+	switch s := s.(type) {
+	case *ast.ExpressionStatement:
+		p.expression(s.Expression, precLowest)
+	case *ast.OptionStatement:
+		p.write("option ")
+		p.write(s.Declaration.ID.Name)
+		p.write(" = ")
+		p.expression(s.Declaration.Init, precLowest)
+	case *ast.ReturnStatement:
+		p.write("return ")
+		p.expression(s.Argument, precLowest)
+	case *ast.VariableDeclaration:
+		for i, d := range s.Declarations {
+			if i > 0 {
+				p.newline()
+			}
+			p.write(d.ID.Name)
+			p.write(" = ")
+			p.expression(d.Init, precLowest)
+		}
+	case *ast.BlockStatement:
+		p.block(s)
+	}
+}
+
+func (p *printer) block(b *ast.BlockStatement) {
+	p.write("{")
+	p.indent++
+	end := -1
+	if offset, _ := p.end(b); offset > 0 {
+		// Comments before the closing brace belong to the block.
+		end = offset - 1
+	}
+	p.lastLine = 0
+	p.statements(b.Body, end)
+	p.indent--
+	p.newline()
+	p.write("}")
+}
+
+func (p *printer) expression(e ast.Expression, prec int) {
+	if precedence(e) < prec {
+		p.write("(")
+		p.expression(e, precLowest)
+		p.write(")")
+		return
+	}
+	switch e := e.(type) {
+	case *ast.PipeExpression:
+		p.pipe(e)
+	case *ast.CallExpression:
+		p.call(e)
+	case *ast.MemberExpression:
+		p.expression(e.Object, precPrimary)
+		if s, ok := e.Property.(*ast.StringLiteral); ok {
+			p.write("[")
+			p.stringLiteral(s)
+			p.write("]")
+		} else {
+			p.write(".")
+			p.expression(e.Property, precPrimary)
+		}
+	case *ast.ArrowFunctionExpression:
+		p.write("(")
+		for i, param := range e.Params {
+			if i > 0 {
+				p.write(", ")
+			}
+			p.property(param, "=")
+		}
+		p.write(") => ")
+		switch body := e.Body.(type) {
+		case *ast.BlockStatement:
+			p.block(body)
+		case *ast.ObjectExpression:
+			// An object body is parenthesized so it is not read as a block.
+			p.write("(")
+			p.expression(body, precLowest)
+			p.write(")")
+		case ast.Expression:
+			p.expression(body, precLowest)
+		}
+	case *ast.ConditionalExpression:
+		p.write("if ")
+		p.expression(e.Test, precLowest)
+		p.write(" then ")
+		p.expression(e.Consequent, precLowest)
+		p.write(" else ")
+		p.expression(e.Alternate, precLowest)
+	case *ast.LogicalExpression:
+		p.expression(e.Left, precLogical)
+		p.write(" ")
+		p.write(e.Operator.String())
+		p.write(" ")
+		p.expression(e.Right, precLogical+1)
+	case *ast.BinaryExpression:
+		prec := operatorPrecedence(e.Operator)
+		p.expression(e.Left, prec)
+		p.write(" ")
+		p.write(e.Operator.String())
+		p.write(" ")
+		p.expression(e.Right, prec+1)
+	case *ast.UnaryExpression:
+		p.write(e.Operator.String())
+		if e.Operator != ast.SubtractionOperator {
+			p.write(" ")
+		}
+		p.expression(e.Argument, precUnary)
+	case *ast.ArrayExpression:
+		p.write("[")
+		for i, el := range e.Elements {
+			if i > 0 {
+				p.write(", ")
+			}
+			p.expression(el, precPrimary)
+		}
+		p.write("]")
+	case *ast.ObjectExpression:
+		p.write("{")
+		p.properties(e.Properties)
+		p.write("}")
+	case *ast.StringExpression:
+		p.write(`"`)
+		for _, part := range e.Parts {
+			p.node(part)
+		}
+		p.write(`"`)
+	case *ast.Identifier:
+		p.write(e.Name)
+	case *ast.StringLiteral:
+		p.stringLiteral(e)
+	case *ast.BooleanLiteral:
+		p.write(strconv.FormatBool(e.Value))
+	case *ast.IntegerLiteral:
+		p.write(strconv.FormatInt(e.Value, 10))
+	case *ast.UnsignedIntegerLiteral:
+		p.write(strconv.FormatUint(e.Value, 10))
+	case *ast.FloatLiteral:
+		p.write(formatFloat(e.Value))
+	case *ast.DurationLiteral:
+		p.write(formatDuration(e.Value))
+	case *ast.DateTimeLiteral:
+		p.write(e.Value.Format(time.RFC3339Nano))
+	case *ast.RegexpLiteral:
+		p.write(formatRegexp(e.Value.String()))
+	case *ast.PipeLiteral:
+		p.write("<-")
+	default:
+		p.write(fmt.Sprintf("<unknown expression %T>", e))
+	}
+}
+
+// pipe prints a pipe chain with each call on its own line.
+func (p *printer) pipe(e *ast.PipeExpression) {
+	var calls []*ast.CallExpression
+	var head ast.Expression = e
+	for {
+		pe, ok := head.(*ast.PipeExpression)
+		if !ok {
+			break
+		}
+		calls = append(calls, pe.Call)
+		head = pe.Argument
+	}
+	p.expression(head, precPrimary)
+	p.indent++
+	for i := len(calls) - 1; i >= 0; i-- {
+		// Blank lines within a pipe chain are not kept.
+		p.lastLine = 0
+		offset, _ := p.start(calls[i])
+		p.commentsBefore(offset)
+		p.newline()
+		p.write("|> ")
+		p.call(calls[i])
+	}
+	p.indent--
+}
+
+func (p *printer) call(e *ast.CallExpression) {
+	p.expression(e.Callee, precPrimary)
+	p.write("(")
+	for i, arg := range e.Arguments {
+		if i > 0 {
+			p.write(", ")
+		}
+		if obj, ok := arg.(*ast.ObjectExpression); ok {
+			p.properties(obj.Properties)
+		} else {
+			p.expression(arg, precLowest)
+		}
+	}
+	p.write(")")
+}
+
+func (p *printer) properties(properties []*ast.Property) {
+	for i, prop := range properties {
+		if i > 0 {
+			p.write(", ")
+		}
+		p.property(prop, ": ")
+	}
+}
+
+func (p *printer) property(prop *ast.Property, sep string) {
+	p.write(prop.Key.Name)
+	if prop.Value != nil {
+		p.write(sep)
+		if sep == "=" {
+			// Default values of parameters must be primary expressions.
+			p.expression(prop.Value, precPrimary)
+		} else {
+			p.expression(prop.Value, precLowest)
+		}
+	}
+}
+
+func (p *printer) stringLiteral(s *ast.StringLiteral) {
+	p.write(`"`)
+	p.write(escapeString(s.Value))
+	p.write(`"`)
+}
+
+// precedence returns the precedence of the expression.
+func precedence(e ast.Expression) int {
+	switch e := e.(type) {
+	case *ast.ConditionalExpression, *ast.ArrowFunctionExpression:
+		return precLowest
+	case *ast.LogicalExpression:
+		return precLogical
+	case *ast.BinaryExpression:
+		return operatorPrecedence(e.Operator)
+	case *ast.UnaryExpression:
+		return precUnary
+	default:
+		return precPrimary
+	}
+}
+
+func operatorPrecedence(op ast.OperatorKind) int {
+	switch op {
+	case ast.EqualOperator, ast.NotEqualOperator, ast.RegexpMatchOperator, ast.NotRegexpMatchOperator:
+		return precEquality
+	case ast.AdditionOperator, ast.SubtractionOperator:
+		return precAdditive
+	case ast.MultiplicationOperator, ast.DivisionOperator:
+		return precMultiplicative
+	default:
+		return precRelational
+	}
+}
+
+// escapeString escapes the characters that cannot appear unescaped in a string literal.
+func escapeString(s string) string {
+	var b bytes.Buffer
+	for i, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '$':
+			if strings.HasPrefix(s[i+1:], "{") {
+				b.WriteString(`\$`)
+			} else {
+				b.WriteRune(r)
+			}
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// formatFloat formats a float so that it is parsed as a float literal.
+func formatFloat(f float64) string {
+	s := strconv.FormatFloat(f, 'f', -1, 64)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+var durationUnits = []struct {
+	d    time.Duration
+	unit string
+}{
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+	{time.Millisecond, "ms"},
+	{time.Microsecond, "us"},
+	{time.Nanosecond, "ns"},
+}
+
+// formatDuration formats a duration as a sequence of magnitude and unit pairs, from the largest unit to the smallest.
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+	var b bytes.Buffer
+	if d < 0 {
+		b.WriteByte('-')
+		d = -d
+	}
+	for _, u := range durationUnits {
+		if n := d / u.d; n > 0 {
+			b.WriteString(strconv.FormatInt(int64(n), 10))
+			b.WriteString(u.unit)
+			d -= n * u.d
+		}
+	}
+	return b.String()
+}
+
+// formatRegexp formats a regular expression literal, escaping any unescaped forward slashes.
+func formatRegexp(pattern string) string {
+	var b bytes.Buffer
+	b.WriteByte('/')
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '\\':
+			b.WriteByte(c)
+			if i+1 < len(pattern) {
+				i++
+				b.WriteByte(pattern[i])
+			}
+		case '/':
+			b.WriteString(`\/`)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('/')
+	return b.String()
+}
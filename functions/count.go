@@ -42,6 +42,10 @@ func (s *CountOpSpec) Kind() query.OperationKind {
 	return CountKind
 }
 
+func (s *CountOpSpec) CallArguments() []query.Argument {
+	return s.AggregateConfig.CallArguments()
+}
+
 type CountProcedureSpec struct {
 	execute.AggregateConfig
 }
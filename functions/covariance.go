@@ -84,6 +84,17 @@ func (s *CovarianceOpSpec) Kind() query.OperationKind {
 	return CovarianceKind
 }
 
+func (s *CovarianceOpSpec) CallArguments() []query.Argument {
+	args := s.AggregateConfig.CallArguments()
+	if s.PearsonCorrelation {
+		args = append(args, query.Argument{Key: "pearsonr", Value: true})
+	}
+	if s.ValueDst != execute.DefaultValueColLabel {
+		args = append(args, query.Argument{Key: "valueDst", Value: s.ValueDst})
+	}
+	return args
+}
+
 type CovarianceProcedureSpec struct {
 	PearsonCorrelation bool
 	ValueLabel         string
@@ -53,6 +53,13 @@ func (s *CumulativeSumOpSpec) Kind() query.OperationKind {
 	return CumulativeSumKind
 }
 
+func (s *CumulativeSumOpSpec) CallArguments() []query.Argument {
+	if stringsEqual(s.Columns, []string{execute.DefaultValueColLabel}) {
+		return nil
+	}
+	return []query.Argument{{Key: "columns", Value: s.Columns}}
+}
+
 type CumulativeSumProcedureSpec struct {
 	Columns []string
 }
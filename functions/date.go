@@ -0,0 +1,144 @@
+package functions
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+// DatePackage is the import path of the package of time and calendar functions.
+const DatePackage = "date"
+
+const (
+	dateTArg        = "t"
+	dateLocationArg = "location"
+	dateUnitArg     = "unit"
+)
+
+func init() {
+	// Functions returning a component of the time in the location.
+	for name, f := range map[string]func(t time.Time) int{
+		"nanosecond": time.Time.Nanosecond,
+		"second":     time.Time.Second,
+		"minute":     time.Time.Minute,
+		"hour":       time.Time.Hour,
+		"weekDay":    func(t time.Time) int { return int(t.Weekday()) },
+		"monthDay":   time.Time.Day,
+		"yearDay":    time.Time.YearDay,
+		"month":      func(t time.Time) int { return int(t.Month()) },
+		"year":       time.Time.Year,
+	} {
+		f := f
+		query.RegisterPackageValue(DatePackage, name, newDateFunction(name, nil, semantic.Int,
+			func(t time.Time, args values.Object) (values.Value, error) {
+				return values.NewIntValue(int64(f(t))), nil
+			},
+		))
+	}
+
+	query.RegisterPackageValue(DatePackage, "truncate", newDateFunction(
+		"truncate",
+		map[string]semantic.Type{dateUnitArg: semantic.Duration},
+		semantic.Time,
+		dateTruncate,
+	))
+	query.RegisterPackageValue(DatePackage, "utcOffset", newDateFunction("utcOffset", nil, semantic.Duration,
+		func(t time.Time, args values.Object) (values.Value, error) {
+			_, offset := t.Zone()
+			return values.NewDurationValue(values.Duration(time.Duration(offset) * time.Second)), nil
+		},
+	))
+}
+
+// newDateFunction returns a function of a time t in an optional location, in addition to the params.
+// The location is the name of a time zone in the IANA time zone database, it defaults to UTC.
+// f is called with t converted to the location, the result is null if any argument is null.
+func newDateFunction(name string, params map[string]semantic.Type, returnType semantic.Type, f func(t time.Time, args values.Object) (values.Value, error)) values.Function {
+	required := map[string]semantic.Type{
+		dateTArg: semantic.Time,
+	}
+	for k, t := range params {
+		required[k] = t
+	}
+	all := map[string]semantic.Type{
+		dateLocationArg: semantic.String,
+	}
+	for k, t := range required {
+		all[k] = t
+	}
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     all,
+			ReturnType: returnType,
+		}),
+		func(args values.Object) (values.Value, error) {
+			loc := time.UTC
+			if v, ok := args.Get(dateLocationArg); ok {
+				if values.IsNull(v) {
+					return values.Null, nil
+				}
+				if k := v.Type().Kind(); k != semantic.String {
+					return nil, fmt.Errorf("argument %q must be of kind %v, got %v", dateLocationArg, semantic.String, k)
+				}
+				var err error
+				if loc, err = loadLocation(v.Str()); err != nil {
+					return nil, err
+				}
+			}
+			if null, err := checkArgs(args, required); err != nil || null {
+				return values.Null, err
+			}
+			t, _ := args.Get(dateTArg)
+			return f(t.Time().Time().In(loc), args)
+		},
+	)
+}
+
+// locations caches the loaded time zones by name, as functions are called once per row.
+var locations sync.Map
+
+// loadLocation returns the time zone with the given name.
+func loadLocation(name string) (*time.Location, error) {
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid location %q: %v", name, err)
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+// week is the length of the date.truncate unit that truncates to the start of the week.
+const week = 7 * 24 * time.Hour
+
+// dateTruncate truncates the wall clock time of t in its location to a multiple of the unit.
+// Units of whole days truncate to midnight in the location, units of whole weeks to midnight on Monday.
+func dateTruncate(t time.Time, args values.Object) (values.Value, error) {
+	u, _ := args.Get(dateUnitArg)
+	unit := int64(u.Duration())
+	if unit <= 0 {
+		return nil, fmt.Errorf("argument %q must be positive, got %v", dateUnitArg, u.Duration())
+	}
+	// The wall clock time as if it were UTC, so that truncating it is not affected by the offset of the location.
+	y, mo, d := t.Date()
+	wall := time.Date(y, mo, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC).UnixNano()
+	// The Unix epoch is a Thursday, weeks start 4 days later on Monday.
+	var origin int64
+	if unit%int64(week) == 0 {
+		origin = int64(4 * 24 * time.Hour)
+	}
+	r := (wall - origin) % unit
+	if r < 0 {
+		r += unit
+	}
+	w := time.Unix(0, wall-r).UTC()
+	local := time.Date(w.Year(), w.Month(), w.Day(), w.Hour(), w.Minute(), w.Second(), w.Nanosecond(), t.Location())
+	return values.NewTimeValue(values.ConvertTime(local)), nil
+}
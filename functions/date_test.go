@@ -0,0 +1,150 @@
+package functions_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/querytest"
+	"github.com/influxdata/ifql/values"
+)
+
+func TestDate_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "truncate range start",
+			Raw: `import "date"
+from(db: "mydb") |> range(start: date.truncate(t: 2018-01-03T10:30:00Z, unit: 24h))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "range1",
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{
+								Absolute: time.Date(2018, 1, 3, 0, 0, 0, 0, time.UTC),
+							},
+							Stop: query.Now,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "range1"},
+				},
+			},
+		},
+		{
+			Name: "argument of wrong type",
+			Raw: `import "date"
+from(db: "mydb") |> filter(fn: (r) => date.hour(t: r._value, location: 1) > 9)`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestDate_Functions(t *testing.T) {
+	// Saturday 2018-03-10T15:04:05.5Z is 10:04:05.5 in New York, before daylight saving time starts the next day.
+	ts := values.NewTimeValue(values.ConvertTime(time.Date(2018, 3, 10, 15, 4, 5, 5e8, time.UTC)))
+	ny := values.NewStringValue("America/New_York")
+	testCases := []packageFunctionTestCase{
+		{
+			name: "nanosecond",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(5e8),
+		},
+		{
+			name: "second",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(5),
+		},
+		{
+			name: "minute",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(4),
+		},
+		{
+			name: "hour",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(15),
+		},
+		{
+			name: "hour",
+			args: map[string]values.Value{"t": ts, "location": ny},
+			want: values.NewIntValue(10),
+		},
+		{
+			name: "weekDay",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(6),
+		},
+		{
+			name: "monthDay",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(10),
+		},
+		{
+			name: "yearDay",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(69),
+		},
+		{
+			name: "month",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(3),
+		},
+		{
+			name: "year",
+			args: map[string]values.Value{"t": ts},
+			want: values.NewIntValue(2018),
+		},
+		{
+			name: "hour",
+			args: map[string]values.Value{"t": values.Null},
+			want: values.Null,
+		},
+		{
+			name: "utcOffset",
+			args: map[string]values.Value{"t": ts, "location": ny},
+			want: values.NewDurationValue(values.Duration(-5 * time.Hour)),
+		},
+		{
+			name: "truncate",
+			args: map[string]values.Value{"t": ts, "unit": values.NewDurationValue(values.Duration(time.Hour))},
+			want: values.NewTimeValue(values.ConvertTime(time.Date(2018, 3, 10, 15, 0, 0, 0, time.UTC))),
+		},
+		{
+			name: "truncate",
+			args: map[string]values.Value{"t": ts, "unit": values.NewDurationValue(values.Duration(24 * time.Hour)), "location": ny},
+			want: values.NewTimeValue(values.ConvertTime(time.Date(2018, 3, 10, 5, 0, 0, 0, time.UTC))),
+		},
+		{
+			name: "truncate",
+			args: map[string]values.Value{"t": ts, "unit": values.NewDurationValue(values.Duration(7 * 24 * time.Hour))},
+			want: values.NewTimeValue(values.ConvertTime(time.Date(2018, 3, 5, 0, 0, 0, 0, time.UTC))),
+		},
+		{
+			name:    "truncate",
+			args:    map[string]values.Value{"t": ts, "unit": values.NewDurationValue(0)},
+			wantErr: true,
+		},
+		{
+			name:    "hour",
+			args:    map[string]values.Value{"t": ts, "location": values.NewStringValue("Nowhere/Special")},
+			wantErr: true,
+		},
+	}
+	testPackageFunctions(t, functions.DatePackage, testCases)
+}
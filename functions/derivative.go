@@ -86,6 +86,23 @@ func (s *DerivativeOpSpec) Kind() query.OperationKind {
 	return DerivativeKind
 }
 
+func (s *DerivativeOpSpec) CallArguments() []query.Argument {
+	var args []query.Argument
+	if s.Unit != query.Duration(time.Second) {
+		args = append(args, query.Argument{Key: "unit", Value: s.Unit})
+	}
+	if s.NonNegative {
+		args = append(args, query.Argument{Key: "nonNegative", Value: true})
+	}
+	if !stringsEqual(s.Columns, []string{execute.DefaultValueColLabel}) {
+		args = append(args, query.Argument{Key: "columns", Value: s.Columns})
+	}
+	if s.TimeSrc != execute.DefaultTimeColLabel {
+		args = append(args, query.Argument{Key: "timeSrc", Value: s.TimeSrc})
+	}
+	return args
+}
+
 type DerivativeProcedureSpec struct {
 	Unit        query.Duration `json:"unit"`
 	NonNegative bool           `json:"non_negative"`
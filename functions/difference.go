@@ -2,7 +2,6 @@ package functions
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/influxdata/ifql/interpreter"
 	"github.com/influxdata/ifql/query"
@@ -71,6 +70,17 @@ func (s *DifferenceOpSpec) Kind() query.OperationKind {
 	return DifferenceKind
 }
 
+func (s *DifferenceOpSpec) CallArguments() []query.Argument {
+	var args []query.Argument
+	if s.NonNegative {
+		args = append(args, query.Argument{Key: "nonNegative", Value: true})
+	}
+	if !stringsEqual(s.Columns, []string{execute.DefaultValueColLabel}) {
+		args = append(args, query.Argument{Key: "columns", Value: s.Columns})
+	}
+	return args
+}
+
 type DifferenceProcedureSpec struct {
 	NonNegative bool     `json:"non_negative"`
 	Columns     []string `json:"columns"`
@@ -173,46 +183,40 @@ func (t *differenceTransformation) Process(id execute.DatasetID, b execute.Block
 		l := cr.Len()
 		for j, c := range cols {
 			d := differences[j]
-			switch c.Type {
-			case execute.TBool:
-				builder.AppendBools(j, cr.Bools(j)[firstIdx:])
-			case execute.TInt:
-				if d != nil {
-					for i := 0; i < l; i++ {
-						v := d.updateInt(cr.Ints(j)[i])
-						if i != 0 || firstIdx == 0 {
-							builder.AppendInt(j, v)
-						}
-					}
-				} else {
-					builder.AppendInts(j, cr.Ints(j)[firstIdx:])
+			if d == nil {
+				for i := firstIdx; i < l; i++ {
+					execute.AppendValueAt(j, j, i, cr, builder)
 				}
-			case execute.TUInt:
-				if d != nil {
-					for i := 0; i < l; i++ {
-						v := d.updateUInt(cr.UInts(j)[i])
-						if i != 0 || firstIdx == 0 {
-							builder.AppendInt(j, v)
-						}
+				continue
+			}
+			for i := 0; i < l; i++ {
+				var (
+					v  interface{}
+					ok bool
+				)
+				if !execute.IsNull(cr, i, j) {
+					switch c.Type {
+					case execute.TInt:
+						v, ok = d.updateInt(cr.Ints(j)[i])
+					case execute.TUInt:
+						v, ok = d.updateUInt(cr.UInts(j)[i])
+					case execute.TFloat:
+						v, ok = d.updateFloat(cr.Floats(j)[i])
 					}
-				} else {
-					builder.AppendUInts(j, cr.UInts(j)[firstIdx:])
 				}
-			case execute.TFloat:
-				if d != nil {
-					for i := 0; i < l; i++ {
-						v := d.updateFloat(cr.Floats(j)[i])
-						if i != 0 || firstIdx == 0 {
-							builder.AppendFloat(j, v)
-						}
-					}
-				} else {
-					builder.AppendFloats(j, cr.Floats(j)[firstIdx:])
+				if i == 0 && firstIdx == 1 {
+					continue
+				}
+				if !ok {
+					builder.AppendNil(j)
+					continue
+				}
+				switch c.Type {
+				case execute.TInt, execute.TUInt:
+					builder.AppendInt(j, v.(int64))
+				case execute.TFloat:
+					builder.AppendFloat(j, v.(float64))
 				}
-			case execute.TString:
-				builder.AppendStrings(j, cr.Strings(j)[firstIdx:])
-			case execute.TTime:
-				builder.AppendTimes(j, cr.Times(j)[firstIdx:])
 			}
 		}
 		// Now that we skipped the first row, start at 0 for the rest of the batches
@@ -249,11 +253,13 @@ type difference struct {
 	pFloatValue float64
 }
 
-func (d *difference) updateInt(v int64) int64 {
+// updateInt returns the difference from the previous value and whether the difference is defined.
+// The difference of the first value is undefined, as is a negative difference when only non negative differences are kept.
+func (d *difference) updateInt(v int64) (int64, bool) {
 	if d.first {
 		d.pIntValue = v
 		d.first = false
-		return 0
+		return 0, false
 	}
 
 	diff := v - d.pIntValue
@@ -261,18 +267,16 @@ func (d *difference) updateInt(v int64) int64 {
 	d.pIntValue = v
 
 	if d.nonNegative && diff < 0 {
-		//TODO(nathanielc): Return null when we have null support
-		// Also see https://github.com/influxdata/ifql/issues/217
-		return v
+		return 0, false
 	}
 
-	return diff
+	return diff, true
 }
-func (d *difference) updateUInt(v uint64) int64 {
+func (d *difference) updateUInt(v uint64) (int64, bool) {
 	if d.first {
 		d.pUIntValue = v
 		d.first = false
-		return 0
+		return 0, false
 	}
 
 	var diff int64
@@ -286,28 +290,24 @@ func (d *difference) updateUInt(v uint64) int64 {
 	d.pUIntValue = v
 
 	if d.nonNegative && diff < 0 {
-		//TODO(nathanielc): Return null when we have null support
-		// Also see https://github.com/influxdata/ifql/issues/217
-		return int64(v)
+		return 0, false
 	}
 
-	return diff
+	return diff, true
 }
-func (d *difference) updateFloat(v float64) float64 {
+func (d *difference) updateFloat(v float64) (float64, bool) {
 	if d.first {
 		d.pFloatValue = v
 		d.first = false
-		return math.NaN()
+		return 0, false
 	}
 
 	diff := v - d.pFloatValue
 	d.pFloatValue = v
 
 	if d.nonNegative && diff < 0 {
-		//TODO(nathanielc): Return null when we have null support
-		// Also see https://github.com/influxdata/ifql/issues/217
-		return v
+		return 0, false
 	}
 
-	return diff
+	return diff, true
 }
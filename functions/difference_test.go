@@ -112,7 +112,7 @@ func TestDifference_Process(t *testing.T) {
 					{Label: "_value", Type: execute.TInt},
 				},
 				Data: [][]interface{}{
-					{execute.Time(2), int64(10)},
+					{execute.Time(2), nil},
 					{execute.Time(3), int64(10)},
 				},
 			}},
@@ -190,7 +190,7 @@ func TestDifference_Process(t *testing.T) {
 					{Label: "_value", Type: execute.TInt},
 				},
 				Data: [][]interface{}{
-					{execute.Time(2), int64(10)},
+					{execute.Time(2), nil},
 					{execute.Time(3), int64(10)},
 				},
 			}},
@@ -218,7 +218,7 @@ func TestDifference_Process(t *testing.T) {
 					{Label: "_value", Type: execute.TFloat},
 				},
 				Data: [][]interface{}{
-					{execute.Time(2), 1.0},
+					{execute.Time(2), nil},
 					{execute.Time(3), 1.0},
 				},
 			}},
@@ -302,8 +302,37 @@ func TestDifference_Process(t *testing.T) {
 					{Label: "y", Type: execute.TFloat},
 				},
 				Data: [][]interface{}{
-					{execute.Time(2), 1.0, 10.0},
-					{execute.Time(3), 1.0, 0.0},
+					{execute.Time(2), nil, nil},
+					{execute.Time(3), 1.0, nil},
+				},
+			}},
+		},
+		{
+			name: "null values",
+			spec: &functions.DifferenceProcedureSpec{
+				Columns: []string{execute.DefaultValueColLabel},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "t", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0, "a"},
+					{execute.Time(2), nil, nil},
+					{execute.Time(3), 5.0, "c"},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "t", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), nil, nil},
+					{execute.Time(3), 3.0, "c"},
 				},
 			}},
 		},
@@ -53,6 +53,13 @@ func (s *DistinctOpSpec) Kind() query.OperationKind {
 	return DistinctKind
 }
 
+func (s *DistinctOpSpec) CallArguments() []query.Argument {
+	if s.Column == execute.DefaultValueColLabel {
+		return nil
+	}
+	return []query.Argument{{Key: "column", Value: s.Column}}
+}
+
 type DistinctProcedureSpec struct {
 	Column string
 }
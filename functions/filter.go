@@ -56,6 +56,10 @@ func (s *FilterOpSpec) Kind() query.OperationKind {
 	return FilterKind
 }
 
+func (s *FilterOpSpec) CallArguments() []query.Argument {
+	return []query.Argument{{Key: "fn", Value: s.Fn}}
+}
+
 type FilterProcedureSpec struct {
 	Fn *semantic.FunctionExpression
 }
@@ -86,13 +90,13 @@ func (s *FilterProcedureSpec) PushDownRules() []plan.PushDownRule {
 			Root:    FromKind,
 			Through: []plan.ProcedureKind{GroupKind, LimitKind, RangeKind},
 			Match: func(spec plan.ProcedureSpec) bool {
-				// TODO(nathanielc): Remove once row functions support calling functions
-				if _, ok := s.Fn.Body.(semantic.Expression); !ok {
+				// Storage predicates cannot contain blocks or function calls.
+				if !isPredicateExpression(s.Fn) {
 					return false
 				}
 				fs := spec.(*FromProcedureSpec)
 				if fs.Filter != nil {
-					if _, ok := fs.Filter.Body.(semantic.Expression); !ok {
+					if !isPredicateExpression(fs.Filter) {
 						return false
 					}
 				}
@@ -102,17 +106,6 @@ func (s *FilterProcedureSpec) PushDownRules() []plan.PushDownRule {
 		{
 			Root:    FilterKind,
 			Through: []plan.ProcedureKind{GroupKind, LimitKind, RangeKind},
-			Match: func(spec plan.ProcedureSpec) bool {
-				// TODO(nathanielc): Remove once row functions support calling functions
-				if _, ok := s.Fn.Body.(semantic.Expression); !ok {
-					return false
-				}
-				fs := spec.(*FilterProcedureSpec)
-				if _, ok := fs.Fn.Body.(semantic.Expression); !ok {
-					return false
-				}
-				return true
-			},
 		},
 	}
 }
@@ -131,56 +124,59 @@ func (s *FilterProcedureSpec) PushDown(root *plan.Procedure, dup func() *plan.Pr
 	}
 }
 
-func mergeArrowFunction(a, b *semantic.FunctionExpression) *semantic.FunctionExpression {
-	fn := a.Copy().(*semantic.FunctionExpression)
+// isPredicateExpression reports whether the body of fn is an expression that does not call any functions.
+func isPredicateExpression(fn *semantic.FunctionExpression) bool {
+	if _, ok := fn.Body.(semantic.Expression); !ok {
+		return false
+	}
+	v := new(callVisitor)
+	semantic.Walk(v, fn.Body)
+	return !v.found
+}
 
-	aExp, aOK := a.Body.(semantic.Expression)
-	bExp, bOK := b.Body.(semantic.Expression)
+type callVisitor struct {
+	found bool
+}
 
-	if aOK && bOK {
-		fn.Body = &semantic.LogicalExpression{
-			Operator: ast.AndOperator,
-			Left:     aExp,
-			Right:    bExp,
-		}
-		return fn
+func (v *callVisitor) Visit(node semantic.Node) semantic.Visitor {
+	if _, ok := node.(*semantic.CallExpression); ok {
+		v.found = true
+		return nil
 	}
+	return v
+}
 
-	// TODO(nathanielc): This code is unreachable while the current PushDownRule Match function is inplace.
+func (v *callVisitor) Done() {}
 
-	and := &semantic.LogicalExpression{
+// mergeArrowFunction merges the predicates a and b into a single predicate of the parameter of a.
+// A predicate that is not an expression of the same parameter is called with the row.
+func mergeArrowFunction(a, b *semantic.FunctionExpression) *semantic.FunctionExpression {
+	fn := a.Copy().(*semantic.FunctionExpression)
+	param := a.Params[0].Key.Name
+	fn.Body = &semantic.LogicalExpression{
 		Operator: ast.AndOperator,
-		Left:     aExp,
-		Right:    bExp,
-	}
-
-	// Create pass through arguments expression
-	passThroughArgs := &semantic.ObjectExpression{
-		Properties: make([]*semantic.Property, len(a.Params)),
-	}
-	for i, p := range a.Params {
-		passThroughArgs.Properties[i] = &semantic.Property{
-			Key: p.Key,
-			//TODO(nathanielc): Construct valid IdentifierExpression with Declaration for the value.
-			//Value: p.Key,
-		}
+		Left:     predicateExpression(a, param),
+		Right:    predicateExpression(b, param),
 	}
+	return fn
+}
 
-	if !aOK {
-		// Rewrite left expression as a function call.
-		and.Left = &semantic.CallExpression{
-			Callee:    a.Copy().(*semantic.FunctionExpression),
-			Arguments: passThroughArgs.Copy().(*semantic.ObjectExpression),
-		}
+// predicateExpression returns the body of the predicate fn if it is an expression of param,
+// otherwise it returns a call to fn passing param as its argument.
+func predicateExpression(fn *semantic.FunctionExpression, param string) semantic.Expression {
+	fn = fn.Copy().(*semantic.FunctionExpression)
+	if e, ok := fn.Body.(semantic.Expression); ok && fn.Params[0].Key.Name == param {
+		return e
 	}
-	if !bOK {
-		// Rewrite right expression as a function call.
-		and.Right = &semantic.CallExpression{
-			Callee:    b.Copy().(*semantic.FunctionExpression),
-			Arguments: passThroughArgs.Copy().(*semantic.ObjectExpression),
-		}
+	return &semantic.CallExpression{
+		Callee: fn,
+		Arguments: &semantic.ObjectExpression{
+			Properties: []*semantic.Property{{
+				Key:   &semantic.Identifier{Name: fn.Params[0].Key.Name},
+				Value: &semantic.IdentifierExpression{Name: param},
+			}},
+		},
 	}
-	return fn
 }
 
 func createFilterTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
@@ -318,7 +318,7 @@ func TestFilter_NewQuery(t *testing.T) {
 			Name: "from with database filter with no parens including regex and field",
 			Raw: `from(db:"mydb")
 						|> filter(fn: (r) =>
-							r["t1"]==/val1/
+							r["t1"] =~ /val1/
 							and
 							r["_field"] == 10.5
 						)
@@ -340,7 +340,7 @@ func TestFilter_NewQuery(t *testing.T) {
 								Body: &semantic.LogicalExpression{
 									Operator: ast.AndOperator,
 									Left: &semantic.BinaryExpression{
-										Operator: ast.EqualOperator,
+										Operator: ast.RegexpMatchOperator,
 										Left: &semantic.MemberExpression{
 											Object:   &semantic.IdentifierExpression{Name: "r"},
 											Property: "t1",
@@ -390,7 +390,7 @@ func TestFilter_NewQuery(t *testing.T) {
 			Name: "from with database regex with escape",
 			Raw: `from(db:"mydb")
 						|> filter(fn: (r) =>
-							r["t1"]==/va\/l1/
+							r["t1"] =~ /va\/l1/
 						)`,
 			Want: &query.Spec{
 				Operations: []*query.Operation{
@@ -406,7 +406,7 @@ func TestFilter_NewQuery(t *testing.T) {
 							Fn: &semantic.FunctionExpression{
 								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
 								Body: &semantic.BinaryExpression{
-									Operator: ast.EqualOperator,
+									Operator: ast.RegexpMatchOperator,
 									Left: &semantic.MemberExpression{
 										Object:   &semantic.IdentifierExpression{Name: "r"},
 										Property: "t1",
@@ -426,9 +426,9 @@ func TestFilter_NewQuery(t *testing.T) {
 			Name: "from with database with two regex",
 			Raw: `from(db:"mydb")
 						|> filter(fn: (r) =>
-							r["t1"]==/va\/l1/
+							r["t1"] =~ /va\/l1/
 							and
-							r["t2"] != /val2/
+							r["t2"] !~ /val2/
 						)`,
 			Want: &query.Spec{
 				Operations: []*query.Operation{
@@ -446,7 +446,7 @@ func TestFilter_NewQuery(t *testing.T) {
 								Body: &semantic.LogicalExpression{
 									Operator: ast.AndOperator,
 									Left: &semantic.BinaryExpression{
-										Operator: ast.EqualOperator,
+										Operator: ast.RegexpMatchOperator,
 										Left: &semantic.MemberExpression{
 											Object:   &semantic.IdentifierExpression{Name: "r"},
 											Property: "t1",
@@ -454,7 +454,7 @@ func TestFilter_NewQuery(t *testing.T) {
 										Right: &semantic.RegexpLiteral{Value: regexp.MustCompile(`va/l1`)},
 									},
 									Right: &semantic.BinaryExpression{
-										Operator: ast.NotEqualOperator,
+										Operator: ast.NotRegexpMatchOperator,
 										Left: &semantic.MemberExpression{
 											Object:   &semantic.IdentifierExpression{Name: "r"},
 											Property: "t2",
@@ -568,6 +568,77 @@ func TestFilter_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: `_value>5 with null`,
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanOperator,
+						Left: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "_value",
+						},
+						Right: &semantic.FloatLiteral{Value: 5},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 6.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 6.0},
+				},
+			}},
+		},
+		{
+			name: "exists host",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.UnaryExpression{
+						Operator: ast.ExistsOperator,
+						Argument: &semantic.MemberExpression{
+							Object:   &semantic.IdentifierExpression{Name: "r"},
+							Property: "host",
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, nil},
+					{execute.Time(2), 6.0, "a"},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 6.0, "a"},
+				},
+			}},
+		},
 		{
 			name: "_value>5 multiple blocks",
 			spec: &functions.FilterProcedureSpec{
@@ -706,6 +777,124 @@ func TestFilter_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: `call function`,
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.LogicalExpression{
+						Operator: ast.AndOperator,
+						Left: &semantic.BinaryExpression{
+							Operator: ast.GreaterThanOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+							Right: &semantic.FloatLiteral{Value: 5},
+						},
+						Right: &semantic.CallExpression{
+							Callee: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "row"}}},
+								Body: &semantic.BlockStatement{
+									Body: []semantic.Statement{
+										&semantic.NativeVariableDeclaration{
+											Identifier: &semantic.Identifier{Name: "limit"},
+											Init:       &semantic.FloatLiteral{Value: 7},
+										},
+										&semantic.ReturnStatement{
+											Argument: &semantic.BinaryExpression{
+												Operator: ast.LessThanOperator,
+												Left: &semantic.MemberExpression{
+													Object:   &semantic.IdentifierExpression{Name: "row"},
+													Property: "_value",
+												},
+												Right: &semantic.IdentifierExpression{Name: "limit"},
+											},
+										},
+									},
+								},
+							},
+							Arguments: &semantic.ObjectExpression{
+								Properties: []*semantic.Property{{
+									Key:   &semantic.Identifier{Name: "row"},
+									Value: &semantic.IdentifierExpression{Name: "r"},
+								}},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 6.0},
+					{execute.Time(3), 8.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 6.0},
+				},
+			}},
+		},
+		{
+			name: `business hours`,
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.BinaryExpression{
+						Operator: ast.GreaterThanEqualOperator,
+						Left: &semantic.CallExpression{
+							Callee: &semantic.IdentifierExpression{Name: "date.hour"},
+							Arguments: &semantic.ObjectExpression{
+								Properties: []*semantic.Property{
+									{
+										Key: &semantic.Identifier{Name: "t"},
+										Value: &semantic.MemberExpression{
+											Object:   &semantic.IdentifierExpression{Name: "r"},
+											Property: "_time",
+										},
+									},
+									{
+										Key:   &semantic.Identifier{Name: "location"},
+										Value: &semantic.StringLiteral{Value: "America/New_York"},
+									},
+								},
+							},
+						},
+						Right: &semantic.IntegerLiteral{Value: 9},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					// 2018-01-01T13:00:00Z is 08:00 in New York.
+					{execute.Time(1514811600e9), 1.0},
+					{execute.Time(1514818800e9), 2.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1514818800e9), 2.0},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -886,6 +1075,94 @@ func TestFilter_PushDown_MergeExpressions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "merge block with filter",
+			spec: &functions.FilterProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "row"}}},
+					Body: &semantic.BlockStatement{
+						Body: []semantic.Statement{
+							&semantic.NativeVariableDeclaration{
+								Identifier: &semantic.Identifier{Name: "limit"},
+								Init:       &semantic.FloatLiteral{Value: 7},
+							},
+							&semantic.ReturnStatement{
+								Argument: &semantic.BinaryExpression{
+									Operator: ast.LessThanOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "row"},
+										Property: "_value",
+									},
+									Right: &semantic.IdentifierExpression{Name: "limit"},
+								},
+							},
+						},
+					},
+				},
+			},
+			root: &plan.Procedure{
+				Spec: &functions.FilterProcedureSpec{
+					Fn: &semantic.FunctionExpression{
+						Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+						Body: &semantic.BinaryExpression{
+							Operator: ast.GreaterThanOperator,
+							Left: &semantic.MemberExpression{
+								Object:   &semantic.IdentifierExpression{Name: "r"},
+								Property: "_value",
+							},
+							Right: &semantic.FloatLiteral{Value: 5},
+						},
+					},
+				},
+			},
+			want: &plan.Procedure{
+				Spec: &functions.FilterProcedureSpec{
+					Fn: &semantic.FunctionExpression{
+						Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+						Body: &semantic.LogicalExpression{
+							Operator: ast.AndOperator,
+							Left: &semantic.BinaryExpression{
+								Operator: ast.GreaterThanOperator,
+								Left: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_value",
+								},
+								Right: &semantic.FloatLiteral{Value: 5},
+							},
+							Right: &semantic.CallExpression{
+								Callee: &semantic.FunctionExpression{
+									Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "row"}}},
+									Body: &semantic.BlockStatement{
+										Body: []semantic.Statement{
+											&semantic.NativeVariableDeclaration{
+												Identifier: &semantic.Identifier{Name: "limit"},
+												Init:       &semantic.FloatLiteral{Value: 7},
+											},
+											&semantic.ReturnStatement{
+												Argument: &semantic.BinaryExpression{
+													Operator: ast.LessThanOperator,
+													Left: &semantic.MemberExpression{
+														Object:   &semantic.IdentifierExpression{Name: "row"},
+														Property: "_value",
+													},
+													Right: &semantic.IdentifierExpression{Name: "limit"},
+												},
+											},
+										},
+									},
+								},
+								Arguments: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{{
+										Key:   &semantic.Identifier{Name: "row"},
+										Value: &semantic.IdentifierExpression{Name: "r"},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -48,6 +48,10 @@ func (s *FirstOpSpec) Kind() query.OperationKind {
 	return FirstKind
 }
 
+func (s *FirstOpSpec) CallArguments() []query.Argument {
+	return s.SelectorConfig.CallArguments()
+}
+
 type FirstProcedureSpec struct {
 	execute.SelectorConfig
 }
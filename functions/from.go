@@ -2,6 +2,7 @@ package functions
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/influxdata/ifql/functions/storage"
 	"github.com/influxdata/ifql/id"
@@ -77,6 +78,20 @@ func (s *FromOpSpec) Kind() query.OperationKind {
 	return FromKind
 }
 
+func (s *FromOpSpec) CallArguments() []query.Argument {
+	var args []query.Argument
+	if s.Database != "" {
+		args = append(args, query.Argument{Key: "db", Value: s.Database})
+	}
+	if s.Bucket != "" {
+		args = append(args, query.Argument{Key: "bucket", Value: s.Bucket})
+	}
+	if len(s.Hosts) > 0 {
+		args = append(args, query.Argument{Key: "hosts", Value: s.Hosts})
+	}
+	return args
+}
+
 type FromProcedureSpec struct {
 	Database string
 	Bucket   string
@@ -165,27 +180,44 @@ func (s *FromProcedureSpec) Copy() plan.ProcedureSpec {
 
 func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
 	spec := prSpec.(*FromProcedureSpec)
-	var w execute.Window
+	// Without a range pushed down the bounds of the plan are read,
+	// the range transformations that could not be pushed down limit them further.
+	bounds := a.Bounds()
+	if spec.BoundsSet {
+		bounds = execute.Bounds{
+			Start: a.ResolveTime(spec.Bounds.Start),
+			Stop:  a.ResolveTime(spec.Bounds.Stop),
+		}
+	}
+	// The window of a read of all points within the bounds at once.
+	duration := execute.Duration(bounds.Stop) - execute.Duration(bounds.Start)
+	boundsWindow := execute.Window{
+		Every:  duration,
+		Period: duration,
+		Start:  bounds.Start,
+	}
+	w := boundsWindow
+	currentTime := bounds.Stop
 	if spec.WindowSet {
+		every := execute.Duration(spec.Window.Every)
+		start := a.ResolveTime(spec.Window.Start)
+		if spec.Window.Start.IsZero() {
+			start = a.ResolveTime(query.Now).Truncate(every)
+		}
 		w = execute.Window{
-			Every:  execute.Duration(spec.Window.Every),
+			Every:  every,
 			Period: execute.Duration(spec.Window.Period),
 			Round:  execute.Duration(spec.Window.Round),
-			Start:  a.ResolveTime(spec.Window.Start),
+			Start:  start,
 		}
-	} else {
-		duration := execute.Duration(a.ResolveTime(spec.Bounds.Stop)) - execute.Duration(a.ResolveTime(spec.Bounds.Start))
-		w = execute.Window{
-			Every:  duration,
-			Period: duration,
-			Start:  a.ResolveTime(spec.Bounds.Start),
+		// Windows are aligned with the start of the window like the window transformation does,
+		// the first window read is the first one that stops after the start of the bounds.
+		offset := start - start.Truncate(every)
+		currentTime = bounds.Start.Truncate(every) + offset
+		if bounds.Start >= currentTime {
+			currentTime += execute.Time(every)
 		}
 	}
-	currentTime := w.Start + execute.Time(w.Period)
-	bounds := execute.Bounds{
-		Start: a.ResolveTime(spec.Bounds.Start),
-		Stop:  a.ResolveTime(spec.Bounds.Stop),
-	}
 
 	deps := a.Dependencies()[FromKind].(storage.Dependencies)
 	orgID := a.OrganizationID()
@@ -201,28 +233,107 @@ func createFromSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execu
 		bucketID = id.ID(spec.Database)
 	}
 
-	return storage.NewSource(
-		dsid,
-		deps.Reader,
-		storage.ReadSpec{
-			OrganizationID:  orgID,
-			BucketID:        bucketID,
-			Hosts:           spec.Hosts,
-			Predicate:       spec.Filter,
-			PointsLimit:     spec.PointsLimit,
-			SeriesLimit:     spec.SeriesLimit,
-			SeriesOffset:    spec.SeriesOffset,
-			Descending:      spec.Descending,
-			OrderByTime:     spec.OrderByTime,
-			MergeAll:        spec.MergeAll,
-			GroupKeys:       spec.GroupKeys,
-			GroupExcept:     spec.GroupExcept,
-			AggregateMethod: spec.AggregateMethod,
-		},
-		bounds,
-		w,
-		currentTime,
-	), nil
+	readSpec := storage.ReadSpec{
+		OrganizationID:  orgID,
+		BucketID:        bucketID,
+		Hosts:           spec.Hosts,
+		Predicate:       spec.Filter,
+		PointsLimit:     spec.PointsLimit,
+		SeriesLimit:     spec.SeriesLimit,
+		SeriesOffset:    spec.SeriesOffset,
+		Descending:      spec.Descending,
+		OrderByTime:     spec.OrderByTime,
+		MergeAll:        spec.MergeAll,
+		GroupKeys:       spec.GroupKeys,
+		GroupExcept:     spec.GroupExcept,
+		AggregateMethod: spec.AggregateMethod,
+		Windowed:        spec.WindowSet,
+	}
+	if ar, ok := deps.Reader.(storage.AggregateReader); ok && spec.AggregateSet && !ar.SupportsAggregate(spec.AggregateMethod) {
+		// The storage cannot apply the aggregate, read all points at once and window and aggregate them instead.
+		readSpec.AggregateMethod = ""
+		readSpec.Windowed = false
+		s := storage.NewSource(dsid, deps.Reader, readSpec, bounds, boundsWindow, bounds.Stop)
+		var window *execute.Window
+		if spec.WindowSet {
+			window = &w
+		}
+		return newAggregateSource(dsid, s, spec.AggregateMethod, window, bounds, a)
+	}
+	return storage.NewSource(dsid, deps.Reader, readSpec, bounds, w, currentTime), nil
+}
+
+// storageAggregate is an aggregate that can be pushed down into a storage read.
+type storageAggregate struct {
+	// spec is the procedure spec of the aggregate that storage can apply,
+	// only aggregates with the default configuration are pushed down.
+	spec   plan.ProcedureSpec
+	create execute.CreateTransformation
+}
+
+// storageAggregates are the aggregates that can be pushed down into a storage read, by aggregate method.
+// These are the aggregate types of the storage read protocol.
+var storageAggregates = map[string]storageAggregate{
+	CountKind: {
+		spec:   &CountProcedureSpec{AggregateConfig: execute.DefaultAggregateConfig},
+		create: createCountTransformation,
+	},
+	SumKind: {
+		spec:   &SumProcedureSpec{AggregateConfig: execute.DefaultAggregateConfig},
+		create: createSumTransformation,
+	},
+}
+
+// isStorageAggregate reports whether the procedure spec is an aggregate that can be pushed down into a storage read.
+func isStorageAggregate(spec plan.ProcedureSpec) bool {
+	sa, ok := storageAggregates[string(spec.Kind())]
+	return ok && reflect.DeepEqual(spec, sa.spec)
+}
+
+// aggregateSource applies the window and aggregate pushed down into a storage read
+// to the points read from a storage that does not support the aggregate.
+type aggregateSource struct {
+	execute.Source
+	d execute.Dataset
+}
+
+// newAggregateSource windows the points of the source if window is not nil and aggregates them.
+func newAggregateSource(id execute.DatasetID, s execute.Source, method string, window *execute.Window, bounds execute.Bounds, a execute.Administration) (execute.Source, error) {
+	sa, ok := storageAggregates[method]
+	if !ok {
+		return nil, fmt.Errorf("unknown aggregate method %q", method)
+	}
+	t, d, err := sa.create(id, execute.DiscardingMode, sa.spec, a)
+	if err != nil {
+		return nil, err
+	}
+	d.SetTriggerSpec(execute.DefaultTriggerSpec)
+	if window != nil {
+		cache := execute.NewBlockBuilderCache(a.Allocator())
+		wd := execute.NewDataset(id, execute.DiscardingMode, cache)
+		wd.SetTriggerSpec(execute.DefaultTriggerSpec)
+		wt := NewFixedWindowTransformation(
+			wd,
+			cache,
+			bounds,
+			*window,
+			execute.DefaultTimeColLabel,
+			execute.DefaultStartColLabel,
+			execute.DefaultStopColLabel,
+		)
+		s.AddTransformation(wt)
+		wd.AddTransformation(t)
+	} else {
+		s.AddTransformation(t)
+	}
+	return &aggregateSource{
+		Source: s,
+		d:      d,
+	}, nil
+}
+
+func (s *aggregateSource) AddTransformation(t execute.Transformation) {
+	s.d.AddTransformation(t)
 }
 
 func InjectFromDependencies(depsMap execute.Dependencies, deps storage.Dependencies) error {
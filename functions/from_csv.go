@@ -0,0 +1,234 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/influxdata/ifql/csv"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/pkg/errors"
+)
+
+const FromCSVKind = "fromCSV"
+
+// FromCSVOpSpec reads annotated CSV data, either provided inline or from a file.
+type FromCSVOpSpec struct {
+	CSV  string `json:"csv"`
+	File string `json:"file"`
+}
+
+var fromCSVSignature = semantic.FunctionSignature{
+	Params: map[string]semantic.Type{
+		"csv":  semantic.String,
+		"file": semantic.String,
+	},
+	ReturnType: query.TableObjectType,
+}
+
+func init() {
+	query.RegisterFunction(FromCSVKind, createFromCSVOpSpec, fromCSVSignature)
+	query.RegisterOpSpec(FromCSVKind, newFromCSVOp)
+	plan.RegisterProcedureSpec(FromCSVKind, newFromCSVProcedure, FromCSVKind)
+	execute.RegisterSource(FromCSVKind, createFromCSVSource)
+}
+
+func createFromCSVOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
+	spec := new(FromCSVOpSpec)
+
+	if data, ok, err := args.GetString("csv"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.CSV = data
+	}
+
+	if file, ok, err := args.GetString("file"); err != nil {
+		return nil, err
+	} else if ok {
+		spec.File = file
+	}
+
+	if spec.CSV == "" && spec.File == "" {
+		return nil, errors.New("must specify one of csv or file")
+	}
+	if spec.CSV != "" && spec.File != "" {
+		return nil, errors.New("must specify only one of csv or file")
+	}
+
+	return spec, nil
+}
+
+func newFromCSVOp() query.OperationSpec {
+	return new(FromCSVOpSpec)
+}
+
+func (s *FromCSVOpSpec) Kind() query.OperationKind {
+	return FromCSVKind
+}
+
+func (s *FromCSVOpSpec) CallArguments() []query.Argument {
+	if s.File != "" {
+		return []query.Argument{{Key: "file", Value: s.File}}
+	}
+	return []query.Argument{{Key: "csv", Value: s.CSV}}
+}
+
+type FromCSVProcedureSpec struct {
+	CSV  string
+	File string
+}
+
+func newFromCSVProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
+	spec, ok := qs.(*FromCSVOpSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", qs)
+	}
+
+	return &FromCSVProcedureSpec{
+		CSV:  spec.CSV,
+		File: spec.File,
+	}, nil
+}
+
+func (s *FromCSVProcedureSpec) Kind() plan.ProcedureKind {
+	return FromCSVKind
+}
+
+func (s *FromCSVProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(FromCSVProcedureSpec)
+	*ns = *s
+	return ns
+}
+
+func createFromCSVSource(prSpec plan.ProcedureSpec, dsid execute.DatasetID, a execute.Administration) (execute.Source, error) {
+	spec, ok := prSpec.(*FromCSVProcedureSpec)
+	if !ok {
+		return nil, fmt.Errorf("invalid spec type %T", prSpec)
+	}
+	if deps, ok := a.Dependencies()[FromCSVKind].(FromCSVDependencies); ok && spec.File != "" {
+		file, err := deps.path(spec.File)
+		if err != nil {
+			return nil, err
+		}
+		spec = spec.Copy().(*FromCSVProcedureSpec)
+		spec.File = file
+	}
+	return NewCSVSource(dsid, spec, a.Allocator()), nil
+}
+
+// FromCSVDependencies restricts the files fromCSV may read.
+type FromCSVDependencies struct {
+	// Dir is the directory of the files fromCSV may read, files are read relative to it.
+	// If Dir is empty, fromCSV may not read any files.
+	Dir string
+}
+
+// Validate reports an error if the directory is set but does not exist.
+func (d FromCSVDependencies) Validate() error {
+	if d.Dir == "" {
+		return nil
+	}
+	fi, err := os.Stat(d.Dir)
+	if err != nil {
+		return errors.Wrap(err, "invalid csv directory")
+	}
+	if !fi.IsDir() {
+		return fmt.Errorf("csv directory %q is not a directory", d.Dir)
+	}
+	return nil
+}
+
+// path returns the path of the file within the directory.
+func (d FromCSVDependencies) path(file string) (string, error) {
+	if d.Dir == "" {
+		return "", errors.New("reading csv files is disabled")
+	}
+	if filepath.IsAbs(file) || strings.HasPrefix(filepath.Clean(file), "..") {
+		return "", fmt.Errorf("csv file %q must be a relative path within the csv directory", file)
+	}
+	return filepath.Join(d.Dir, file), nil
+}
+
+// InjectFromCSVDependencies restricts the files fromCSV may read to the directory of the dependencies.
+// Without these dependencies fromCSV may read any file.
+func InjectFromCSVDependencies(depsMap execute.Dependencies, deps FromCSVDependencies) error {
+	if err := deps.Validate(); err != nil {
+		return err
+	}
+	depsMap[FromCSVKind] = deps
+	return nil
+}
+
+// csvSource decodes annotated CSV data and produces all decoded blocks, regardless of the result they belong to.
+type csvSource struct {
+	id    execute.DatasetID
+	spec  *FromCSVProcedureSpec
+	alloc *execute.Allocator
+
+	ts []execute.Transformation
+}
+
+func NewCSVSource(id execute.DatasetID, spec *FromCSVProcedureSpec, a *execute.Allocator) execute.Source {
+	return &csvSource{
+		id:    id,
+		spec:  spec,
+		alloc: a,
+	}
+}
+
+func (s *csvSource) AddTransformation(t execute.Transformation) {
+	s.ts = append(s.ts, t)
+}
+
+func (s *csvSource) Run(ctx context.Context) {
+	err := s.run(ctx)
+	for _, t := range s.ts {
+		t.Finish(s.id, err)
+	}
+}
+
+func (s *csvSource) run(ctx context.Context) error {
+	var r io.Reader
+	if s.spec.File != "" {
+		f, err := os.Open(s.spec.File)
+		if err != nil {
+			return errors.Wrap(err, "failed to open csv file")
+		}
+		defer f.Close()
+		r = f
+	} else {
+		r = strings.NewReader(s.spec.CSV)
+	}
+
+	decoder := csv.NewResultDecoder(csv.ResultDecoderConfig{
+		Allocator: s.alloc,
+	})
+	results, err := decoder.Decode(r)
+	if err != nil {
+		return errors.Wrap(err, "failed to decode csv")
+	}
+
+	for _, result := range results {
+		err := result.Blocks().Do(func(b execute.Block) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			for _, t := range s.ts {
+				if err := t.Process(s.id, b); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package functions_test
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/id"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/plan"
+	"github.com/influxdata/ifql/query/querytest"
+)
+
+func TestFromCSV_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name:    "fromCSV no args",
+			Raw:     `fromCSV()`,
+			WantErr: true,
+		},
+		{
+			Name:    "fromCSV conflicting args",
+			Raw:     `fromCSV(csv:"d", file:"f")`,
+			WantErr: true,
+		},
+		{
+			Name: "fromCSV file",
+			Raw:  `fromCSV(file:"/data/results.csv")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "fromCSV0",
+						Spec: &functions.FromCSVOpSpec{
+							File: "/data/results.csv",
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+// blockCollector is a transformation that records all processed blocks.
+type blockCollector struct {
+	blocks   []*executetest.Block
+	finished bool
+	err      error
+}
+
+func (c *blockCollector) RetractBlock(id execute.DatasetID, key execute.PartitionKey) error {
+	return nil
+}
+func (c *blockCollector) Process(id execute.DatasetID, b execute.Block) error {
+	blk, err := executetest.ConvertBlock(b)
+	if err != nil {
+		return err
+	}
+	c.blocks = append(c.blocks, blk)
+	return nil
+}
+func (c *blockCollector) UpdateWatermark(id execute.DatasetID, t execute.Time) error {
+	return nil
+}
+func (c *blockCollector) UpdateProcessingTime(id execute.DatasetID, t execute.Time) error {
+	return nil
+}
+func (c *blockCollector) Finish(id execute.DatasetID, err error) {
+	c.finished = true
+	c.err = err
+}
+
+func TestFromCSV_Source(t *testing.T) {
+	spec := &functions.FromCSVProcedureSpec{
+		CSV: `#datatype,string,long,dateTime:RFC3339,string,double
+#partition,false,false,false,true,false
+,result,table,_time,host,_value
+,_result,0,2018-05-08T20:50:00Z,A,1.5
+,_result,0,2018-05-08T20:50:10Z,A,2.5
+,_result,1,2018-05-08T20:50:00Z,B,3
+`,
+	}
+	want := []*executetest.Block{
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_time", Type: execute.TTime},
+				{Label: "host", Type: execute.TString},
+				{Label: "_value", Type: execute.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1525812600 * 1e9), "A", 1.5},
+				{execute.Time(1525812610 * 1e9), "A", 2.5},
+			},
+		},
+		{
+			KeyCols: []string{"host"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_time", Type: execute.TTime},
+				{Label: "host", Type: execute.TString},
+				{Label: "_value", Type: execute.TFloat},
+			},
+			Data: [][]interface{}{
+				{execute.Time(1525812600 * 1e9), "B", 3.0},
+			},
+		},
+	}
+
+	c := new(blockCollector)
+	src := functions.NewCSVSource(executetest.RandomDatasetID(), spec, executetest.UnlimitedAllocator)
+	src.AddTransformation(c)
+	src.Run(context.Background())
+
+	if !c.finished {
+		t.Fatal("source did not finish")
+	}
+	if c.err != nil {
+		t.Fatal(c.err)
+	}
+	executetest.NormalizeBlocks(c.blocks)
+	executetest.NormalizeBlocks(want)
+	if !cmp.Equal(want, c.blocks) {
+		t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(want, c.blocks))
+	}
+}
+
+func TestFromCSV_FileDependencies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ifql-csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	data := `#datatype,string,long,dateTime:RFC3339,double
+,result,table,_time,_value
+,_result,0,2018-05-08T20:50:00Z,1.5
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "data.csv"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		deps    *functions.FromCSVDependencies
+		file    string
+		wantErr bool
+	}{
+		{
+			name: "unrestricted",
+			file: filepath.Join(dir, "data.csv"),
+		},
+		{
+			name: "relative to directory",
+			deps: &functions.FromCSVDependencies{Dir: dir},
+			file: "data.csv",
+		},
+		{
+			name:    "absolute path",
+			deps:    &functions.FromCSVDependencies{Dir: dir},
+			file:    filepath.Join(dir, "data.csv"),
+			wantErr: true,
+		},
+		{
+			name:    "outside directory",
+			deps:    &functions.FromCSVDependencies{Dir: filepath.Join(dir, "sub")},
+			file:    "../data.csv",
+			wantErr: true,
+		},
+		{
+			name:    "disabled",
+			deps:    &functions.FromCSVDependencies{},
+			file:    "data.csv",
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			deps := make(execute.Dependencies)
+			if tc.deps != nil {
+				if tc.deps.Dir != "" {
+					if err := os.MkdirAll(tc.deps.Dir, 0755); err != nil {
+						t.Fatal(err)
+					}
+				}
+				if err := functions.InjectFromCSVDependencies(deps, *tc.deps); err != nil {
+					t.Fatal(err)
+				}
+			}
+			fromID := plan.ProcedureIDFromOperationID("fromCSV")
+			p := &plan.PlanSpec{
+				Now: time.Now(),
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 1,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					fromID: {
+						ID:   fromID,
+						Spec: &functions.FromCSVProcedureSpec{File: tc.file},
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: fromID},
+				},
+				Order: []plan.ProcedureID{fromID},
+			}
+			results, err := execute.NewExecutor(deps).Execute(context.Background(), id.ID("org"), p)
+			if err == nil {
+				err = results[plan.DefaultYieldName].Blocks().Do(func(execute.Block) error {
+					return nil
+				})
+			}
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
@@ -1,12 +1,20 @@
 package functions_test
 
 import (
+	"context"
+	"math"
+	"sort"
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/functions/storage"
+	"github.com/influxdata/ifql/id"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+	"github.com/influxdata/ifql/query/plan"
 	"github.com/influxdata/ifql/query/querytest"
 )
 
@@ -89,3 +97,206 @@ func TestFromOperation_Marshaling(t *testing.T) {
 	}
 	querytest.OperationMarshalingTestHelper(t, data, op)
 }
+
+type windowAggregateReader struct {
+	supported bool
+	reads     []windowAggregateRead
+}
+
+type windowAggregateBlocks []execute.Block
+
+func (bs windowAggregateBlocks) Do(f func(execute.Block) error) error {
+	for _, b := range bs {
+		if err := f(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type windowAggregateRead struct {
+	Start, Stop     execute.Time
+	AggregateMethod string
+	Windowed        bool
+}
+
+// windowAggregatePointsEvery is the interval of the points of the storage, every point has the value 1.
+const windowAggregatePointsEvery = execute.Time(30 * time.Second)
+
+// Read reads the points between start and stop,
+// or a point that is their sum with the stop time like storage does for a windowed aggregate.
+func (r *windowAggregateReader) Read(ctx context.Context, trace map[string]string, rs storage.ReadSpec, start, stop execute.Time) (execute.BlockIterator, error) {
+	r.reads = append(r.reads, windowAggregateRead{
+		Start:           start,
+		Stop:            stop,
+		AggregateMethod: rs.AggregateMethod,
+		Windowed:        rs.Windowed,
+	})
+	b := &executetest.Block{
+		ColMeta: []execute.ColMeta{
+			{Label: "_start", Type: execute.TTime},
+			{Label: "_stop", Type: execute.TTime},
+			{Label: "_time", Type: execute.TTime},
+			{Label: "_value", Type: execute.TFloat},
+		},
+	}
+	if rs.Windowed {
+		b.KeyCols = []string{"_start", "_stop"}
+	}
+	sum := 0.0
+	for t := start - start%windowAggregatePointsEvery; t < stop; t += windowAggregatePointsEvery {
+		if t < start {
+			continue
+		}
+		if rs.AggregateMethod == "" {
+			b.Data = append(b.Data, []interface{}{start, stop, t, 1.0})
+		}
+		sum++
+	}
+	if rs.AggregateMethod == functions.SumKind {
+		b.Data = [][]interface{}{
+			{start, stop, stop, sum},
+		}
+	}
+	return windowAggregateBlocks{b}, nil
+}
+
+func (r *windowAggregateReader) SupportsAggregate(method string) bool {
+	return r.supported
+}
+
+func (r *windowAggregateReader) Close() {}
+
+func TestFrom_WindowAggregate(t *testing.T) {
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	ts := func(d time.Duration) execute.Time {
+		return execute.Time(now.Add(d).UnixNano())
+	}
+	fromID := plan.ProcedureIDFromOperationID("from")
+	p := &plan.PlanSpec{
+		Now: now,
+		Resources: query.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Bounds: plan.BoundsSpec{
+			Start: query.Time{Absolute: now.Add(30 * time.Second)},
+			Stop:  query.Time{Absolute: now.Add(3 * time.Minute)},
+		},
+		Procedures: map[plan.ProcedureID]*plan.Procedure{
+			fromID: {
+				ID: fromID,
+				Spec: &functions.FromProcedureSpec{
+					Database:  "mydb",
+					WindowSet: true,
+					Window: plan.WindowSpec{
+						Every:  query.Duration(time.Minute),
+						Period: query.Duration(time.Minute),
+					},
+					AggregateSet:    true,
+					AggregateMethod: functions.SumKind,
+				},
+			},
+		},
+		Results: map[string]plan.YieldSpec{
+			plan.DefaultYieldName: {ID: fromID},
+		},
+		Order: []plan.ProcedureID{fromID},
+	}
+	// The blocks are the same whether storage or the window and sum transformations aggregate the points.
+	want := []*executetest.Block{
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_start", Type: execute.TTime},
+				{Label: "_stop", Type: execute.TTime},
+				{Label: "_time", Type: execute.TTime},
+				{Label: "_value", Type: execute.TFloat},
+			},
+			Data: [][]interface{}{
+				{ts(30 * time.Second), ts(time.Minute), ts(time.Minute), 1.0},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_start", Type: execute.TTime},
+				{Label: "_stop", Type: execute.TTime},
+				{Label: "_time", Type: execute.TTime},
+				{Label: "_value", Type: execute.TFloat},
+			},
+			Data: [][]interface{}{
+				{ts(time.Minute), ts(2 * time.Minute), ts(2 * time.Minute), 2.0},
+			},
+		},
+		{
+			KeyCols: []string{"_start", "_stop"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_start", Type: execute.TTime},
+				{Label: "_stop", Type: execute.TTime},
+				{Label: "_time", Type: execute.TTime},
+				{Label: "_value", Type: execute.TFloat},
+			},
+			Data: [][]interface{}{
+				{ts(2 * time.Minute), ts(3 * time.Minute), ts(3 * time.Minute), 2.0},
+			},
+		},
+	}
+	testCases := []struct {
+		name      string
+		supported bool
+		wantReads []windowAggregateRead
+	}{
+		{
+			name:      "supported",
+			supported: true,
+			wantReads: []windowAggregateRead{
+				{Start: ts(30 * time.Second), Stop: ts(time.Minute), AggregateMethod: functions.SumKind, Windowed: true},
+				{Start: ts(time.Minute), Stop: ts(2 * time.Minute), AggregateMethod: functions.SumKind, Windowed: true},
+				{Start: ts(2 * time.Minute), Stop: ts(3 * time.Minute), AggregateMethod: functions.SumKind, Windowed: true},
+			},
+		},
+		{
+			name:      "fallback",
+			supported: false,
+			wantReads: []windowAggregateRead{
+				{Start: ts(30 * time.Second), Stop: ts(3 * time.Minute)},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			r := &windowAggregateReader{supported: tc.supported}
+			deps := make(execute.Dependencies)
+			if err := functions.InjectFromDependencies(deps, storage.Dependencies{Reader: r}); err != nil {
+				t.Fatal(err)
+			}
+			results, err := execute.NewExecutor(deps).Execute(context.Background(), id.ID("org"), p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []*executetest.Block
+			if err := results[plan.DefaultYieldName].Blocks().Do(func(b execute.Block) error {
+				cb, err := executetest.ConvertBlock(b)
+				if err != nil {
+					return err
+				}
+				got = append(got, cb)
+				return nil
+			}); err != nil {
+				t.Fatal(err)
+			}
+			executetest.NormalizeBlocks(got)
+			executetest.NormalizeBlocks(want)
+			sort.Sort(executetest.SortedBlocks(got))
+			sort.Sort(executetest.SortedBlocks(want))
+			if !cmp.Equal(want, got) {
+				t.Errorf("unexpected blocks -want/+got\n%s", cmp.Diff(want, got))
+			}
+			if !cmp.Equal(tc.wantReads, r.reads) {
+				t.Errorf("unexpected reads -want/+got\n%s", cmp.Diff(tc.wantReads, r.reads))
+			}
+		})
+	}
+}
@@ -69,6 +69,17 @@ func (s *GroupOpSpec) Kind() query.OperationKind {
 	return GroupKind
 }
 
+func (s *GroupOpSpec) CallArguments() []query.Argument {
+	var args []query.Argument
+	if len(s.By) > 0 {
+		args = append(args, query.Argument{Key: "by", Value: s.By})
+	}
+	if len(s.Except) > 0 {
+		args = append(args, query.Argument{Key: "except", Value: s.Except})
+	}
+	return args
+}
+
 type GroupProcedureSpec struct {
 	By     []string
 	Except []string
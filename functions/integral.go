@@ -58,6 +58,14 @@ func (s *IntegralOpSpec) Kind() query.OperationKind {
 	return IntegralKind
 }
 
+func (s *IntegralOpSpec) CallArguments() []query.Argument {
+	var args []query.Argument
+	if s.Unit != query.Duration(time.Second) {
+		args = append(args, query.Argument{Key: "unit", Value: s.Unit})
+	}
+	return append(args, s.AggregateConfig.CallArguments()...)
+}
+
 type IntegralProcedureSpec struct {
 	Unit query.Duration `json:"unit"`
 	execute.AggregateConfig
@@ -30,13 +30,27 @@ type JoinOpSpec struct {
 	// TODO(nathanielc): Change this to a map of parent operation IDs to names.
 	// Then make it possible for the transformation to map operation IDs to parent IDs.
 	TableNames map[query.OperationID]string `json:"table_names"`
-}
+	// Method is the join method, one of inner, left, right or outer.
+	// Rows without a matching row in the other table are only joined by the outer methods,
+	// in which case the values of the missing row are null.
+	// An empty method is an inner join.
+	Method string `json:"method,omitempty"`
+}
+
+// Join methods
+const (
+	JoinMethodInner = "inner"
+	JoinMethodLeft  = "left"
+	JoinMethodRight = "right"
+	JoinMethodOuter = "outer"
+)
 
 var joinSignature = semantic.FunctionSignature{
 	Params: map[string]semantic.Type{
 		"tables": semantic.Object,
 		"fn":     semantic.Function,
 		"on":     semantic.NewArrayType(semantic.String),
+		"method": semantic.String,
 	},
 	ReturnType:   query.TableObjectType,
 	PipeArgument: "tables",
@@ -73,6 +87,17 @@ func createJoinOpSpec(args query.Arguments, a *query.Administration) (query.Oper
 		}
 	}
 
+	if method, ok, err := args.GetString("method"); err != nil {
+		return nil, err
+	} else if ok {
+		switch method {
+		case JoinMethodInner, JoinMethodLeft, JoinMethodRight, JoinMethodOuter:
+			spec.Method = method
+		default:
+			return nil, fmt.Errorf("unknown join method %q", method)
+		}
+	}
+
 	if m, ok, err := args.GetObject("tables"); err != nil {
 		return nil, err
 	} else if ok {
@@ -109,10 +134,27 @@ func (s *JoinOpSpec) Kind() query.OperationKind {
 	return JoinKind
 }
 
+func (s *JoinOpSpec) CallArguments() []query.Argument {
+	tables := make(map[string]interface{}, len(s.TableNames))
+	for id, name := range s.TableNames {
+		tables[name] = id
+	}
+	args := []query.Argument{{Key: "tables", Value: tables}}
+	if len(s.On) > 0 {
+		args = append(args, query.Argument{Key: "on", Value: s.On})
+	}
+	args = append(args, query.Argument{Key: "fn", Value: s.Fn})
+	if s.Method != "" {
+		args = append(args, query.Argument{Key: "method", Value: s.Method})
+	}
+	return args
+}
+
 type MergeJoinProcedureSpec struct {
 	On         []string                     `json:"keys"`
 	Fn         *semantic.FunctionExpression `json:"f"`
 	TableNames map[plan.ProcedureID]string  `json:"table_names"`
+	Method     string                       `json:"method,omitempty"`
 }
 
 func newMergeJoinProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
@@ -131,6 +173,7 @@ func newMergeJoinProcedure(qs query.OperationSpec, pa plan.Administration) (plan
 		On:         spec.On,
 		Fn:         spec.Fn,
 		TableNames: tableNames,
+		Method:     spec.Method,
 	}
 	sort.Strings(p.On)
 	return p, nil
@@ -146,6 +189,7 @@ func (s *MergeJoinProcedureSpec) Copy() plan.ProcedureSpec {
 	copy(ns.On, s.On)
 
 	ns.Fn = s.Fn.Copy().(*semantic.FunctionExpression)
+	ns.Method = s.Method
 
 	return ns
 }
@@ -180,7 +224,7 @@ func createMergeJoinTransformation(id execute.DatasetID, mode execute.Accumulati
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "invalid expression")
 	}
-	cache := NewMergeJoinCache(joinFn, a.Allocator(), leftName, rightName, s.On)
+	cache := NewMergeJoinCache(joinFn, a.Allocator(), leftName, rightName, s.On, s.Method)
 	d := execute.NewDataset(id, mode, cache)
 	t := NewMergeJoinTransformation(d, cache, s, parents, tableNames)
 	return t, d, nil
@@ -353,13 +397,14 @@ type mergeJoinCache struct {
 	on   map[string]bool
 
 	leftName, rightName string
+	method              string
 
 	triggerSpec query.TriggerSpec
 
 	joinFn *joinFunc
 }
 
-func NewMergeJoinCache(joinFn *joinFunc, a *execute.Allocator, leftName, rightName string, keys []string) *mergeJoinCache {
+func NewMergeJoinCache(joinFn *joinFunc, a *execute.Allocator, leftName, rightName string, keys []string, method string) *mergeJoinCache {
 	on := make(map[string]bool, len(keys))
 	for _, k := range keys {
 		on[k] = true
@@ -372,6 +417,7 @@ func NewMergeJoinCache(joinFn *joinFunc, a *execute.Allocator, leftName, rightNa
 		alloc:     a,
 		leftName:  leftName,
 		rightName: rightName,
+		method:    method,
 	}
 }
 
@@ -438,6 +484,7 @@ func (c *mergeJoinCache) Tables(key execute.PartitionKey) *joinTables {
 			right:     execute.NewColListBlockBuilder(key, c.alloc),
 			leftName:  c.leftName,
 			rightName: c.rightName,
+			method:    c.method,
 			trigger:   execute.NewTriggerFromSpec(c.triggerSpec),
 			joinFn:    c.joinFn,
 		}
@@ -455,6 +502,7 @@ type joinTables struct {
 
 	left, right         *execute.ColListBlockBuilder
 	leftName, rightName string
+	method              string
 
 	trigger execute.Trigger
 
@@ -521,34 +569,74 @@ func (t *joinTables) Join() (execute.Block, error) {
 		t.rightName: -1,
 	}
 
+	// join evaluates the join function for the rows of the left and right subsets and adds the results to the block.
+	// An empty subset represents the missing row of an outer join.
+	join := func(leftSet, rightSet subset) error {
+		ls, rs := leftSet, rightSet
+		if ls.Empty() {
+			ls = subset{Start: -1, Stop: 0}
+		}
+		if rs.Empty() {
+			rs = subset{Start: -1, Stop: 0}
+		}
+		for l := ls.Start; l < ls.Stop; l++ {
+			for r := rs.Start; r < rs.Stop; r++ {
+				// Evaluate expression and add to block
+				rows[t.leftName] = l
+				rows[t.rightName] = r
+				m, err := t.joinFn.Eval(rows)
+				if err != nil {
+					return errors.Wrap(err, "failed to evaluate join function")
+				}
+				for j, c := range bCols {
+					v, _ := m.Get(c.Label)
+					execute.AppendValue(builder, j, v)
+				}
+			}
+		}
+		return nil
+	}
+	keepLeft := t.method == JoinMethodLeft || t.method == JoinMethodOuter
+	keepRight := t.method == JoinMethodRight || t.method == JoinMethodOuter
+
 	leftSet, leftKey = t.advance(leftSet.Stop, left)
 	rightSet, rightKey = t.advance(rightSet.Stop, right)
 	for !leftSet.Empty() && !rightSet.Empty() {
 		if leftKey.Equal(rightKey) {
-			// Inner join
-			for l := leftSet.Start; l < leftSet.Stop; l++ {
-				for r := rightSet.Start; r < rightSet.Stop; r++ {
-					// Evaluate expression and add to block
-					rows[t.leftName] = l
-					rows[t.rightName] = r
-					m, err := t.joinFn.Eval(rows)
-					if err != nil {
-						return nil, errors.Wrap(err, "failed to evaluate join function")
-					}
-					for j, c := range bCols {
-						v, _ := m.Get(c.Label)
-						execute.AppendValue(builder, j, v)
-					}
-				}
+			if err := join(leftSet, rightSet); err != nil {
+				return nil, err
 			}
 			leftSet, leftKey = t.advance(leftSet.Stop, left)
 			rightSet, rightKey = t.advance(rightSet.Stop, right)
 		} else if leftKey.Less(rightKey) {
+			if keepLeft {
+				if err := join(leftSet, subset{}); err != nil {
+					return nil, err
+				}
+			}
 			leftSet, leftKey = t.advance(leftSet.Stop, left)
 		} else {
+			if keepRight {
+				if err := join(subset{}, rightSet); err != nil {
+					return nil, err
+				}
+			}
 			rightSet, rightKey = t.advance(rightSet.Stop, right)
 		}
 	}
+	// Join the remaining rows of the outer tables
+	for keepLeft && !leftSet.Empty() {
+		if err := join(leftSet, subset{}); err != nil {
+			return nil, err
+		}
+		leftSet, _ = t.advance(leftSet.Stop, left)
+	}
+	for keepRight && !rightSet.Empty() {
+		if err := join(subset{}, rightSet); err != nil {
+			return nil, err
+		}
+		rightSet, _ = t.advance(rightSet.Stop, right)
+	}
 	return builder.Block()
 }
 
@@ -581,6 +669,12 @@ func equalRowKeys(x, y int, table *execute.ColListBlock, on map[string]bool) boo
 		if !on[c.Label] {
 			continue
 		}
+		if xn, yn := execute.IsNull(table, x, j), execute.IsNull(table, y, j); xn || yn {
+			if xn != yn {
+				return false
+			}
+			continue
+		}
 		switch c.Type {
 		case execute.TBool:
 			if xv, yv := table.Bools(j)[x], table.Bools(j)[y]; xv != yv {
@@ -640,7 +734,7 @@ func NewRowJoinFunction(fn *semantic.FunctionExpression, parentIDs []execute.Dat
 	if len(fn.Params) != 1 {
 		return nil, errors.New("join function should only have one parameter for the map of tables")
 	}
-	scope, decls := query.BuiltIns()
+	scope, decls := query.CompilerBuiltIns()
 	return &joinFunc{
 		compilationCache: compiler.NewCompilationCache(fn, scope, decls),
 		scope:            make(compiler.Scope, 1),
@@ -705,6 +799,11 @@ func (f *joinFunc) Eval(rows map[string]int) (values.Object, error) {
 		obj, _ := f.record.Get(tbl)
 		o := obj.(*execute.Record)
 		for _, r := range references {
+			if row < 0 {
+				// The table has no row, so all of its values are null.
+				o.Set(r, values.Null)
+				continue
+			}
 			o.Set(r, execute.ValueForRow(row, f.recordCols[tableCol{table: tbl, col: r}], data))
 		}
 	}
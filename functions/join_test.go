@@ -699,6 +699,103 @@ func TestMergeJoin_Process(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "left with missing values",
+			spec: &functions.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				Fn:         addFunction,
+				TableNames: tableNames,
+				Method:     functions.JoinMethodLeft,
+			},
+			data0: []*executetest.Block{
+				{
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 11.0},
+						{execute.Time(2), nil},
+						{execute.Time(3), 33.0},
+					},
+				},
+			},
+		},
+		{
+			name: "outer with missing values",
+			spec: &functions.MergeJoinProcedureSpec{
+				On:         []string{"_time"},
+				Fn:         addFunction,
+				TableNames: tableNames,
+				Method:     functions.JoinMethodOuter,
+			},
+			data0: []*executetest.Block{
+				{
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 1.0},
+						{execute.Time(2), 2.0},
+						{execute.Time(3), 3.0},
+					},
+				},
+			},
+			data1: []*executetest.Block{
+				{
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 10.0},
+						{execute.Time(3), 30.0},
+						{execute.Time(4), 40.0},
+					},
+				},
+			},
+			want: []*executetest.Block{
+				{
+					ColMeta: []execute.ColMeta{
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TFloat},
+					},
+					Data: [][]interface{}{
+						{execute.Time(1), 11.0},
+						{execute.Time(2), nil},
+						{execute.Time(3), 33.0},
+						{nil, nil},
+					},
+				},
+			},
+		},
 		{
 			name: "inner with multiple matches",
 			spec: &functions.MergeJoinProcedureSpec{
@@ -1060,7 +1157,7 @@ func TestMergeJoin_Process(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			c := functions.NewMergeJoinCache(joinExpr, executetest.UnlimitedAllocator, tableNames[parents[0]], tableNames[parents[1]], tc.spec.On)
+			c := functions.NewMergeJoinCache(joinExpr, executetest.UnlimitedAllocator, tableNames[parents[0]], tableNames[parents[1]], tc.spec.On, tc.spec.Method)
 			c.SetTriggerSpec(execute.DefaultTriggerSpec)
 			jt := functions.NewMergeJoinTransformation(d, c, tc.spec, parents, tableNames)
 
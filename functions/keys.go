@@ -62,6 +62,13 @@ func (s *KeysOpSpec) Kind() query.OperationKind {
 	return KeysKind
 }
 
+func (s *KeysOpSpec) CallArguments() []query.Argument {
+	if stringsEqual(s.Except, keysExceptDefaultValue) {
+		return nil
+	}
+	return []query.Argument{{Key: "except", Value: s.Except}}
+}
+
 type KeysProcedureSpec struct {
 	Except []string
 }
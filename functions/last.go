@@ -47,6 +47,10 @@ func (s *LastOpSpec) Kind() query.OperationKind {
 	return LastKind
 }
 
+func (s *LastOpSpec) CallArguments() []query.Argument {
+	return s.SelectorConfig.CallArguments()
+}
+
 type LastProcedureSpec struct {
 	execute.SelectorConfig
 }
@@ -27,7 +27,6 @@ func init() {
 	query.RegisterFunction(LimitKind, createLimitOpSpec, limitSignature)
 	query.RegisterOpSpec(LimitKind, newLimitOp)
 	plan.RegisterProcedureSpec(LimitKind, newLimitProcedure, LimitKind)
-	// TODO register a range transformation. Currently range is only supported if it is pushed down into a select procedure.
 	execute.RegisterTransformation(LimitKind, createLimitTransformation)
 }
 
@@ -55,6 +54,10 @@ func (s *LimitOpSpec) Kind() query.OperationKind {
 	return LimitKind
 }
 
+func (s *LimitOpSpec) CallArguments() []query.Argument {
+	return []query.Argument{{Key: "n", Value: s.N}}
+}
+
 type LimitProcedureSpec struct {
 	N int64 `json:"n"`
 	//Offset int64 `json:"offset"`
@@ -208,6 +211,13 @@ func (cr limitColReader) Times(j int) []execute.Time {
 	return cr.ColReader.Times(j)[:cr.n]
 }
 
+func (cr limitColReader) Valid(j int) []bool {
+	if valid := cr.ColReader.Valid(j); valid != nil {
+		return valid[:cr.n]
+	}
+	return nil
+}
+
 func (t *limitTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
 	return t.d.UpdateWatermark(mark)
 }
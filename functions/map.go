@@ -55,6 +55,10 @@ func (s *MapOpSpec) Kind() query.OperationKind {
 	return MapKind
 }
 
+func (s *MapOpSpec) CallArguments() []query.Argument {
+	return []query.Argument{{Key: "fn", Value: s.Fn}}
+}
+
 type MapProcedureSpec struct {
 	Fn *semantic.FunctionExpression
 }
@@ -1,7 +1,10 @@
 package functions_test
 
 import (
+	"context"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/functions"
@@ -84,6 +87,97 @@ func TestMap_NewQuery(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "resolve string interpolation",
+			Raw:  `x = "cpu" from(db:"mydb") |> map(fn: (r) => "${x} ${r._value}")`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "map1",
+						Spec: &functions.MapOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.StringExpression{
+									Parts: []semantic.StringExpressionPart{
+										&semantic.InterpolatedPart{
+											Expression: &semantic.StringLiteral{Value: "cpu"},
+										},
+										&semantic.TextPart{Value: " "},
+										&semantic.InterpolatedPart{
+											Expression: &semantic.MemberExpression{
+												Object: &semantic.IdentifierExpression{
+													Name: "r",
+												},
+												Property: "_value",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "map1"},
+				},
+			},
+		},
+		{
+			Name: "resolve function call",
+			Raw: `scale = (x, factor=10) => x * factor
+from(db:"mydb") |> map(fn: (r) => scale(x: r._value))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "map1",
+						Spec: &functions.MapOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.CallExpression{
+									Callee: &semantic.FunctionExpression{
+										Params: []*semantic.FunctionParam{
+											{Key: &semantic.Identifier{Name: "x"}},
+											{Key: &semantic.Identifier{Name: "factor"}, Default: &semantic.IntegerLiteral{Value: 10}},
+										},
+										Body: &semantic.BinaryExpression{
+											Operator: ast.MultiplicationOperator,
+											Left:     &semantic.IdentifierExpression{Name: "x"},
+											Right:    &semantic.IdentifierExpression{Name: "factor"},
+										},
+									},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{
+											{
+												Key: &semantic.Identifier{Name: "x"},
+												Value: &semantic.MemberExpression{
+													Object:   &semantic.IdentifierExpression{Name: "r"},
+													Property: "_value",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "map1"},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -204,6 +298,56 @@ func TestMap_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: `_value+5 with null`,
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "_time"},
+								Value: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_time",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "_value"},
+								Value: &semantic.BinaryExpression{
+									Operator: ast.AdditionOperator,
+									Left: &semantic.MemberExpression{
+										Object:   &semantic.IdentifierExpression{Name: "r"},
+										Property: "_value",
+									},
+									Right: &semantic.FloatLiteral{Value: 5},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 6.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil},
+					{execute.Time(2), 11.0},
+				},
+			}},
+		},
 		{
 			name: `_value*_value`,
 			spec: &functions.MapProcedureSpec{
@@ -379,6 +523,458 @@ func TestMap_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "string interpolation",
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "_time"},
+								Value: &semantic.MemberExpression{
+									Object: &semantic.IdentifierExpression{
+										Name: "r",
+									},
+									Property: "_time",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "_value"},
+								Value: &semantic.StringExpression{
+									Parts: []semantic.StringExpressionPart{
+										&semantic.TextPart{Value: "cpu "},
+										&semantic.InterpolatedPart{
+											Expression: &semantic.MemberExpression{
+												Object: &semantic.IdentifierExpression{
+													Name: "r",
+												},
+												Property: "host",
+											},
+										},
+										&semantic.TextPart{Value: " is at "},
+										&semantic.InterpolatedPart{
+											Expression: &semantic.MemberExpression{
+												Object: &semantic.IdentifierExpression{
+													Name: "r",
+												},
+												Property: "_value",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.5, "a"},
+					{execute.Time(2), 6.0, "b"},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu a is at 1.5"},
+					{execute.Time(2), "cpu b is at 6"},
+				},
+			}},
+		},
+		{
+			name: "conditional expression",
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "_time"},
+								Value: &semantic.MemberExpression{
+									Object: &semantic.IdentifierExpression{
+										Name: "r",
+									},
+									Property: "_time",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "_value"},
+								Value: &semantic.ConditionalExpression{
+									Test: &semantic.BinaryExpression{
+										Operator: ast.GreaterThanOperator,
+										Left: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "r",
+											},
+											Property: "_value",
+										},
+										Right: &semantic.FloatLiteral{Value: 5},
+									},
+									Consequent: &semantic.StringLiteral{Value: "high"},
+									Alternate:  &semantic.StringLiteral{Value: "low"},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 6.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "low"},
+					{execute.Time(2), "high"},
+				},
+			}},
+		},
+		{
+			name: "sprintf",
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "_time"},
+								Value: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_time",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "_value"},
+								Value: &semantic.CallExpression{
+									Callee: &semantic.IdentifierExpression{Name: "strings.sprintf"},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{
+											{
+												Key:   &semantic.Identifier{Name: "format"},
+												Value: &semantic.StringLiteral{Value: "%s=%.2f"},
+											},
+											{
+												Key: &semantic.Identifier{Name: "args"},
+												Value: &semantic.ArrayExpression{
+													Elements: []semantic.Expression{
+														&semantic.MemberExpression{
+															Object:   &semantic.IdentifierExpression{Name: "r"},
+															Property: "host",
+														},
+														&semantic.MemberExpression{
+															Object:   &semantic.IdentifierExpression{Name: "r"},
+															Property: "_value",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "host", Type: execute.TString},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a", 1.0},
+					{execute.Time(2), "b", 6.125},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a=1.00"},
+					{execute.Time(2), "b=6.12"},
+				},
+			}},
+		},
+		{
+			name: "call functions",
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.BlockStatement{
+						Body: []semantic.Statement{
+							&semantic.NativeVariableDeclaration{
+								Identifier: &semantic.Identifier{Name: "scale"},
+								Init: &semantic.FunctionExpression{
+									Params: []*semantic.FunctionParam{
+										{Key: &semantic.Identifier{Name: "x"}},
+										{Key: &semantic.Identifier{Name: "factor"}, Default: &semantic.FloatLiteral{Value: 10}},
+									},
+									Body: &semantic.BinaryExpression{
+										Operator: ast.MultiplicationOperator,
+										Left:     &semantic.IdentifierExpression{Name: "x"},
+										Right:    &semantic.IdentifierExpression{Name: "factor"},
+									},
+								},
+							},
+							&semantic.ReturnStatement{
+								Argument: &semantic.ObjectExpression{
+									Properties: []*semantic.Property{
+										{
+											Key: &semantic.Identifier{Name: "_time"},
+											Value: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "_time",
+											},
+										},
+										{
+											Key: &semantic.Identifier{Name: "_value"},
+											Value: &semantic.CallExpression{
+												Callee: &semantic.IdentifierExpression{Name: "scale"},
+												Arguments: &semantic.ObjectExpression{
+													Properties: []*semantic.Property{{
+														Key: &semantic.Identifier{Name: "x"},
+														Value: &semantic.MemberExpression{
+															Object:   &semantic.IdentifierExpression{Name: "r"},
+															Property: "_value",
+														},
+													}},
+												},
+											},
+										},
+										{
+											Key: &semantic.Identifier{Name: "host"},
+											Value: &semantic.CallExpression{
+												Callee: &semantic.FunctionExpression{
+													Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "row"}}},
+													Body: &semantic.CallExpression{
+														Callee: &semantic.IdentifierExpression{Name: "string"},
+														Arguments: &semantic.ObjectExpression{
+															Properties: []*semantic.Property{{
+																Key: &semantic.Identifier{Name: "v"},
+																Value: &semantic.MemberExpression{
+																	Object:   &semantic.IdentifierExpression{Name: "row"},
+																	Property: "host",
+																},
+															}},
+														},
+													},
+												},
+												Arguments: &semantic.ObjectExpression{
+													Properties: []*semantic.Property{{
+														Key:   &semantic.Identifier{Name: "row"},
+														Value: &semantic.IdentifierExpression{Name: "r"},
+													}},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "host", Type: execute.TInt},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1), 1.5},
+					{execute.Time(2), int64(2), nil},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 15.0, "1"},
+					{execute.Time(2), nil, "2"},
+				},
+			}},
+		},
+		{
+			name: "math",
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "_time"},
+								Value: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_time",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "_value"},
+								Value: &semantic.CallExpression{
+									Callee: &semantic.IdentifierExpression{Name: "math.round"},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{{
+											Key: &semantic.Identifier{Name: "x"},
+											Value: &semantic.BinaryExpression{
+												Operator: ast.MultiplicationOperator,
+												Left: &semantic.CallExpression{
+													Callee: &semantic.IdentifierExpression{Name: "math.log10"},
+													Arguments: &semantic.ObjectExpression{
+														Properties: []*semantic.Property{{
+															Key: &semantic.Identifier{Name: "x"},
+															Value: &semantic.MemberExpression{
+																Object:   &semantic.IdentifierExpression{Name: "r"},
+																Property: "_value",
+															},
+														}},
+													},
+												},
+												Right: &semantic.FloatLiteral{Value: 10},
+											},
+										}},
+									},
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "delta"},
+								Value: &semantic.CallExpression{
+									Callee: &semantic.IdentifierExpression{Name: "math.abs"},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{{
+											Key: &semantic.Identifier{Name: "x"},
+											Value: &semantic.MemberExpression{
+												Object:   &semantic.IdentifierExpression{Name: "r"},
+												Property: "delta",
+											},
+										}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "delta", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 100.0, int64(-3)},
+					{execute.Time(2), 2000.0, int64(4)},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "delta", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 20.0, int64(3)},
+					{execute.Time(2), 33.0, int64(4)},
+				},
+			}},
+		},
+		{
+			name: "derive tag",
+			spec: &functions.MapProcedureSpec{
+				Fn: &semantic.FunctionExpression{
+					Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+					Body: &semantic.ObjectExpression{
+						Properties: []*semantic.Property{
+							{
+								Key: &semantic.Identifier{Name: "_time"},
+								Value: &semantic.MemberExpression{
+									Object:   &semantic.IdentifierExpression{Name: "r"},
+									Property: "_time",
+								},
+							},
+							{
+								Key: &semantic.Identifier{Name: "dc"},
+								Value: &semantic.CallExpression{
+									Callee: &semantic.IdentifierExpression{Name: "strings.toUpper"},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{{
+											Key: &semantic.Identifier{Name: "v"},
+											Value: &semantic.MemberExpression{
+												Object: &semantic.CallExpression{
+													Callee: &semantic.IdentifierExpression{Name: "regexp.findStringSubmatch"},
+													Arguments: &semantic.ObjectExpression{
+														Properties: []*semantic.Property{
+															{
+																Key:   &semantic.Identifier{Name: "r"},
+																Value: &semantic.RegexpLiteral{Value: regexp.MustCompile(`^([a-z]+)-`)},
+															},
+															{
+																Key: &semantic.Identifier{Name: "v"},
+																Value: &semantic.MemberExpression{
+																	Object:   &semantic.IdentifierExpression{Name: "r"},
+																	Property: "host",
+																},
+															},
+														},
+													},
+												},
+												Property: "1",
+											},
+										}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "east-a"},
+					{execute.Time(2), "west-b"},
+					{execute.Time(3), "localhost"},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "dc", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "EAST"},
+					{execute.Time(2), "WEST"},
+					{execute.Time(3), nil},
+				},
+			}},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -398,3 +994,189 @@ func TestMap_Process(t *testing.T) {
 		})
 	}
 }
+
+func TestMap_ProcessQuery(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+		data  []execute.Block
+		want  []*executetest.Block
+	}{
+		{
+			name: "index function results",
+			query: `
+import "regexp"
+import "strings"
+
+from(db:"mydb")
+	|> map(fn: (r) => ({
+		_time: r._time,
+		a: regexp.findStringSubmatch(r:/(c)(p)/, v:r._measurement)[2],
+		b: strings.split(v:r.host, t:"-")[0],
+	}))`,
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_measurement", Type: execute.TString},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "cpu", "east-a"},
+					{execute.Time(2), "cpu", "west-b"},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "a", Type: execute.TString},
+					{Label: "b", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "p", "east"},
+					{execute.Time(2), "p", "west"},
+				},
+			}},
+		},
+		{
+			name: "truncate time",
+			query: `
+import "date"
+
+from(db:"mydb")
+	|> map(fn: (r) => ({_time: date.truncate(t: r._time, unit: 1h), _value: r._value}))`,
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(90 * time.Minute), 1.0},
+					{execute.Time(150 * time.Minute), 2.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(time.Hour), 1.0},
+					{execute.Time(2 * time.Hour), 2.0},
+				},
+			}},
+		},
+		{
+			name: "missing column",
+			query: `
+from(db:"mydb")
+	|> map(fn: (r) => ({_time: r._time, _value: r.missing + 1.0, ok: r.missing == 1.0 or r._value > 1.0}))`,
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), 2.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "ok", Type: execute.TBool},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), nil, nil},
+					{execute.Time(2), nil, nil},
+				},
+			}},
+		},
+		{
+			name: "convert null column",
+			query: `
+from(db:"mydb")
+	|> map(fn: (r) => ({_time: r._time, s: string(v: r._value), i: int(v: r._value)}))`,
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0},
+					{execute.Time(2), nil},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "i", Type: execute.TInt},
+					{Label: "s", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(1), "1"},
+					{execute.Time(2), nil, nil},
+				},
+			}},
+		},
+		{
+			name: "sprintf null column",
+			query: `
+import "strings"
+
+from(db:"mydb")
+	|> map(fn: (r) => ({_time: r._time, s: strings.sprintf(format: "%s=%.1f", args: [r.host, r._value])}))`,
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 1.0, "a"},
+					{execute.Time(2), 2.0, nil},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "s", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), "a=1.0"},
+					{execute.Time(2), nil},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec, err := query.Compile(context.Background(), tc.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var fn *semantic.FunctionExpression
+			for _, op := range spec.Operations {
+				if s, ok := op.Spec.(*functions.MapOpSpec); ok {
+					fn = s.Fn
+				}
+			}
+			if fn == nil {
+				t.Fatal("query has no map operation")
+			}
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					f, err := functions.NewMapTransformation(d, c, &functions.MapProcedureSpec{Fn: fn})
+					if err != nil {
+						t.Fatal(err)
+					}
+					return f
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package functions
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+// MathPackage is the import path of the package of math functions.
+const MathPackage = "math"
+
+// mathT and mathU are numeric type variables, the signatures of the math functions are polymorphic over them.
+var (
+	mathT = semantic.NewConstrainedTypeVar("T", semantic.Int, semantic.UInt, semantic.Float)
+	mathU = semantic.NewConstrainedTypeVar("U", semantic.Int, semantic.UInt, semantic.Float)
+)
+
+const (
+	mathXArg = "x"
+	mathYArg = "y"
+)
+
+func init() {
+	query.RegisterPackageValue(MathPackage, "pi", values.NewFloatValue(math.Pi))
+	query.RegisterPackageValue(MathPackage, "e", values.NewFloatValue(math.E))
+
+	// Functions of a number returning a float.
+	for name, f := range map[string]func(float64) float64{
+		"sqrt":  math.Sqrt,
+		"exp":   math.Exp,
+		"log":   math.Log,
+		"log2":  math.Log2,
+		"log10": math.Log10,
+		"sin":   math.Sin,
+		"cos":   math.Cos,
+		"tan":   math.Tan,
+		"asin":  math.Asin,
+		"acos":  math.Acos,
+		"atan":  math.Atan,
+	} {
+		query.RegisterPackageValue(MathPackage, name, newFloatFunction(name, f))
+	}
+	// Functions of two numbers returning a float.
+	query.RegisterPackageValue(MathPackage, "pow", newFloat2Function("pow", mathXArg, mathYArg, math.Pow))
+	query.RegisterPackageValue(MathPackage, "atan2", newFloat2Function("atan2", mathYArg, mathXArg, math.Atan2))
+
+	// Functions returning a number of the same type as their arguments.
+	query.RegisterPackageValue(MathPackage, "abs", newNumericFunction("abs", numericFunc{
+		i: func(x int64) int64 {
+			if x < 0 {
+				return -x
+			}
+			return x
+		},
+		u: func(x uint64) uint64 { return x },
+		f: math.Abs,
+	}))
+	query.RegisterPackageValue(MathPackage, "ceil", newNumericFunction("ceil", numericFunc{f: math.Ceil}))
+	query.RegisterPackageValue(MathPackage, "floor", newNumericFunction("floor", numericFunc{f: math.Floor}))
+	query.RegisterPackageValue(MathPackage, "round", newNumericFunction("round", numericFunc{f: math.Round}))
+	query.RegisterPackageValue(MathPackage, "mod", values.NewFunction(
+		"mod",
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				mathXArg: mathT,
+				mathYArg: mathT,
+			},
+			ReturnType: mathT,
+		}),
+		mathMod,
+	))
+}
+
+// mathArg returns the numeric argument with the given name, the argument is nil if its value is null.
+func mathArg(args values.Object, name string) (values.Value, error) {
+	v, ok := args.Get(name)
+	if !ok {
+		return nil, fmt.Errorf("missing argument %q", name)
+	}
+	if values.IsNull(v) {
+		return nil, nil
+	}
+	switch k := v.Type().Kind(); k {
+	case semantic.Int, semantic.UInt, semantic.Float:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("argument %q must be a number, got %v", name, k)
+	}
+}
+
+// mathFloat converts the numeric value to a float.
+func mathFloat(v values.Value) float64 {
+	switch v.Type().Kind() {
+	case semantic.Int:
+		return float64(v.Int())
+	case semantic.UInt:
+		return float64(v.UInt())
+	default:
+		return v.Float()
+	}
+}
+
+// newFloatFunction returns a math function of a number x that computes f of x as a float.
+func newFloatFunction(name string, f func(float64) float64) values.Function {
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     map[string]semantic.Type{mathXArg: mathT},
+			ReturnType: semantic.Float,
+		}),
+		func(args values.Object) (values.Value, error) {
+			x, err := mathArg(args, mathXArg)
+			if err != nil || x == nil {
+				return values.Null, err
+			}
+			return values.NewFloatValue(f(mathFloat(x))), nil
+		},
+	)
+}
+
+// newFloat2Function returns a math function of the numbers a and b that computes f of a and b as a float.
+func newFloat2Function(name, a, b string, f func(float64, float64) float64) values.Function {
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				a: mathT,
+				b: mathU,
+			},
+			ReturnType: semantic.Float,
+		}),
+		func(args values.Object) (values.Value, error) {
+			av, err := mathArg(args, a)
+			if err != nil || av == nil {
+				return values.Null, err
+			}
+			bv, err := mathArg(args, b)
+			if err != nil || bv == nil {
+				return values.Null, err
+			}
+			return values.NewFloatValue(f(mathFloat(av), mathFloat(bv))), nil
+		},
+	)
+}
+
+// numericFunc holds the implementations of a math function for each numeric kind.
+// A missing integer implementation is the identity, since integers are already whole numbers.
+type numericFunc struct {
+	i func(int64) int64
+	u func(uint64) uint64
+	f func(float64) float64
+}
+
+// newNumericFunction returns a math function of a number x whose result is of the same type as x.
+func newNumericFunction(name string, f numericFunc) values.Function {
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     map[string]semantic.Type{mathXArg: mathT},
+			ReturnType: mathT,
+		}),
+		func(args values.Object) (values.Value, error) {
+			x, err := mathArg(args, mathXArg)
+			if err != nil || x == nil {
+				return values.Null, err
+			}
+			switch x.Type().Kind() {
+			case semantic.Int:
+				if f.i == nil {
+					return x, nil
+				}
+				return values.NewIntValue(f.i(x.Int())), nil
+			case semantic.UInt:
+				if f.u == nil {
+					return x, nil
+				}
+				return values.NewUIntValue(f.u(x.UInt())), nil
+			default:
+				return values.NewFloatValue(f.f(x.Float())), nil
+			}
+		},
+	)
+}
+
+// mathMod returns the remainder of x divided by y, x and y must be of the same type.
+func mathMod(args values.Object) (values.Value, error) {
+	x, err := mathArg(args, mathXArg)
+	if err != nil || x == nil {
+		return values.Null, err
+	}
+	y, err := mathArg(args, mathYArg)
+	if err != nil || y == nil {
+		return values.Null, err
+	}
+	if xk, yk := x.Type().Kind(), y.Type().Kind(); xk != yk {
+		return nil, fmt.Errorf("arguments %q and %q must be of the same type, got %v and %v", mathXArg, mathYArg, xk, yk)
+	}
+	switch x.Type().Kind() {
+	case semantic.Int:
+		if y.Int() == 0 {
+			return nil, errors.New("integer division by zero")
+		}
+		return values.NewIntValue(x.Int() % y.Int()), nil
+	case semantic.UInt:
+		if y.UInt() == 0 {
+			return nil, errors.New("integer division by zero")
+		}
+		return values.NewUIntValue(x.UInt() % y.UInt()), nil
+	default:
+		return values.NewFloatValue(math.Mod(x.Float(), y.Float())), nil
+	}
+}
@@ -0,0 +1,146 @@
+package functions_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/querytest"
+	"github.com/influxdata/ifql/values"
+)
+
+func TestMath_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "integer result",
+			Raw: `import "math"
+from(db: "mydb") |> limit(n: math.abs(x: -5) + math.mod(x: 7, y: 4))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "limit1",
+						Spec: &functions.LimitOpSpec{
+							N: 8,
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "limit1"},
+				},
+			},
+		},
+		{
+			Name: "argument of wrong type",
+			Raw: `import "math"
+from(db: "mydb") |> limit(n: math.abs(x: "5"))`,
+			WantErr: true,
+		},
+		{
+			Name: "arguments of different types",
+			Raw: `import "math"
+from(db: "mydb") |> limit(n: math.mod(x: 7, y: 4.0))`,
+			WantErr: true,
+		},
+		{
+			Name: "float result",
+			Raw: `import "math"
+from(db: "mydb") |> limit(n: math.sqrt(x: 64))`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestMath_Functions(t *testing.T) {
+	testCases := []packageFunctionTestCase{
+		{
+			name: "abs",
+			args: map[string]values.Value{"x": values.NewIntValue(-3)},
+			want: values.NewIntValue(3),
+		},
+		{
+			name: "abs",
+			args: map[string]values.Value{"x": values.NewFloatValue(-2.5)},
+			want: values.NewFloatValue(2.5),
+		},
+		{
+			name: "abs",
+			args: map[string]values.Value{"x": values.Null},
+			want: values.Null,
+		},
+		{
+			name: "sqrt",
+			args: map[string]values.Value{"x": values.NewUIntValue(16)},
+			want: values.NewFloatValue(4),
+		},
+		{
+			name: "log10",
+			args: map[string]values.Value{"x": values.NewFloatValue(1000)},
+			want: values.NewFloatValue(3),
+		},
+		{
+			name: "exp",
+			args: map[string]values.Value{"x": values.NewIntValue(0)},
+			want: values.NewFloatValue(1),
+		},
+		{
+			name: "round",
+			args: map[string]values.Value{"x": values.NewFloatValue(2.5)},
+			want: values.NewFloatValue(3),
+		},
+		{
+			name: "floor",
+			args: map[string]values.Value{"x": values.NewFloatValue(-2.5)},
+			want: values.NewFloatValue(-3),
+		},
+		{
+			name: "ceil",
+			args: map[string]values.Value{"x": values.NewIntValue(7)},
+			want: values.NewIntValue(7),
+		},
+		{
+			name: "pow",
+			args: map[string]values.Value{"x": values.NewIntValue(2), "y": values.NewFloatValue(10)},
+			want: values.NewFloatValue(1024),
+		},
+		{
+			name: "atan2",
+			args: map[string]values.Value{"y": values.NewFloatValue(1), "x": values.NewFloatValue(0)},
+			want: values.NewFloatValue(math.Pi / 2),
+		},
+		{
+			name: "mod",
+			args: map[string]values.Value{"x": values.NewIntValue(-7), "y": values.NewIntValue(3)},
+			want: values.NewIntValue(-1),
+		},
+		{
+			name: "mod",
+			args: map[string]values.Value{"x": values.NewFloatValue(7.5), "y": values.NewFloatValue(2)},
+			want: values.NewFloatValue(1.5),
+		},
+		{
+			name:    "mod",
+			args:    map[string]values.Value{"x": values.NewUIntValue(7), "y": values.NewUIntValue(0)},
+			wantErr: true,
+		},
+		{
+			name:    "sqrt",
+			args:    map[string]values.Value{"x": values.NewStringValue("a")},
+			wantErr: true,
+		},
+	}
+	testPackageFunctions(t, functions.MathPackage, testCases)
+}
@@ -48,6 +48,10 @@ func (s *MaxOpSpec) Kind() query.OperationKind {
 	return MaxKind
 }
 
+func (s *MaxOpSpec) CallArguments() []query.Argument {
+	return s.SelectorConfig.CallArguments()
+}
+
 type MaxProcedureSpec struct {
 	execute.SelectorConfig
 }
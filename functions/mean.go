@@ -43,6 +43,10 @@ func (s *MeanOpSpec) Kind() query.OperationKind {
 	return MeanKind
 }
 
+func (s *MeanOpSpec) CallArguments() []query.Argument {
+	return s.AggregateConfig.CallArguments()
+}
+
 type MeanProcedureSpec struct {
 	execute.AggregateConfig
 }
@@ -48,6 +48,10 @@ func (s *MinOpSpec) Kind() query.OperationKind {
 	return MinKind
 }
 
+func (s *MinOpSpec) CallArguments() []query.Argument {
+	return s.SelectorConfig.CallArguments()
+}
+
 type MinProcedureSpec struct {
 	execute.SelectorConfig
 }
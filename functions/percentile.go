@@ -96,6 +96,16 @@ func (s *PercentileOpSpec) Kind() query.OperationKind {
 	return PercentileKind
 }
 
+func (s *PercentileOpSpec) CallArguments() []query.Argument {
+	args := []query.Argument{{Key: "p", Value: s.Percentile}}
+	if s.Exact {
+		args = append(args, query.Argument{Key: "exact", Value: true})
+	} else if s.Compression != 1000 {
+		args = append(args, query.Argument{Key: "compression", Value: s.Compression})
+	}
+	return append(args, s.AggregateConfig.CallArguments()...)
+}
+
 type PercentileProcedureSpec struct {
 	Percentile  float64 `json:"percentile"`
 	Compression float64 `json:"compression"`
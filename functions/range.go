@@ -19,13 +19,14 @@ type RangeOpSpec struct {
 var rangeSignature = query.DefaultFunctionSignature()
 
 func init() {
-	rangeSignature.Params["start"] = semantic.Time
-	rangeSignature.Params["stop"] = semantic.Time
+	// The start and stop times are either absolute times or durations relative to now.
+	rangeSignature.Params["start"] = semantic.NewConstrainedTypeVar("Start", semantic.Time, semantic.Duration)
+	rangeSignature.Params["stop"] = semantic.NewConstrainedTypeVar("Stop", semantic.Time, semantic.Duration)
 
 	query.RegisterFunction(RangeKind, createRangeOpSpec, rangeSignature)
 	query.RegisterOpSpec(RangeKind, newRangeOp)
 	plan.RegisterProcedureSpec(RangeKind, newRangeProcedure, RangeKind)
-	// TODO register a range transformation. Currently range is only supported if it is pushed down into a select procedure.
+	// A range that cannot be pushed down into a from procedure is executed as a transformation.
 	execute.RegisterTransformation(RangeKind, createRangeTransformation)
 }
 
@@ -61,6 +62,15 @@ func (s *RangeOpSpec) Kind() query.OperationKind {
 	return RangeKind
 }
 
+func (s *RangeOpSpec) CallArguments() []query.Argument {
+	args := []query.Argument{{Key: "start", Value: s.Start}}
+	// A zero stop time does not bound the range, the same as the implicit now.
+	if s.Stop != query.Now && !s.Stop.IsZero() {
+		args = append(args, query.Argument{Key: "stop", Value: s.Stop})
+	}
+	return args
+}
+
 type RangeProcedureSpec struct {
 	Bounds plan.BoundsSpec
 }
@@ -119,30 +129,37 @@ func createRangeTransformation(id execute.DatasetID, mode execute.AccumulationMo
 	if !ok {
 		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
 	}
+	bounds := execute.AllTime
+	if !s.Bounds.Start.IsZero() {
+		bounds.Start = a.ResolveTime(s.Bounds.Start)
+	}
+	if s.Bounds.Stop.IsZero() {
+		bounds.Stop = a.ResolveTime(query.Now)
+	} else {
+		bounds.Stop = a.ResolveTime(s.Bounds.Stop)
+	}
 	cache := execute.NewBlockBuilderCache(a.Allocator())
 	d := execute.NewDataset(id, mode, cache)
-	t, err := NewRangeTransformation(d, cache, s)
-	if err != nil {
-		return nil, nil, err
-	}
+	t := NewRangeTransformation(d, cache, bounds)
 	return t, d, nil
 }
 
+// rangeTransformation keeps the records whose time is within the bounds,
+// and limits the start and stop times of each block to the bounds.
+// It is used when the range could not be pushed down into a from procedure.
 type rangeTransformation struct {
 	d     execute.Dataset
 	cache execute.BlockBuilderCache
 
-	Start query.Time
-	Stop  query.Time
+	bounds execute.Bounds
 }
 
-func NewRangeTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *RangeProcedureSpec) (*rangeTransformation, error) {
+func NewRangeTransformation(d execute.Dataset, cache execute.BlockBuilderCache, bounds execute.Bounds) *rangeTransformation {
 	return &rangeTransformation{
-		d:     d,
-		cache: cache,
-		Start: spec.Bounds.Start,
-		Stop:  spec.Bounds.Stop,
-	}, nil
+		d:      d,
+		cache:  cache,
+		bounds: bounds,
+	}
 }
 
 func (t *rangeTransformation) RetractBlock(id execute.DatasetID, key execute.PartitionKey) error {
@@ -150,18 +167,85 @@ func (t *rangeTransformation) RetractBlock(id execute.DatasetID, key execute.Par
 }
 
 func (t *rangeTransformation) Process(id execute.DatasetID, b execute.Block) error {
-	builder, created := t.cache.BlockBuilder(b.Key())
-	if !created {
-		return fmt.Errorf("range found duplicate block with key: %v", b.Key())
+	cols := b.Cols()
+	timeIdx := execute.ColIdx(execute.DefaultTimeColLabel, cols)
+	if timeIdx < 0 {
+		return fmt.Errorf("range found no %q column", execute.DefaultTimeColLabel)
 	}
-	execute.AddBlockCols(b, builder)
-	cols := make([]int, len(b.Cols()))
-	for i := range cols {
-		cols[i] = i
+	startIdx := execute.ColIdx(execute.DefaultStartColLabel, cols)
+	stopIdx := execute.ColIdx(execute.DefaultStopColLabel, cols)
+
+	key, ok := t.key(b.Key())
+	if !ok {
+		// The bounds of the block are outside of the range.
+		return nil
+	}
+	builder, created := t.cache.BlockBuilder(key)
+	if created {
+		execute.AddBlockCols(b, builder)
+	} else if !sameCols(builder.Cols(), cols) {
+		// Blocks whose bounds are limited to the same range share a key.
+		return fmt.Errorf("range found blocks with key %v and different columns", key)
 	}
-	execute.AppendBlock(b, builder, cols)
 
-	return nil
+	return b.Do(func(cr execute.ColReader) error {
+		l := cr.Len()
+		for i := 0; i < l; i++ {
+			if execute.IsNull(cr, i, timeIdx) || !t.bounds.Contains(cr.Times(timeIdx)[i]) {
+				continue
+			}
+			for j := range cols {
+				switch {
+				case j == startIdx && !execute.IsNull(cr, i, j):
+					builder.AppendTime(j, t.start(cr.Times(j)[i]))
+				case j == stopIdx && !execute.IsNull(cr, i, j):
+					builder.AppendTime(j, t.stop(cr.Times(j)[i]))
+				default:
+					execute.AppendValueAt(j, j, i, cr, builder)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// key returns the key of the block with its start and stop times limited to the range.
+// It reports false if the bounds of the key do not overlap the range.
+func (t *rangeTransformation) key(key execute.PartitionKey) (execute.PartitionKey, bool) {
+	bounds := execute.AllTime
+	values := make([]interface{}, len(key.Cols()))
+	for j, c := range key.Cols() {
+		switch c.Label {
+		case execute.DefaultStartColLabel:
+			bounds.Start = t.start(key.ValueTime(j))
+			values[j] = bounds.Start
+		case execute.DefaultStopColLabel:
+			bounds.Stop = t.stop(key.ValueTime(j))
+			values[j] = bounds.Stop
+		default:
+			values[j] = key.Value(j)
+		}
+	}
+	if bounds.Start >= bounds.Stop {
+		return nil, false
+	}
+	return execute.NewPartitionKey(key.Cols(), values), true
+}
+
+// start returns the start time limited to the range.
+func (t *rangeTransformation) start(start execute.Time) execute.Time {
+	if start < t.bounds.Start {
+		return t.bounds.Start
+	}
+	return start
+}
+
+// stop returns the stop time limited to the range.
+func (t *rangeTransformation) stop(stop execute.Time) execute.Time {
+	if stop > t.bounds.Stop {
+		return t.bounds.Stop
+	}
+	return stop
 }
 
 func (t *rangeTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
@@ -173,3 +257,15 @@ func (t *rangeTransformation) UpdateProcessingTime(id execute.DatasetID, pt exec
 func (t *rangeTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
 }
+
+func sameCols(a, b []execute.ColMeta) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
 	"github.com/influxdata/ifql/query/plan"
 	"github.com/influxdata/ifql/query/plan/plantest"
 	"github.com/influxdata/ifql/query/querytest"
@@ -121,3 +122,177 @@ func TestRange_PushDown_Duplicate(t *testing.T) {
 
 	plantest.PhysicalPlan_PushDown_TestHelper(t, spec, root, true, want)
 }
+
+func TestRange_Process(t *testing.T) {
+	testCases := []struct {
+		name   string
+		bounds execute.Bounds
+		data   []execute.Block
+		want   []*executetest.Block
+	}{
+		{
+			name:   "trim records and bounds",
+			bounds: execute.Bounds{Start: 3, Stop: 7},
+			data: []execute.Block{&executetest.Block{
+				KeyCols: []string{"_start", "_stop", "t1"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "t1", Type: execute.TString},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(10), "a", execute.Time(0), 1.0},
+					{execute.Time(0), execute.Time(10), "a", execute.Time(3), 2.0},
+					{execute.Time(0), execute.Time(10), "a", execute.Time(6), nil},
+					{execute.Time(0), execute.Time(10), "a", execute.Time(7), 4.0},
+					{execute.Time(0), execute.Time(10), "a", nil, 5.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop", "t1"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "t1", Type: execute.TString},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3), execute.Time(7), "a", execute.Time(3), 2.0},
+					{execute.Time(3), execute.Time(7), "a", execute.Time(6), nil},
+				},
+			}},
+		},
+		{
+			name:   "block outside of range",
+			bounds: execute.Bounds{Start: 5, Stop: 8},
+			data: []execute.Block{
+				&executetest.Block{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: []execute.ColMeta{
+						{Label: "_start", Type: execute.TTime},
+						{Label: "_stop", Type: execute.TTime},
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), execute.Time(5), execute.Time(1), int64(1)},
+					},
+				},
+				&executetest.Block{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: []execute.ColMeta{
+						{Label: "_start", Type: execute.TTime},
+						{Label: "_stop", Type: execute.TTime},
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5), execute.Time(10), execute.Time(5), int64(2)},
+						{execute.Time(5), execute.Time(10), execute.Time(9), int64(3)},
+					},
+				},
+			},
+			want: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), execute.Time(8), execute.Time(5), int64(2)},
+				},
+			}},
+		},
+		{
+			name:   "overlapping windows",
+			bounds: execute.Bounds{Start: 6, Stop: 8},
+			data: []execute.Block{
+				&executetest.Block{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: []execute.ColMeta{
+						{Label: "_start", Type: execute.TTime},
+						{Label: "_stop", Type: execute.TTime},
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(0), execute.Time(10), execute.Time(6), int64(1)},
+					},
+				},
+				&executetest.Block{
+					KeyCols: []string{"_start", "_stop"},
+					ColMeta: []execute.ColMeta{
+						{Label: "_start", Type: execute.TTime},
+						{Label: "_stop", Type: execute.TTime},
+						{Label: "_time", Type: execute.TTime},
+						{Label: "_value", Type: execute.TInt},
+					},
+					Data: [][]interface{}{
+						{execute.Time(5), execute.Time(15), execute.Time(6), int64(2)},
+						{execute.Time(5), execute.Time(15), execute.Time(7), int64(3)},
+					},
+				},
+			},
+			want: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(6), execute.Time(8), execute.Time(6), int64(1)},
+					{execute.Time(6), execute.Time(8), execute.Time(6), int64(2)},
+					{execute.Time(6), execute.Time(8), execute.Time(7), int64(3)},
+				},
+			}},
+		},
+		{
+			name:   "no bounds columns",
+			bounds: execute.Bounds{Start: 2, Stop: 4},
+			data: []execute.Block{&executetest.Block{
+				KeyCols: []string{"t1"},
+				ColMeta: []execute.ColMeta{
+					{Label: "t1", Type: execute.TString},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", execute.Time(1), 1.0},
+					{"a", execute.Time(2), 2.0},
+					{"a", execute.Time(4), 3.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				KeyCols: []string{"t1"},
+				ColMeta: []execute.ColMeta{
+					{Label: "t1", Type: execute.TString},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{"a", execute.Time(2), 2.0},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewRangeTransformation(d, c, tc.bounds)
+				},
+			)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package functions
+
+import (
+	"regexp"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+// RegexpPackage is the import path of the package of regular expression functions.
+const RegexpPackage = "regexp"
+
+const (
+	regexpRArg = "r"
+	regexpVArg = "v"
+)
+
+func init() {
+	query.RegisterPackageValue(RegexpPackage, "compile", newStringFunction(
+		"compile",
+		map[string]semantic.Type{regexpVArg: semantic.String},
+		semantic.Regexp,
+		func(args values.Object) (values.Value, error) {
+			r, err := regexp.Compile(stringArg(args, regexpVArg))
+			if err != nil {
+				return nil, err
+			}
+			return values.NewRegexpValue(r), nil
+		},
+	))
+	query.RegisterPackageValue(RegexpPackage, "quoteMeta", newStringFunction(
+		"quoteMeta",
+		map[string]semantic.Type{regexpVArg: semantic.String},
+		semantic.String,
+		func(args values.Object) (values.Value, error) {
+			return values.NewStringValue(regexp.QuoteMeta(stringArg(args, regexpVArg))), nil
+		},
+	))
+
+	query.RegisterPackageValue(RegexpPackage, "matchString", newRegexpFunction("matchString", nil, semantic.Bool,
+		func(r *regexp.Regexp, args values.Object) values.Value {
+			return values.NewBoolValue(r.MatchString(stringArg(args, regexpVArg)))
+		},
+	))
+	query.RegisterPackageValue(RegexpPackage, "findString", newRegexpFunction("findString", nil, semantic.String,
+		func(r *regexp.Regexp, args values.Object) values.Value {
+			return values.NewStringValue(r.FindString(stringArg(args, regexpVArg)))
+		},
+	))
+	query.RegisterPackageValue(RegexpPackage, "findStringSubmatch", newRegexpFunction("findStringSubmatch", nil, semantic.NewArrayType(semantic.String),
+		func(r *regexp.Regexp, args values.Object) values.Value {
+			return stringArray(r.FindStringSubmatch(stringArg(args, regexpVArg)))
+		},
+	))
+	query.RegisterPackageValue(RegexpPackage, "findAllString", newRegexpFunction("findAllString", nil, semantic.NewArrayType(semantic.String),
+		func(r *regexp.Regexp, args values.Object) values.Value {
+			return stringArray(r.FindAllString(stringArg(args, regexpVArg), -1))
+		},
+	))
+	query.RegisterPackageValue(RegexpPackage, "replaceAllString", newRegexpFunction("replaceAllString", map[string]semantic.Type{"t": semantic.String}, semantic.String,
+		func(r *regexp.Regexp, args values.Object) values.Value {
+			return values.NewStringValue(r.ReplaceAllString(stringArg(args, regexpVArg), stringArg(args, "t")))
+		},
+	))
+	query.RegisterPackageValue(RegexpPackage, "splitString", newRegexpFunction("splitString", nil, semantic.NewArrayType(semantic.String),
+		func(r *regexp.Regexp, args values.Object) values.Value {
+			return stringArray(r.Split(stringArg(args, regexpVArg), -1))
+		},
+	))
+}
+
+// newRegexpFunction returns a function of a regular expression r and a string v, in addition to the params.
+func newRegexpFunction(name string, params map[string]semantic.Type, returnType semantic.Type, f func(r *regexp.Regexp, args values.Object) values.Value) values.Function {
+	all := map[string]semantic.Type{
+		regexpRArg: semantic.Regexp,
+		regexpVArg: semantic.String,
+	}
+	for k, t := range params {
+		all[k] = t
+	}
+	return newStringFunction(name, all, returnType, func(args values.Object) (values.Value, error) {
+		r, _ := args.Get(regexpRArg)
+		return f(r.Regexp(), args), nil
+	})
+}
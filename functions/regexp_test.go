@@ -0,0 +1,106 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/querytest"
+	"github.com/influxdata/ifql/values"
+)
+
+func TestRegexp_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "submatch",
+			Raw: `import "regexp"
+from(db: regexp.findStringSubmatch(r: /^(\w+)_autogen$/, v: "telegraf_autogen")[1])`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "telegraf",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "string is not a regular expression",
+			Raw: `import "regexp"
+from(db: regexp.findString(r: "telegraf", v: "telegraf_autogen"))`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestRegexp_Functions(t *testing.T) {
+	hostRegexp := values.NewRegexpValue(regexp.MustCompile(`^([a-z]+)-(\d+)$`))
+	testCases := []packageFunctionTestCase{
+		{
+			name: "matchString",
+			args: map[string]values.Value{"r": hostRegexp, "v": values.NewStringValue("east-1")},
+			want: values.NewBoolValue(true),
+		},
+		{
+			name: "findString",
+			args: map[string]values.Value{"r": values.NewRegexpValue(regexp.MustCompile(`\d+`)), "v": values.NewStringValue("east-12")},
+			want: values.NewStringValue("12"),
+		},
+		{
+			name: "findStringSubmatch",
+			args: map[string]values.Value{"r": hostRegexp, "v": values.NewStringValue("east-1")},
+			want: stringArrayValue("east-1", "east", "1"),
+		},
+		{
+			name: "findStringSubmatch",
+			args: map[string]values.Value{"r": hostRegexp, "v": values.NewStringValue("localhost")},
+			want: stringArrayValue(),
+		},
+		{
+			name: "findStringSubmatch",
+			args: map[string]values.Value{"r": hostRegexp, "v": values.Null},
+			want: values.Null,
+		},
+		{
+			name: "findAllString",
+			args: map[string]values.Value{"r": values.NewRegexpValue(regexp.MustCompile(`\d`)), "v": values.NewStringValue("a1b2")},
+			want: stringArrayValue("1", "2"),
+		},
+		{
+			name: "replaceAllString",
+			args: map[string]values.Value{"r": hostRegexp, "v": values.NewStringValue("east-1"), "t": values.NewStringValue("$2.$1")},
+			want: values.NewStringValue("1.east"),
+		},
+		{
+			name: "splitString",
+			args: map[string]values.Value{"r": values.NewRegexpValue(regexp.MustCompile(`[,;]`)), "v": values.NewStringValue("a,b;c")},
+			want: stringArrayValue("a", "b", "c"),
+		},
+		{
+			name: "quoteMeta",
+			args: map[string]values.Value{"v": values.NewStringValue("a.b")},
+			want: values.NewStringValue(`a\.b`),
+		},
+		{
+			name:    "compile",
+			args:    map[string]values.Value{"v": values.NewStringValue("(")},
+			wantErr: true,
+		},
+		{
+			name:    "matchString",
+			args:    map[string]values.Value{"r": values.NewStringValue("a"), "v": values.NewStringValue("a")},
+			wantErr: true,
+		},
+	}
+	testPackageFunctions(t, functions.RegexpPackage, testCases)
+}
@@ -67,6 +67,14 @@ func (s *SampleOpSpec) Kind() query.OperationKind {
 	return SampleKind
 }
 
+func (s *SampleOpSpec) CallArguments() []query.Argument {
+	args := []query.Argument{{Key: "n", Value: s.N}}
+	if s.Pos != -1 {
+		args = append(args, query.Argument{Key: "pos", Value: s.Pos})
+	}
+	return append(args, s.SelectorConfig.CallArguments()...)
+}
+
 type SampleProcedureSpec struct {
 	N   int64
 	Pos int64
@@ -57,6 +57,13 @@ func (s *SetOpSpec) Kind() query.OperationKind {
 	return SetKind
 }
 
+func (s *SetOpSpec) CallArguments() []query.Argument {
+	return []query.Argument{
+		{Key: "key", Value: s.Key},
+		{Key: "value", Value: s.Value},
+	}
+}
+
 type SetProcedureSpec struct {
 	Key, Value string
 }
@@ -67,6 +67,19 @@ func (s *ShiftOpSpec) Kind() query.OperationKind {
 	return ShiftKind
 }
 
+func (s *ShiftOpSpec) CallArguments() []query.Argument {
+	args := []query.Argument{{Key: "shift", Value: s.Shift}}
+	defaultColumns := []string{
+		execute.DefaultTimeColLabel,
+		execute.DefaultStopColLabel,
+		execute.DefaultStartColLabel,
+	}
+	if !stringsEqual(s.Columns, defaultColumns) {
+		args = append(args, query.Argument{Key: "columns", Value: s.Columns})
+	}
+	return args
+}
+
 type ShiftProcedureSpec struct {
 	Shift   query.Duration
 	Columns []string
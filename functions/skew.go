@@ -44,6 +44,10 @@ func (s *SkewOpSpec) Kind() query.OperationKind {
 	return SkewKind
 }
 
+func (s *SkewOpSpec) CallArguments() []query.Argument {
+	return s.AggregateConfig.CallArguments()
+}
+
 type SkewProcedureSpec struct {
 	execute.AggregateConfig
 }
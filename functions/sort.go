@@ -64,6 +64,17 @@ func (s *SortOpSpec) Kind() query.OperationKind {
 	return SortKind
 }
 
+func (s *SortOpSpec) CallArguments() []query.Argument {
+	var args []query.Argument
+	if !stringsEqual(s.Cols, []string{execute.DefaultValueColLabel}) {
+		args = append(args, query.Argument{Key: "cols", Value: s.Cols})
+	}
+	if s.Desc {
+		args = append(args, query.Argument{Key: "desc", Value: true})
+	}
+	return args
+}
+
 type SortProcedureSpec struct {
 	Cols []string
 	Desc bool
@@ -160,3 +171,15 @@ func (t *sortTransformation) UpdateProcessingTime(id execute.DatasetID, pt execu
 func (t *sortTransformation) Finish(id execute.DatasetID, err error) {
 	t.d.Finish(err)
 }
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
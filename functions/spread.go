@@ -46,6 +46,10 @@ func (s *SpreadOpSpec) Kind() query.OperationKind {
 	return SpreadKind
 }
 
+func (s *SpreadOpSpec) CallArguments() []query.Argument {
+	return s.AggregateConfig.CallArguments()
+}
+
 func newSpreadProcedure(qs query.OperationSpec, pa plan.Administration) (plan.ProcedureSpec, error) {
 	spec, ok := qs.(*SpreadOpSpec)
 	if !ok {
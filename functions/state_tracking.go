@@ -127,6 +127,23 @@ func (s *StateTrackingOpSpec) Kind() query.OperationKind {
 	return StateTrackingKind
 }
 
+func (s *StateTrackingOpSpec) CallArguments() []query.Argument {
+	args := []query.Argument{{Key: "fn", Value: s.Fn}}
+	if s.CountLabel != "" {
+		args = append(args, query.Argument{Key: "countLabel", Value: s.CountLabel})
+	}
+	if s.DurationLabel != "" {
+		args = append(args, query.Argument{Key: "durationLabel", Value: s.DurationLabel})
+	}
+	if s.DurationUnit != query.Duration(time.Second) {
+		args = append(args, query.Argument{Key: "durationUnit", Value: s.DurationUnit})
+	}
+	if s.TimeCol != execute.DefaultTimeColLabel {
+		args = append(args, query.Argument{Key: "timeCol", Value: s.TimeCol})
+	}
+	return args
+}
+
 type StateTrackingProcedureSpec struct {
 	Fn *semantic.FunctionExpression
 	CountLabel,
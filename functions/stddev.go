@@ -42,6 +42,10 @@ func (s *StddevOpSpec) Kind() query.OperationKind {
 	return StddevKind
 }
 
+func (s *StddevOpSpec) CallArguments() []query.Argument {
+	return s.AggregateConfig.CallArguments()
+}
+
 type StddevProcedureSpec struct {
 	execute.AggregateConfig
 }
@@ -56,6 +56,9 @@ func toStoragePredicate(n semantic.Expression, objectName string) (*Node, error)
 		if err != nil {
 			return nil, errors.Wrap(err, "left hand side")
 		}
+		if n.Operator == ast.InOperator {
+			return toInPredicate(left, n.Right, objectName)
+		}
 		right, err := toStoragePredicate(n.Right, objectName)
 		if err != nil {
 			return nil, errors.Wrap(err, "right hand side")
@@ -70,6 +73,35 @@ func toStoragePredicate(n semantic.Expression, objectName string) (*Node, error)
 			Value:    &Node_Comparison_{Comparison: op},
 			Children: children,
 		}, nil
+	case *semantic.UnaryExpression:
+		var op Node_Comparison
+		switch n.Operator {
+		case ast.EmptyOperator:
+			op = ComparisonEqual
+		case ast.NotEmptyOperator, ast.ExistsOperator:
+			op = ComparisonNotEqual
+		default:
+			return nil, fmt.Errorf("unsupported unary operator %v", n.Operator)
+		}
+		ref, err := toStoragePredicate(n.Argument, objectName)
+		if err != nil {
+			return nil, err
+		}
+		if ref.NodeType != NodeTypeTagRef {
+			return nil, fmt.Errorf("operator %v is only supported on tags", n.Operator)
+		}
+		// An empty or missing tag is compared as the empty string.
+		return &Node{
+			NodeType: NodeTypeComparisonExpression,
+			Value:    &Node_Comparison_{Comparison: op},
+			Children: []*Node{
+				ref,
+				{
+					NodeType: NodeTypeLiteral,
+					Value:    &Node_StringValue{StringValue: ""},
+				},
+			},
+		}, nil
 	case *semantic.StringLiteral:
 		return &Node{
 			NodeType: NodeTypeLiteral,
@@ -133,6 +165,43 @@ func toStoragePredicate(n semantic.Expression, objectName string) (*Node, error)
 	}
 }
 
+// toInPredicate converts an in expression over an array literal into an OR of equality comparisons.
+func toInPredicate(left *Node, right semantic.Expression, objectName string) (*Node, error) {
+	arr, ok := right.(*semantic.ArrayExpression)
+	if !ok {
+		return nil, fmt.Errorf("right hand side of operator %v must be an array literal, got %T", ast.InOperator, right)
+	}
+	if len(arr.Elements) == 0 {
+		return nil, fmt.Errorf("right hand side of operator %v must not be empty", ast.InOperator)
+	}
+	comparisons := make([]*Node, len(arr.Elements))
+	for i, el := range arr.Elements {
+		value, err := toStoragePredicate(el, objectName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "element %d", i)
+		}
+		comparisons[i] = &Node{
+			NodeType: NodeTypeComparisonExpression,
+			Value:    &Node_Comparison_{Comparison: ComparisonEqual},
+			Children: []*Node{left, value},
+		}
+	}
+	return orNodes(comparisons), nil
+}
+
+// orNodes combines the nodes into a balanced tree of logical OR nodes.
+func orNodes(nodes []*Node) *Node {
+	if len(nodes) == 1 {
+		return nodes[0]
+	}
+	mid := len(nodes) / 2
+	return &Node{
+		NodeType: NodeTypeLogicalExpression,
+		Value:    &Node_Logical_{Logical: LogicalOr},
+		Children: []*Node{orNodes(nodes[:mid]), orNodes(nodes[mid:])},
+	}
+}
+
 func toComparisonOperator(o ast.OperatorKind) (Node_Comparison, error) {
 	switch o {
 	case ast.EqualOperator:
@@ -0,0 +1,166 @@
+package pb_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/functions/storage/pb"
+	"github.com/influxdata/ifql/semantic"
+)
+
+func TestToStoragePredicate(t *testing.T) {
+	tagRef := func(tag string) *pb.Node {
+		return &pb.Node{
+			NodeType: pb.NodeTypeTagRef,
+			Value:    &pb.Node_TagRefValue{TagRefValue: tag},
+		}
+	}
+	str := func(s string) *pb.Node {
+		return &pb.Node{
+			NodeType: pb.NodeTypeLiteral,
+			Value:    &pb.Node_StringValue{StringValue: s},
+		}
+	}
+	compare := func(op pb.Node_Comparison, left, right *pb.Node) *pb.Node {
+		return &pb.Node{
+			NodeType: pb.NodeTypeComparisonExpression,
+			Value:    &pb.Node_Comparison_{Comparison: op},
+			Children: []*pb.Node{left, right},
+		}
+	}
+	or := func(left, right *pb.Node) *pb.Node {
+		return &pb.Node{
+			NodeType: pb.NodeTypeLogicalExpression,
+			Value:    &pb.Node_Logical_{Logical: pb.LogicalOr},
+			Children: []*pb.Node{left, right},
+		}
+	}
+	member := func(property string) *semantic.MemberExpression {
+		return &semantic.MemberExpression{
+			Object:   &semantic.IdentifierExpression{Name: "r"},
+			Property: property,
+		}
+	}
+	strings := func(values ...string) *semantic.ArrayExpression {
+		arr := new(semantic.ArrayExpression)
+		for _, v := range values {
+			arr.Elements = append(arr.Elements, &semantic.StringLiteral{Value: v})
+		}
+		return arr
+	}
+
+	testCases := []struct {
+		name    string
+		body    semantic.Expression
+		want    *pb.Node
+		wantErr bool
+	}{
+		{
+			name: "equal",
+			body: &semantic.BinaryExpression{
+				Operator: ast.EqualOperator,
+				Left:     member("host"),
+				Right:    &semantic.StringLiteral{Value: "a"},
+			},
+			want: compare(pb.ComparisonEqual, tagRef("host"), str("a")),
+		},
+		{
+			name: "in single element",
+			body: &semantic.BinaryExpression{
+				Operator: ast.InOperator,
+				Left:     member("host"),
+				Right:    strings("a"),
+			},
+			want: compare(pb.ComparisonEqual, tagRef("host"), str("a")),
+		},
+		{
+			name: "in",
+			body: &semantic.BinaryExpression{
+				Operator: ast.InOperator,
+				Left:     member("host"),
+				Right:    strings("a", "b", "c", "d"),
+			},
+			want: or(
+				or(
+					compare(pb.ComparisonEqual, tagRef("host"), str("a")),
+					compare(pb.ComparisonEqual, tagRef("host"), str("b")),
+				),
+				or(
+					compare(pb.ComparisonEqual, tagRef("host"), str("c")),
+					compare(pb.ComparisonEqual, tagRef("host"), str("d")),
+				),
+			),
+		},
+		{
+			name: "in empty array",
+			body: &semantic.BinaryExpression{
+				Operator: ast.InOperator,
+				Left:     member("host"),
+				Right:    strings(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "in identifier",
+			body: &semantic.BinaryExpression{
+				Operator: ast.InOperator,
+				Left:     member("host"),
+				Right:    &semantic.IdentifierExpression{Name: "hosts"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty",
+			body: &semantic.UnaryExpression{
+				Operator: ast.EmptyOperator,
+				Argument: member("host"),
+			},
+			want: compare(pb.ComparisonEqual, tagRef("host"), str("")),
+		},
+		{
+			name: "not empty",
+			body: &semantic.UnaryExpression{
+				Operator: ast.NotEmptyOperator,
+				Argument: member("host"),
+			},
+			want: compare(pb.ComparisonNotEqual, tagRef("host"), str("")),
+		},
+		{
+			name: "exists",
+			body: &semantic.UnaryExpression{
+				Operator: ast.ExistsOperator,
+				Argument: member("host"),
+			},
+			want: compare(pb.ComparisonNotEqual, tagRef("host"), str("")),
+		},
+		{
+			name: "empty field",
+			body: &semantic.UnaryExpression{
+				Operator: ast.EmptyOperator,
+				Argument: member("_value"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := pb.ToStoragePredicate(&semantic.FunctionExpression{
+				Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+				Body:   tc.body,
+			})
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			if !cmp.Equal(tc.want, got.Root) {
+				t.Errorf("unexpected predicate -want/+got:\n%s", cmp.Diff(tc.want, got.Root))
+			}
+		})
+	}
+}
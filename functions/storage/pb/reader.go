@@ -5,13 +5,29 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/gogo/protobuf/types"
 	"github.com/influxdata/ifql/functions/storage"
 	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/yarpc"
 	"github.com/pkg/errors"
 )
 
+// AggregatesCapability is the key of the storage capabilities that lists the aggregate types
+// the storage supports, as a comma separated list of aggregate type names, e.g. "SUM,COUNT".
+const AggregatesCapability = "aggregates"
+
+// capabilitiesTimeout is the time a reader waits for a host to report its capabilities.
+const capabilitiesTimeout = 10 * time.Second
+
+// defaultAggregates are the aggregate types supported by hosts that do not report them in their capabilities.
+var defaultAggregates = map[Aggregate_AggregateType]bool{
+	AggregateTypeSum:   true,
+	AggregateTypeCount: true,
+}
+
 func NewReader(hl storage.HostLookup) (*reader, error) {
 	// TODO(nathanielc): Watch for host changes
 	hosts := hl.Hosts()
@@ -34,6 +50,10 @@ func NewReader(hl storage.HostLookup) (*reader, error) {
 
 type reader struct {
 	conns []connection
+
+	aggregatesOnce sync.Once
+	// aggregates are the aggregate types supported by all hosts.
+	aggregates map[Aggregate_AggregateType]bool
 }
 
 type connection struct {
@@ -66,6 +86,60 @@ func (sr *reader) Read(ctx context.Context, trace map[string]string, readSpec st
 	return bi, nil
 }
 
+// SupportsAggregate reports whether all hosts support the aggregate method,
+// according to the capabilities they report the first time it is called.
+func (sr *reader) SupportsAggregate(method string) bool {
+	agg, err := determineAggregateMethod(method)
+	if err != nil {
+		return false
+	}
+	if agg == AggregateTypeNone {
+		return true
+	}
+	sr.aggregatesOnce.Do(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), capabilitiesTimeout)
+		defer cancel()
+		for i, c := range sr.conns {
+			hostAggregates := SupportedAggregates(ctx, c.client)
+			if i == 0 {
+				sr.aggregates = hostAggregates
+				continue
+			}
+			for t := range sr.aggregates {
+				if !hostAggregates[t] {
+					delete(sr.aggregates, t)
+				}
+			}
+		}
+	})
+	return sr.aggregates[agg]
+}
+
+// SupportedAggregates returns the aggregate types the storage supports according to its capabilities.
+// Storage that fails to report the aggregate types it supports is expected to support the SUM and COUNT types.
+func SupportedAggregates(ctx context.Context, client StorageClient) map[Aggregate_AggregateType]bool {
+	var (
+		names string
+		ok    bool
+	)
+	if caps, err := client.Capabilities(ctx, &types.Empty{}); err == nil {
+		names, ok = caps.Caps[AggregatesCapability]
+	}
+	aggregates := make(map[Aggregate_AggregateType]bool)
+	if !ok {
+		for t := range defaultAggregates {
+			aggregates[t] = true
+		}
+		return aggregates
+	}
+	for _, name := range strings.Split(names, ",") {
+		if t, ok := Aggregate_AggregateType_value[strings.ToUpper(strings.TrimSpace(name))]; ok {
+			aggregates[Aggregate_AggregateType(t)] = true
+		}
+	}
+	return aggregates
+}
+
 func (sr *reader) Close() {
 	for _, conn := range sr.conns {
 		_ = conn.conn.Close()
@@ -122,6 +196,7 @@ func (bi *bockIterator) Do(f func(execute.Block) error) error {
 			return err
 		}
 		streams = append(streams, &streamState{
+			bounds:   bi.bounds,
 			stream:   stream,
 			readSpec: &bi.readSpec,
 		})
@@ -138,7 +213,7 @@ func (bi *bockIterator) Do(f func(execute.Block) error) error {
 		frame := ms.next()
 		s := frame.GetSeries()
 		typ := convertDataType(s.DataType)
-		key := partitionKeyForSeries(s, &bi.readSpec)
+		key := partitionKeyForSeries(s, &bi.readSpec, bi.bounds)
 		cols := bi.determineBlockCols(s, typ)
 		block := newBlock(bi.bounds, key, cols, ms, &bi.readSpec, s.Tags)
 
@@ -214,9 +289,22 @@ func (bi *bockIterator) determineBlockCols(s *ReadResponse_SeriesFrame, typ exec
 	return cols
 }
 
-func partitionKeyForSeries(s *ReadResponse_SeriesFrame, readSpec *storage.ReadSpec) execute.PartitionKey {
-	cols := make([]execute.ColMeta, 0, len(s.Tags))
-	values := make([]interface{}, 0, len(s.Tags))
+func partitionKeyForSeries(s *ReadResponse_SeriesFrame, readSpec *storage.ReadSpec, bounds execute.Bounds) execute.PartitionKey {
+	cols := make([]execute.ColMeta, 0, len(s.Tags)+2)
+	values := make([]interface{}, 0, len(s.Tags)+2)
+	if readSpec.Windowed {
+		cols = append(cols,
+			execute.ColMeta{
+				Label: execute.DefaultStartColLabel,
+				Type:  execute.TTime,
+			},
+			execute.ColMeta{
+				Label: execute.DefaultStopColLabel,
+				Type:  execute.TTime,
+			},
+		)
+		values = append(values, bounds.Start, bounds.Stop)
+	}
 	if len(readSpec.GroupKeys) > 0 {
 		for _, tag := range s.Tags {
 			if !execute.ContainsStr(readSpec.GroupKeys, string(tag.Key)) {
@@ -273,6 +361,8 @@ type block struct {
 	l int
 	// colBufs are the buffers for the given columns.
 	colBufs []interface{}
+	// valid is the validity of the given columns, a nil entry means all values are valid.
+	valid [][]bool
 
 	// resuable buffer for the time column
 	timeBuf []execute.Time
@@ -300,6 +390,7 @@ func newBlock(
 		key:      key,
 		tags:     make([][]byte, len(cols)),
 		colBufs:  make([]interface{}, len(cols)),
+		valid:    make([][]bool, len(cols)),
 		cols:     cols,
 		readSpec: readSpec,
 		ms:       ms,
@@ -367,6 +458,9 @@ func (b *block) Times(j int) []execute.Time {
 	execute.CheckColType(b.cols[j], execute.TTime)
 	return b.colBufs[j].([]execute.Time)
 }
+func (b *block) Valid(j int) []bool {
+	return b.valid[j]
+}
 
 // readTags populates b.tags with the provided tags
 func (b *block) readTags(tags []Tag) {
@@ -575,29 +669,44 @@ func (b *block) advance() bool {
 }
 
 // appendTags fills the colBufs for the tag columns with the tag value.
+// Tags that are missing from the current series are null.
 func (b *block) appendTags() {
-	for j := range b.cols {
+	for j := valueColIdx + 1; j < len(b.cols); j++ {
 		v := b.tags[j]
+		if b.colBufs[j] == nil {
+			b.colBufs[j] = make([]string, b.l)
+		}
+		colBuf := b.colBufs[j].([]string)
+		if cap(colBuf) < b.l {
+			colBuf = make([]string, b.l)
+		} else {
+			colBuf = colBuf[:b.l]
+		}
+		vStr := string(v)
+		for i := range colBuf {
+			colBuf[i] = vStr
+		}
+		b.colBufs[j] = colBuf
+
 		if v != nil {
-			if b.colBufs[j] == nil {
-				b.colBufs[j] = make([]string, b.l)
-			}
-			colBuf := b.colBufs[j].([]string)
-			if cap(colBuf) < b.l {
-				colBuf = make([]string, b.l)
-			} else {
-				colBuf = colBuf[:b.l]
-			}
-			vStr := string(v)
-			for i := range colBuf {
-				colBuf[i] = vStr
+			b.valid[j] = nil
+			continue
+		}
+		valid := b.valid[j]
+		if cap(valid) < b.l {
+			valid = make([]bool, b.l)
+		} else {
+			valid = valid[:b.l]
+			for i := range valid {
+				valid[i] = false
 			}
-			b.colBufs[j] = colBuf
 		}
+		b.valid[j] = valid
 	}
 }
 
 // appendBounds fills the colBufs for the time bounds
+// and sets the time of the points of windowed aggregates.
 func (b *block) appendBounds() {
 	bounds := []execute.Time{b.bounds.Start, b.bounds.Stop}
 	for j := range []int{startColIdx, stopColIdx} {
@@ -615,9 +724,17 @@ func (b *block) appendBounds() {
 		}
 		b.colBufs[j] = colBuf
 	}
+	if b.readSpec.Windowed && b.readSpec.AggregateMethod != "" {
+		// The points of a windowed aggregate have the stop time of their window,
+		// like the points of an aggregate transformation.
+		for i := range b.timeBuf {
+			b.timeBuf[i] = b.bounds.Stop
+		}
+	}
 }
 
 type streamState struct {
+	bounds     execute.Bounds
 	stream     Storage_ReadClient
 	rep        ReadResponse
 	currentKey execute.PartitionKey
@@ -660,7 +777,7 @@ func (s *streamState) computeKey() {
 	// Determine new currentKey
 	if p := s.peek(); readFrameType(p) == seriesType {
 		series := p.GetSeries()
-		s.currentKey = partitionKeyForSeries(series, s.readSpec)
+		s.currentKey = partitionKeyForSeries(series, s.readSpec, s.bounds)
 	}
 }
 func (s *streamState) next() ReadResponse_Frame {
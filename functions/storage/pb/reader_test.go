@@ -0,0 +1,78 @@
+package pb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/functions/storage/pb"
+)
+
+type capabilitiesClient struct {
+	pb.StorageClient
+	caps map[string]string
+	err  error
+}
+
+func (c capabilitiesClient) Capabilities(ctx context.Context, in *types.Empty) (*pb.CapabilitiesResponse, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pb.CapabilitiesResponse{Caps: c.caps}, nil
+}
+
+func TestSupportedAggregates(t *testing.T) {
+	testCases := []struct {
+		name   string
+		client capabilitiesClient
+		want   map[pb.Aggregate_AggregateType]bool
+	}{
+		{
+			name: "reported",
+			client: capabilitiesClient{
+				caps: map[string]string{pb.AggregatesCapability: "count, unknown"},
+			},
+			want: map[pb.Aggregate_AggregateType]bool{
+				pb.AggregateTypeCount: true,
+			},
+		},
+		{
+			name: "none reported",
+			client: capabilitiesClient{
+				caps: map[string]string{pb.AggregatesCapability: ""},
+			},
+			want: map[pb.Aggregate_AggregateType]bool{},
+		},
+		{
+			name: "not reported",
+			client: capabilitiesClient{
+				caps: map[string]string{"other": "value"},
+			},
+			want: map[pb.Aggregate_AggregateType]bool{
+				pb.AggregateTypeSum:   true,
+				pb.AggregateTypeCount: true,
+			},
+		},
+		{
+			name: "not implemented",
+			client: capabilitiesClient{
+				err: errors.New("not implemented"),
+			},
+			want: map[pb.Aggregate_AggregateType]bool{
+				pb.AggregateTypeSum:   true,
+				pb.AggregateTypeCount: true,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := pb.SupportedAggregates(context.Background(), tc.client)
+			if !cmp.Equal(tc.want, got) {
+				t.Errorf("unexpected aggregates -want/+got\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
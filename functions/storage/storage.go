@@ -125,9 +125,16 @@ func (s *source) next(ctx context.Context, trace map[string]string) (execute.Blo
 	stop := s.currentTime
 
 	s.currentTime = s.currentTime + execute.Time(s.window.Every)
-	if stop > s.bounds.Stop {
+	if start >= s.bounds.Stop {
 		return nil, 0, false
 	}
+	// Windows that overlap the start or stop of the bounds are read within the bounds only.
+	if start < s.bounds.Start {
+		start = s.bounds.Start
+	}
+	if stop > s.bounds.Stop {
+		stop = s.bounds.Stop
+	}
 	bi, err := s.reader.Read(
 		ctx,
 		trace,
@@ -156,6 +163,10 @@ type ReadSpec struct {
 
 	AggregateMethod string
 
+	// Windowed indicates that the read is one of the windows of a windowed read.
+	// The bounds of the window are part of the partition key of the blocks read.
+	Windowed bool
+
 	// OrderByTime indicates that series reads should produce all
 	// series for a time before producing any series for a larger time.
 	// By default this is false meaning all values of time are produced for a given series,
@@ -173,3 +184,11 @@ type Reader interface {
 	Read(ctx context.Context, trace map[string]string, rs ReadSpec, start, stop execute.Time) (execute.BlockIterator, error)
 	Close()
 }
+
+// AggregateReader is a Reader that knows which aggregate methods the storage can apply to a read.
+// Readers that are not AggregateReaders are expected to apply any aggregate method of a ReadSpec.
+type AggregateReader interface {
+	Reader
+	// SupportsAggregate reports whether the storage can apply the aggregate method.
+	SupportsAggregate(method string) bool
+}
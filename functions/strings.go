@@ -0,0 +1,362 @@
+package functions
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+// StringsPackage is the import path of the package of string functions.
+const StringsPackage = "strings"
+
+func init() {
+	query.RegisterPackageValue(StringsPackage, "sprintf", values.NewFunction(
+		"sprintf",
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				sprintfFormatArg: semantic.String,
+				sprintfArgsArg:   semantic.Array,
+			},
+			ReturnType:  semantic.String,
+			FormatParam: sprintfFormatArg,
+			FormatArgs:  sprintfArgsArg,
+		}),
+		sprintf,
+	))
+
+	// Functions of a string returning a string.
+	for name, f := range map[string]func(string) string{
+		"toLower":   strings.ToLower,
+		"toUpper":   strings.ToUpper,
+		"trimSpace": strings.TrimSpace,
+	} {
+		f := f
+		query.RegisterPackageValue(StringsPackage, name, newStringFunction(
+			name,
+			map[string]semantic.Type{stringsVArg: semantic.String},
+			semantic.String,
+			func(args values.Object) (values.Value, error) {
+				return values.NewStringValue(f(stringArg(args, stringsVArg))), nil
+			},
+		))
+	}
+	// Functions of two strings returning a string.
+	for name, f := range map[string]struct {
+		arg string
+		f   func(string, string) string
+	}{
+		"trim":       {arg: "cutset", f: strings.Trim},
+		"trimLeft":   {arg: "cutset", f: strings.TrimLeft},
+		"trimRight":  {arg: "cutset", f: strings.TrimRight},
+		"trimPrefix": {arg: "prefix", f: strings.TrimPrefix},
+		"trimSuffix": {arg: "suffix", f: strings.TrimSuffix},
+	} {
+		f := f
+		query.RegisterPackageValue(StringsPackage, name, newStringFunction(
+			name,
+			map[string]semantic.Type{
+				stringsVArg: semantic.String,
+				f.arg:       semantic.String,
+			},
+			semantic.String,
+			func(args values.Object) (values.Value, error) {
+				return values.NewStringValue(f.f(stringArg(args, stringsVArg), stringArg(args, f.arg))), nil
+			},
+		))
+	}
+	// Functions of two strings returning a bool.
+	for name, f := range map[string]struct {
+		arg string
+		f   func(string, string) bool
+	}{
+		"contains":  {arg: "substr", f: strings.Contains},
+		"hasPrefix": {arg: "prefix", f: strings.HasPrefix},
+		"hasSuffix": {arg: "suffix", f: strings.HasSuffix},
+	} {
+		f := f
+		query.RegisterPackageValue(StringsPackage, name, newStringFunction(
+			name,
+			map[string]semantic.Type{
+				stringsVArg: semantic.String,
+				f.arg:       semantic.String,
+			},
+			semantic.Bool,
+			func(args values.Object) (values.Value, error) {
+				return values.NewBoolValue(f.f(stringArg(args, stringsVArg), stringArg(args, f.arg))), nil
+			},
+		))
+	}
+
+	query.RegisterPackageValue(StringsPackage, "strlen", newStringFunction(
+		"strlen",
+		map[string]semantic.Type{stringsVArg: semantic.String},
+		semantic.Int,
+		func(args values.Object) (values.Value, error) {
+			return values.NewIntValue(int64(utf8.RuneCountInString(stringArg(args, stringsVArg)))), nil
+		},
+	))
+	query.RegisterPackageValue(StringsPackage, "index", newStringFunction(
+		"index",
+		map[string]semantic.Type{
+			stringsVArg: semantic.String,
+			"substr":    semantic.String,
+		},
+		semantic.Int,
+		func(args values.Object) (values.Value, error) {
+			v := stringArg(args, stringsVArg)
+			i := strings.Index(v, stringArg(args, "substr"))
+			if i > 0 {
+				// Index by runes, consistent with substring.
+				i = utf8.RuneCountInString(v[:i])
+			}
+			return values.NewIntValue(int64(i)), nil
+		},
+	))
+	query.RegisterPackageValue(StringsPackage, "substring", newStringFunction(
+		"substring",
+		map[string]semantic.Type{
+			stringsVArg: semantic.String,
+			"start":     semantic.Int,
+			"end":       semantic.Int,
+		},
+		semantic.String,
+		substring,
+	))
+	query.RegisterPackageValue(StringsPackage, "split", newStringFunction(
+		"split",
+		map[string]semantic.Type{
+			stringsVArg: semantic.String,
+			"t":         semantic.String,
+		},
+		semantic.NewArrayType(semantic.String),
+		func(args values.Object) (values.Value, error) {
+			return stringArray(strings.Split(stringArg(args, stringsVArg), stringArg(args, "t"))), nil
+		},
+	))
+	query.RegisterPackageValue(StringsPackage, "join", newStringFunction(
+		"join",
+		map[string]semantic.Type{
+			"arr":       semantic.NewArrayType(semantic.String),
+			stringsVArg: semantic.String,
+		},
+		semantic.String,
+		join,
+	))
+	query.RegisterPackageValue(StringsPackage, "replace", newStringFunction(
+		"replace",
+		map[string]semantic.Type{
+			stringsVArg: semantic.String,
+			"t":         semantic.String,
+			"u":         semantic.String,
+			"i":         semantic.Int,
+		},
+		semantic.String,
+		func(args values.Object) (values.Value, error) {
+			i, _ := args.Get("i")
+			return values.NewStringValue(strings.Replace(stringArg(args, stringsVArg), stringArg(args, "t"), stringArg(args, "u"), int(i.Int()))), nil
+		},
+	))
+	query.RegisterPackageValue(StringsPackage, "replaceAll", newStringFunction(
+		"replaceAll",
+		map[string]semantic.Type{
+			stringsVArg: semantic.String,
+			"t":         semantic.String,
+			"u":         semantic.String,
+		},
+		semantic.String,
+		func(args values.Object) (values.Value, error) {
+			return values.NewStringValue(strings.Replace(stringArg(args, stringsVArg), stringArg(args, "t"), stringArg(args, "u"), -1)), nil
+		},
+	))
+}
+
+// stringsVArg is the name of the string argument of the string functions.
+const stringsVArg = "v"
+
+// newStringFunction returns a function with the given parameters, all of which are required.
+// The arguments are checked against the kinds of the parameters before f is called,
+// and the result is null without calling f if any argument is null.
+func newStringFunction(name string, params map[string]semantic.Type, returnType semantic.Type, f func(args values.Object) (values.Value, error)) values.Function {
+	return values.NewFunction(
+		name,
+		semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     params,
+			ReturnType: returnType,
+		}),
+		func(args values.Object) (values.Value, error) {
+			if null, err := checkArgs(args, params); err != nil || null {
+				return values.Null, err
+			}
+			return f(args)
+		},
+	)
+}
+
+// checkArgs checks that the args are present and of the kinds of the params,
+// and reports whether any of them is null.
+func checkArgs(args values.Object, params map[string]semantic.Type) (bool, error) {
+	null := false
+	for name, t := range params {
+		v, ok := args.Get(name)
+		if !ok {
+			return false, fmt.Errorf("missing argument %q", name)
+		}
+		if values.IsNull(v) {
+			null = true
+			continue
+		}
+		if k := v.Type().Kind(); k != t.Kind() {
+			return false, fmt.Errorf("argument %q must be of kind %v, got %v", name, t.Kind(), k)
+		}
+	}
+	return null, nil
+}
+
+// stringArg returns the string argument with the given name, which has been checked by newStringFunction.
+func stringArg(args values.Object, name string) string {
+	v, _ := args.Get(name)
+	return v.Str()
+}
+
+// stringArray returns an array value of the strings.
+func stringArray(strs []string) values.Value {
+	elements := make([]values.Value, len(strs))
+	for i, s := range strs {
+		elements[i] = values.NewStringValue(s)
+	}
+	return values.NewArrayWithBacking(semantic.String, elements)
+}
+
+// substring returns the runes of v from start up to but excluding end.
+// The indexes are clamped to the bounds of v.
+func substring(args values.Object) (values.Value, error) {
+	runes := []rune(stringArg(args, stringsVArg))
+	start, _ := args.Get("start")
+	end, _ := args.Get("end")
+	clamp := func(i int64) int {
+		if i < 0 {
+			return 0
+		}
+		if i > int64(len(runes)) {
+			return len(runes)
+		}
+		return int(i)
+	}
+	s, e := clamp(start.Int()), clamp(end.Int())
+	if s >= e {
+		return values.NewStringValue(""), nil
+	}
+	return values.NewStringValue(string(runes[s:e])), nil
+}
+
+// join concatenates the elements of arr, placing v between them.
+// The result is null if any element is null.
+func join(args values.Object) (values.Value, error) {
+	arr, _ := args.Get("arr")
+	a := arr.Array()
+	elements := make([]string, a.Len())
+	for i := range elements {
+		e := a.Get(i)
+		if values.IsNull(e) {
+			return values.Null, nil
+		}
+		if k := e.Type().Kind(); k != semantic.String {
+			return nil, fmt.Errorf("argument %q must be an array of strings, got element of kind %v", "arr", k)
+		}
+		elements[i] = e.Str()
+	}
+	return values.NewStringValue(strings.Join(elements, stringArg(args, stringsVArg))), nil
+}
+
+const (
+	sprintfFormatArg = "format"
+	sprintfArgsArg   = "args"
+)
+
+// sprintf formats the args according to the printf style format.
+// The kinds of the args are checked against the verbs of the format.
+// The result is null if any of the args is null.
+func sprintf(args values.Object) (values.Value, error) {
+	format, ok := args.Get(sprintfFormatArg)
+	if !ok {
+		return nil, fmt.Errorf("missing argument %q", sprintfFormatArg)
+	}
+	if k := format.Type().Kind(); k != semantic.String {
+		return nil, fmt.Errorf("argument %q must be a string, got %v", sprintfFormatArg, k)
+	}
+	var a values.Array
+	if v, ok := args.Get(sprintfArgsArg); ok {
+		// The args are null if any of the elements evaluated to null.
+		if values.IsNull(v) {
+			return values.Null, nil
+		}
+		if k := v.Type().Kind(); k != semantic.Array {
+			return nil, fmt.Errorf("argument %q must be an array, got %v", sprintfArgsArg, k)
+		}
+		a = v.Array()
+	} else {
+		a = values.NewArray(semantic.Nil)
+	}
+
+	kinds := make([]semantic.Kind, a.Len())
+	operands := make([]interface{}, a.Len())
+	null := false
+	a.Range(func(i int, v values.Value) {
+		if values.IsNull(v) {
+			kinds[i] = semantic.Nil
+			null = true
+			return
+		}
+		kinds[i] = v.Type().Kind()
+		operands[i] = goValue(v)
+	})
+	if err := semantic.CheckFormat(format.Str(), kinds); err != nil {
+		return nil, err
+	}
+	if null {
+		return values.Null, nil
+	}
+	return values.NewStringValue(fmt.Sprintf(format.Str(), operands...)), nil
+}
+
+// goValue converts the value to its Go representation for formatting.
+// Times and durations are formatted as their literals.
+func goValue(v values.Value) interface{} {
+	switch v.Type().Kind() {
+	case semantic.String:
+		return v.Str()
+	case semantic.Int:
+		return v.Int()
+	case semantic.UInt:
+		return v.UInt()
+	case semantic.Float:
+		return v.Float()
+	case semantic.Bool:
+		return v.Bool()
+	case semantic.Time:
+		return v.Time()
+	case semantic.Duration:
+		return v.Duration()
+	case semantic.Regexp:
+		return v.Regexp()
+	case semantic.Array:
+		a := v.Array()
+		elements := make([]interface{}, a.Len())
+		a.Range(func(i int, e values.Value) {
+			elements[i] = goValue(e)
+		})
+		return elements
+	case semantic.Object:
+		properties := make(map[string]interface{})
+		v.Object().Range(func(k string, p values.Value) {
+			properties[k] = goValue(p)
+		})
+		return properties
+	default:
+		return v
+	}
+}
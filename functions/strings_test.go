@@ -0,0 +1,287 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/querytest"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+func TestSprintf_NewQuery(t *testing.T) {
+	tests := []querytest.NewQueryTestCase{
+		{
+			Name: "format database",
+			Raw: `import "strings"
+from(db: strings.sprintf(format: "%s_%03d", args: ["telegraf", 7]))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "telegraf_007",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "resolve sprintf in map",
+			Raw: `import s "strings"
+from(db: "mydb") |> map(fn: (r) => s.sprintf(format: "%s=%.2f", args: [r.host, r._value]))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "mydb",
+						},
+					},
+					{
+						ID: "map1",
+						Spec: &functions.MapOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.CallExpression{
+									Callee: &semantic.IdentifierExpression{Name: "strings.sprintf"},
+									Arguments: &semantic.ObjectExpression{
+										Properties: []*semantic.Property{
+											{
+												Key:   &semantic.Identifier{Name: "format"},
+												Value: &semantic.StringLiteral{Value: "%s=%.2f"},
+											},
+											{
+												Key: &semantic.Identifier{Name: "args"},
+												Value: &semantic.ArrayExpression{
+													Elements: []semantic.Expression{
+														&semantic.MemberExpression{
+															Object:   &semantic.IdentifierExpression{Name: "r"},
+															Property: "host",
+														},
+														&semantic.MemberExpression{
+															Object:   &semantic.IdentifierExpression{Name: "r"},
+															Property: "_value",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{Parent: "from0", Child: "map1"},
+				},
+			},
+		},
+		{
+			Name: "string functions",
+			Raw: `import "strings"
+from(db: strings.toLower(v: strings.trimSuffix(v: "Telegraf_autogen", suffix: "_autogen")))`,
+			Want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID: "from0",
+						Spec: &functions.FromOpSpec{
+							Database: "telegraf",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "wrong verb",
+			Raw: `import "strings"
+from(db: strings.sprintf(format: "%d", args: ["telegraf"]))`,
+			WantErr: true,
+		},
+		{
+			Name: "missing argument",
+			Raw: `import "strings"
+from(db: strings.sprintf(format: "%s %s", args: ["telegraf"]))`,
+			WantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			querytest.NewQueryTestHelper(t, tc)
+		})
+	}
+}
+
+func TestStrings_Functions(t *testing.T) {
+	testCases := []packageFunctionTestCase{
+		{
+			name: "toLower",
+			args: map[string]values.Value{"v": values.NewStringValue("East-A")},
+			want: values.NewStringValue("east-a"),
+		},
+		{
+			name: "toUpper",
+			args: map[string]values.Value{"v": values.Null},
+			want: values.Null,
+		},
+		{
+			name: "trimSpace",
+			args: map[string]values.Value{"v": values.NewStringValue("  a b ")},
+			want: values.NewStringValue("a b"),
+		},
+		{
+			name: "trim",
+			args: map[string]values.Value{"v": values.NewStringValue("..a.."), "cutset": values.NewStringValue(".")},
+			want: values.NewStringValue("a"),
+		},
+		{
+			name: "trimPrefix",
+			args: map[string]values.Value{"v": values.NewStringValue("host_a"), "prefix": values.NewStringValue("host_")},
+			want: values.NewStringValue("a"),
+		},
+		{
+			name: "hasSuffix",
+			args: map[string]values.Value{"v": values.NewStringValue("host_a"), "suffix": values.NewStringValue("_a")},
+			want: values.NewBoolValue(true),
+		},
+		{
+			name: "contains",
+			args: map[string]values.Value{"v": values.NewStringValue("host_a"), "substr": values.NewStringValue("b")},
+			want: values.NewBoolValue(false),
+		},
+		{
+			name: "strlen",
+			args: map[string]values.Value{"v": values.NewStringValue("héllo")},
+			want: values.NewIntValue(5),
+		},
+		{
+			name: "index",
+			args: map[string]values.Value{"v": values.NewStringValue("héllo"), "substr": values.NewStringValue("l")},
+			want: values.NewIntValue(2),
+		},
+		{
+			name: "substring",
+			args: map[string]values.Value{"v": values.NewStringValue("héllo"), "start": values.NewIntValue(1), "end": values.NewIntValue(3)},
+			want: values.NewStringValue("él"),
+		},
+		{
+			name: "substring",
+			args: map[string]values.Value{"v": values.NewStringValue("abc"), "start": values.NewIntValue(-1), "end": values.NewIntValue(10)},
+			want: values.NewStringValue("abc"),
+		},
+		{
+			name: "split",
+			args: map[string]values.Value{"v": values.NewStringValue("a,b,c"), "t": values.NewStringValue(",")},
+			want: stringArrayValue("a", "b", "c"),
+		},
+		{
+			name: "join",
+			args: map[string]values.Value{"arr": stringArrayValue("a", "b", "c"), "v": values.NewStringValue("-")},
+			want: values.NewStringValue("a-b-c"),
+		},
+		{
+			name: "replace",
+			args: map[string]values.Value{"v": values.NewStringValue("aaa"), "t": values.NewStringValue("a"), "u": values.NewStringValue("b"), "i": values.NewIntValue(2)},
+			want: values.NewStringValue("bba"),
+		},
+		{
+			name: "replaceAll",
+			args: map[string]values.Value{"v": values.NewStringValue("aaa"), "t": values.NewStringValue("a"), "u": values.NewStringValue("b")},
+			want: values.NewStringValue("bbb"),
+		},
+		{
+			name:    "split",
+			args:    map[string]values.Value{"v": values.NewStringValue("a,b,c")},
+			wantErr: true,
+		},
+		{
+			name:    "toLower",
+			args:    map[string]values.Value{"v": values.NewIntValue(1)},
+			wantErr: true,
+		},
+	}
+	testPackageFunctions(t, functions.StringsPackage, testCases)
+}
+
+// packageFunctionTestCase is a call of a function of a built-in package.
+type packageFunctionTestCase struct {
+	name    string
+	args    map[string]values.Value
+	want    values.Value
+	wantErr bool
+}
+
+// testPackageFunctions calls the functions of the package with the args of each test case.
+func testPackageFunctions(t *testing.T, pkg string, testCases []packageFunctionTestCase) {
+	t.Helper()
+	scope, _ := query.CompilerBuiltIns()
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			f, ok := scope[pkg+"."+tc.name]
+			if !ok {
+				t.Fatalf("missing function %q", tc.name)
+			}
+			args := values.NewObject()
+			for k, v := range tc.args {
+				args.Set(k, v)
+			}
+			got, err := f.Function().Call(args)
+			if err != nil {
+				if !tc.wantErr {
+					t.Fatal(err)
+				}
+				return
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			if got.Type() != tc.want.Type() {
+				t.Fatalf("unexpected type: want: %v got: %v", tc.want.Type(), got.Type())
+			}
+			if !cmp.Equal(goPackageValue(tc.want), goPackageValue(got)) {
+				t.Errorf("unexpected value -want/+got\n%s", cmp.Diff(goPackageValue(tc.want), goPackageValue(got)))
+			}
+		})
+	}
+}
+
+func goPackageValue(v values.Value) interface{} {
+	switch v.Type().Kind() {
+	case semantic.String:
+		return v.Str()
+	case semantic.Int:
+		return v.Int()
+	case semantic.UInt:
+		return v.UInt()
+	case semantic.Float:
+		return v.Float()
+	case semantic.Bool:
+		return v.Bool()
+	case semantic.Time:
+		return v.Time()
+	case semantic.Duration:
+		return v.Duration()
+	case semantic.Array:
+		a := v.Array()
+		elements := make([]interface{}, a.Len())
+		a.Range(func(i int, e values.Value) {
+			elements[i] = goPackageValue(e)
+		})
+		return elements
+	default:
+		return nil
+	}
+}
+
+func stringArrayValue(strs ...string) values.Value {
+	elements := make([]values.Value, len(strs))
+	for i, s := range strs {
+		elements[i] = values.NewStringValue(s)
+	}
+	return values.NewArrayWithBacking(semantic.String, elements)
+}
@@ -42,6 +42,10 @@ func (s *SumOpSpec) Kind() query.OperationKind {
 	return SumKind
 }
 
+func (s *SumOpSpec) CallArguments() []query.Argument {
+	return s.AggregateConfig.CallArguments()
+}
+
 type SumProcedureSpec struct {
 	execute.AggregateConfig
 }
@@ -6,8 +6,7 @@ import (
 
 func init() {
 	query.RegisterBuiltIn("top-bottom", topBottomBuiltIn)
-	// TODO(nathanielc): Provide an implementation of top/bottom transformation that can use a more efficient sort based on the limit.
-	// This transformation should be used when ever the planner sees a sort |> limit pair of procedures.
+	// The planner replaces the sort |> limit pair of procedures with a top-N procedure, see SortLimitRewriteRule.
 }
 
 var topBottomBuiltIn = `
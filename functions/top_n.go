@@ -0,0 +1,318 @@
+package functions
+
+import (
+	"container/heap"
+	"fmt"
+	"sort"
+
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/plan"
+)
+
+// TopNKind is the kind of the procedure that replaces a sort followed by a limit.
+// It has no operation, the planner creates it with the SortLimitRewriteRule.
+const TopNKind = "topN"
+
+func init() {
+	plan.RegisterRewriteRule(SortLimitRewriteRule{})
+	execute.RegisterTransformation(TopNKind, createTopNTransformation)
+}
+
+// TopNProcedureSpec keeps the first N records of each block, in the order of sorting the block by Cols.
+type TopNProcedureSpec struct {
+	N    int64
+	Cols []string
+	Desc bool
+}
+
+func (s *TopNProcedureSpec) Kind() plan.ProcedureKind {
+	return TopNKind
+}
+func (s *TopNProcedureSpec) Copy() plan.ProcedureSpec {
+	ns := new(TopNProcedureSpec)
+
+	ns.N = s.N
+	ns.Cols = make([]string, len(s.Cols))
+	copy(ns.Cols, s.Cols)
+	ns.Desc = s.Desc
+
+	return ns
+}
+
+// SortLimitRewriteRule replaces a sort followed by a limit with a top-N procedure,
+// which keeps only the limit of records per block instead of sorting the entire block.
+type SortLimitRewriteRule struct {
+}
+
+func (r SortLimitRewriteRule) Root() plan.ProcedureKind {
+	return SortKind
+}
+
+func (r SortLimitRewriteRule) Rewrite(pr *plan.Procedure, planner plan.PlanRewriter) error {
+	// The sorted records must not be used by any other procedure.
+	if len(pr.Children) != 1 {
+		return nil
+	}
+	var limit *plan.Procedure
+	pr.DoChildren(func(child *plan.Procedure) {
+		limit = child
+	})
+	limitSpec, ok := limit.Spec.(*LimitProcedureSpec)
+	if !ok {
+		return nil
+	}
+	sortSpec := pr.Spec.(*SortProcedureSpec)
+
+	pr.Spec = &TopNProcedureSpec{
+		N:    limitSpec.N,
+		Cols: sortSpec.Cols,
+		Desc: sortSpec.Desc,
+	}
+	return planner.RemoveProcedure(limit)
+}
+
+func createTopNTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
+	s, ok := spec.(*TopNProcedureSpec)
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid spec type %T", spec)
+	}
+	cache := execute.NewBlockBuilderCache(a.Allocator())
+	d := execute.NewDataset(id, mode, cache)
+	t := NewTopNTransformation(d, cache, s)
+	return t, d, nil
+}
+
+type topNTransformation struct {
+	d     execute.Dataset
+	cache execute.BlockBuilderCache
+
+	n    int
+	cols []string
+	desc bool
+}
+
+func NewTopNTransformation(d execute.Dataset, cache execute.BlockBuilderCache, spec *TopNProcedureSpec) *topNTransformation {
+	return &topNTransformation{
+		d:     d,
+		cache: cache,
+		n:     int(spec.N),
+		cols:  spec.Cols,
+		desc:  spec.Desc,
+	}
+}
+
+func (t *topNTransformation) RetractBlock(id execute.DatasetID, key execute.PartitionKey) error {
+	return t.d.RetractBlock(key)
+}
+
+func (t *topNTransformation) Process(id execute.DatasetID, b execute.Block) error {
+	builder, created := t.cache.BlockBuilder(b.Key())
+	if !created {
+		return fmt.Errorf("topN found duplicate block with key: %v", b.Key())
+	}
+	execute.AddBlockCols(b, builder)
+
+	cols := b.Cols()
+	h := &topNHeap{
+		desc: t.desc,
+	}
+	for _, label := range t.cols {
+		if j := execute.ColIdx(label, cols); j >= 0 {
+			h.cols = append(h.cols, j)
+		}
+	}
+
+	if t.n > 0 {
+		// The heap holds the first n records seen so far, the record that sorts last is on top.
+		h.rows = make([]*topNRow, 0, t.n)
+		var next *topNRow
+		seq := 0
+		err := b.Do(func(cr execute.ColReader) error {
+			l := cr.Len()
+			for i := 0; i < l; i++ {
+				if next == nil {
+					next = &topNRow{values: make([]interface{}, len(cols))}
+				}
+				next.set(cr, i, seq)
+				seq++
+				if len(h.rows) < t.n {
+					heap.Push(h, next)
+					next = nil
+				} else if h.before(next, h.rows[0]) {
+					// Reuse the evicted record for the next row.
+					next, h.rows[0] = h.rows[0], next
+					heap.Fix(h, 0)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	rows := h.rows
+	sort.Slice(rows, func(i, j int) bool {
+		return h.before(rows[i], rows[j])
+	})
+	for _, r := range rows {
+		for j, v := range r.values {
+			appendTopNValue(builder, j, v)
+		}
+	}
+	return nil
+}
+
+func (t *topNTransformation) UpdateWatermark(id execute.DatasetID, mark execute.Time) error {
+	return t.d.UpdateWatermark(mark)
+}
+func (t *topNTransformation) UpdateProcessingTime(id execute.DatasetID, pt execute.Time) error {
+	return t.d.UpdateProcessingTime(pt)
+}
+func (t *topNTransformation) Finish(id execute.DatasetID, err error) {
+	t.d.Finish(err)
+}
+
+// topNRow is a record kept by the top-N transformation.
+type topNRow struct {
+	// values holds the values of the columns, nil for null values.
+	values []interface{}
+	// seq is the position of the record in its block, equal records keep their order.
+	seq int
+}
+
+// set copies the values of row i of cr into the record.
+func (r *topNRow) set(cr execute.ColReader, i, seq int) {
+	r.seq = seq
+	for j, c := range cr.Cols() {
+		if execute.IsNull(cr, i, j) {
+			r.values[j] = nil
+			continue
+		}
+		switch c.Type {
+		case execute.TBool:
+			r.values[j] = cr.Bools(j)[i]
+		case execute.TInt:
+			r.values[j] = cr.Ints(j)[i]
+		case execute.TUInt:
+			r.values[j] = cr.UInts(j)[i]
+		case execute.TFloat:
+			r.values[j] = cr.Floats(j)[i]
+		case execute.TString:
+			r.values[j] = cr.Strings(j)[i]
+		case execute.TTime:
+			r.values[j] = cr.Times(j)[i]
+		}
+	}
+}
+
+// topNHeap is a heap of records where the record that sorts last is on top.
+type topNHeap struct {
+	cols []int
+	desc bool
+	rows []*topNRow
+}
+
+// before reports whether record a sorts before record b.
+// Records are ordered as by sort, where null values sort before all other values.
+func (h *topNHeap) before(a, b *topNRow) bool {
+	for _, j := range h.cols {
+		if c := compareTopNValues(a.values[j], b.values[j]); c != 0 {
+			if h.desc {
+				return c > 0
+			}
+			return c < 0
+		}
+	}
+	return a.seq < b.seq
+}
+
+func (h *topNHeap) Len() int {
+	return len(h.rows)
+}
+func (h *topNHeap) Less(i, j int) bool {
+	return h.before(h.rows[j], h.rows[i])
+}
+func (h *topNHeap) Swap(i, j int) {
+	h.rows[i], h.rows[j] = h.rows[j], h.rows[i]
+}
+func (h *topNHeap) Push(x interface{}) {
+	h.rows = append(h.rows, x.(*topNRow))
+}
+func (h *topNHeap) Pop() interface{} {
+	n := len(h.rows) - 1
+	r := h.rows[n]
+	h.rows = h.rows[:n]
+	return r
+}
+
+// compareTopNValues returns -1, 0 or 1 if a is less than, equal to or greater than b.
+// Both values are of the type of the same column.
+// Values are ordered as the columns of blocks sort them, i.e. nulls first and true before false.
+func compareTopNValues(a, b interface{}) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		default:
+			return 1
+		}
+	}
+	switch a := a.(type) {
+	case bool:
+		b := b.(bool)
+		switch {
+		case a == b:
+			return 0
+		case a:
+			return -1
+		default:
+			return 1
+		}
+	case int64:
+		return compareOrdered(a < b.(int64), a == b.(int64))
+	case uint64:
+		return compareOrdered(a < b.(uint64), a == b.(uint64))
+	case float64:
+		return compareOrdered(a < b.(float64), a == b.(float64))
+	case string:
+		return compareOrdered(a < b.(string), a == b.(string))
+	case execute.Time:
+		return compareOrdered(a < b.(execute.Time), a == b.(execute.Time))
+	default:
+		panic(fmt.Errorf("unexpected value type %T", a))
+	}
+}
+
+func compareOrdered(less, equal bool) int {
+	switch {
+	case less:
+		return -1
+	case equal:
+		return 0
+	default:
+		return 1
+	}
+}
+
+// appendTopNValue appends the value of a record to column j of the builder.
+func appendTopNValue(builder execute.BlockBuilder, j int, v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		builder.AppendNil(j)
+	case bool:
+		builder.AppendBool(j, v)
+	case int64:
+		builder.AppendInt(j, v)
+	case uint64:
+		builder.AppendUInt(j, v)
+	case float64:
+		builder.AppendFloat(j, v)
+	case string:
+		builder.AppendString(j, v)
+	case execute.Time:
+		builder.AppendTime(j, v)
+	}
+}
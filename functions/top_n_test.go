@@ -0,0 +1,222 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query/execute"
+	"github.com/influxdata/ifql/query/execute/executetest"
+)
+
+func TestTopN_PassThrough(t *testing.T) {
+	executetest.TransformationPassThroughTestHelper(t, func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+		s := functions.NewTopNTransformation(
+			d,
+			c,
+			&functions.TopNProcedureSpec{
+				N:    1,
+				Cols: []string{"_value"},
+				Desc: true,
+			},
+		)
+		return s
+	})
+}
+
+func TestTopN_Process(t *testing.T) {
+	testCases := []struct {
+		name string
+		spec *functions.TopNProcedureSpec
+		data []execute.Block
+		want []*executetest.Block
+	}{
+		{
+			name: "top",
+			spec: &functions.TopNProcedureSpec{
+				N:    2,
+				Cols: []string{"_value"},
+				Desc: true,
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+					{execute.Time(2), 5.0},
+					{execute.Time(3), 1.0},
+					{execute.Time(4), 3.0},
+					{execute.Time(5), 5.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 5.0},
+					{execute.Time(5), 5.0},
+				},
+			}},
+		},
+		{
+			name: "bottom with nulls",
+			spec: &functions.TopNProcedureSpec{
+				N:    3,
+				Cols: []string{"_value"},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), int64(4)},
+					{execute.Time(2), int64(2)},
+					{execute.Time(3), nil},
+					{execute.Time(4), int64(3)},
+					{execute.Time(5), int64(1)},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(3), nil},
+					{execute.Time(5), int64(1)},
+					{execute.Time(2), int64(2)},
+				},
+			}},
+		},
+		{
+			name: "bottom bool",
+			spec: &functions.TopNProcedureSpec{
+				N:    3,
+				Cols: []string{"_value"},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TBool},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), false},
+					{execute.Time(2), true},
+					{execute.Time(3), false},
+					{execute.Time(4), true},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TBool},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), true},
+					{execute.Time(4), true},
+					{execute.Time(1), false},
+				},
+			}},
+		},
+		{
+			name: "multiple columns",
+			spec: &functions.TopNProcedureSpec{
+				N:    3,
+				Cols: []string{"host", "_value"},
+				Desc: true,
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TUInt},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), uint64(4), "a"},
+					{execute.Time(2), uint64(2), "b"},
+					{execute.Time(3), uint64(7), "a"},
+					{execute.Time(4), uint64(3), "b"},
+					{execute.Time(5), uint64(1), "c"},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TUInt},
+					{Label: "host", Type: execute.TString},
+				},
+				Data: [][]interface{}{
+					{execute.Time(5), uint64(1), "c"},
+					{execute.Time(4), uint64(3), "b"},
+					{execute.Time(2), uint64(2), "b"},
+				},
+			}},
+		},
+		{
+			name: "fewer records than n",
+			spec: &functions.TopNProcedureSpec{
+				N:    5,
+				Cols: []string{"_value"},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+					{execute.Time(2), 1.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(2), 1.0},
+					{execute.Time(1), 2.0},
+				},
+			}},
+		},
+		{
+			name: "zero",
+			spec: &functions.TopNProcedureSpec{
+				N:    0,
+				Cols: []string{"_value"},
+			},
+			data: []execute.Block{&executetest.Block{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(1), 2.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				ColMeta: []execute.ColMeta{
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+			}},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			executetest.ProcessTestHelper(
+				t,
+				tc.data,
+				tc.want,
+				func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+					return functions.NewTopNTransformation(d, c, tc.spec)
+				},
+			)
+		})
+	}
+}
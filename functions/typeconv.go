@@ -33,15 +33,25 @@ const (
 	conversionArg = "v"
 )
 
+// The types of the values each conversion accepts.
+// Null values of any type are converted to null.
+var (
+	stringConvArgType   = semantic.NewConstrainedTypeVar("T", semantic.String, semantic.Int, semantic.UInt, semantic.Float, semantic.Bool, semantic.Time, semantic.Duration)
+	intConvArgType      = stringConvArgType
+	uintConvArgType     = stringConvArgType
+	floatConvArgType    = semantic.NewConstrainedTypeVar("T", semantic.String, semantic.Int, semantic.UInt, semantic.Float, semantic.Bool)
+	boolConvArgType     = floatConvArgType
+	timeConvArgType     = semantic.NewConstrainedTypeVar("T", semantic.String, semantic.Int, semantic.UInt)
+	durationConvArgType = timeConvArgType
+)
+
 var missingArg = fmt.Errorf("missing argument %q", conversionArg)
 
 type stringConv struct{}
 
 func (c stringConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: stringConvArgType},
 		ReturnType: semantic.String,
 	})
 }
@@ -80,28 +90,16 @@ func (c stringConv) Function() values.Function {
 }
 
 func (c stringConv) Call(args values.Object) (values.Value, error) {
-	var str string
 	v, ok := args.Get(conversionArg)
 	if !ok {
 		return nil, missingArg
 	}
-	switch v.Type().Kind() {
-	case semantic.String:
-		str = v.Str()
-	case semantic.Int:
-		str = strconv.FormatInt(v.Int(), 10)
-	case semantic.UInt:
-		str = strconv.FormatUint(v.UInt(), 10)
-	case semantic.Float:
-		str = strconv.FormatFloat(v.Float(), 'f', -1, 64)
-	case semantic.Bool:
-		str = strconv.FormatBool(v.Bool())
-	case semantic.Time:
-		str = v.Time().String()
-	case semantic.Duration:
-		str = v.Duration().String()
-	default:
-		return nil, fmt.Errorf("cannot convert %v to string", v.Type())
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
+	str, err := values.ToString(v)
+	if err != nil {
+		return nil, err
 	}
 	return values.NewStringValue(str), nil
 }
@@ -110,9 +108,7 @@ type intConv struct{}
 
 func (c intConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: intConvArgType},
 		ReturnType: semantic.Int,
 	})
 }
@@ -156,6 +152,9 @@ func (c intConv) Call(args values.Object) (values.Value, error) {
 	if !ok {
 		return nil, missingArg
 	}
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
 	switch v.Type().Kind() {
 	case semantic.String:
 		n, err := strconv.ParseInt(v.Str(), 10, 64)
@@ -189,9 +188,7 @@ type uintConv struct{}
 
 func (c uintConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: uintConvArgType},
 		ReturnType: semantic.UInt,
 	})
 }
@@ -235,6 +232,9 @@ func (c uintConv) Call(args values.Object) (values.Value, error) {
 	if !ok {
 		return nil, missingArg
 	}
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
 	switch v.Type().Kind() {
 	case semantic.String:
 		n, err := strconv.ParseUint(v.Str(), 10, 64)
@@ -268,9 +268,7 @@ type floatConv struct{}
 
 func (c floatConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: floatConvArgType},
 		ReturnType: semantic.Float,
 	})
 }
@@ -314,6 +312,9 @@ func (c floatConv) Call(args values.Object) (values.Value, error) {
 	if !ok {
 		return nil, missingArg
 	}
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
 	switch v.Type().Kind() {
 	case semantic.String:
 		n, err := strconv.ParseFloat(v.Str(), 64)
@@ -343,9 +344,7 @@ type boolConv struct{}
 
 func (c boolConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: boolConvArgType},
 		ReturnType: semantic.Bool,
 	})
 }
@@ -389,6 +388,9 @@ func (c boolConv) Call(args values.Object) (values.Value, error) {
 	if !ok {
 		return nil, missingArg
 	}
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
 	switch v.Type().Kind() {
 	case semantic.String:
 		switch s := v.Str(); s {
@@ -438,9 +440,7 @@ type timeConv struct{}
 
 func (c timeConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: timeConvArgType},
 		ReturnType: semantic.Time,
 	})
 }
@@ -484,6 +484,9 @@ func (c timeConv) Call(args values.Object) (values.Value, error) {
 	if !ok {
 		return nil, missingArg
 	}
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
 	switch v.Type().Kind() {
 	case semantic.String:
 		n, err := values.ParseTime(v.Str())
@@ -505,9 +508,7 @@ type durationConv struct{}
 
 func (c durationConv) Type() semantic.Type {
 	return semantic.NewFunctionType(semantic.FunctionSignature{
-		// TODO: We need support for polymorphic function signatures and free type variables.
-		// Probably use a Hindley-Milner type inference system?
-		Params:     map[string]semantic.Type{conversionArg: semantic.Int},
+		Params:     map[string]semantic.Type{conversionArg: durationConvArgType},
 		ReturnType: semantic.Duration,
 	})
 }
@@ -551,6 +552,9 @@ func (c durationConv) Call(args values.Object) (values.Value, error) {
 	if !ok {
 		return nil, missingArg
 	}
+	if values.IsNull(v) {
+		return values.Null, nil
+	}
 	switch v.Type().Kind() {
 	case semantic.String:
 		n, err := values.ParseDuration(v.Str())
@@ -0,0 +1,47 @@
+package functions_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/values"
+)
+
+func TestTypeConversions(t *testing.T) {
+	scope, _ := query.BuiltIns()
+	testCases := []struct {
+		name string
+		v    values.Value
+		want values.Value
+	}{
+		{name: "string", v: values.NewIntValue(1), want: values.NewStringValue("1")},
+		{name: "int", v: values.NewStringValue("1"), want: values.NewIntValue(1)},
+		{name: "uint", v: values.NewFloatValue(1.5), want: values.NewUIntValue(1)},
+		{name: "float", v: values.NewBoolValue(true), want: values.NewFloatValue(1)},
+		{name: "bool", v: values.NewStringValue("true"), want: values.NewBoolValue(true)},
+		{name: "time", v: values.NewIntValue(1), want: values.NewTimeValue(1)},
+		{name: "duration", v: values.NewIntValue(1), want: values.NewDurationValue(1)},
+		{name: "string", v: values.Null, want: values.Null},
+		{name: "int", v: values.Null, want: values.Null},
+		{name: "uint", v: values.Null, want: values.Null},
+		{name: "float", v: values.Null, want: values.Null},
+		{name: "bool", v: values.Null, want: values.Null},
+		{name: "time", v: values.Null, want: values.Null},
+		{name: "duration", v: values.Null, want: values.Null},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			args := values.NewObject()
+			args.Set("v", tc.v)
+			got, err := scope[tc.name].Function().Call(args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tc.want, got) {
+				t.Errorf("unexpected value: want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -52,6 +52,13 @@ func (s *UniqueOpSpec) Kind() query.OperationKind {
 	return UniqueKind
 }
 
+func (s *UniqueOpSpec) CallArguments() []query.Argument {
+	if s.Column == execute.DefaultValueColLabel {
+		return nil
+	}
+	return []query.Argument{{Key: "column", Value: s.Column}}
+}
+
 type UniqueProcedureSpec struct {
 	Column string
 }
@@ -33,13 +33,15 @@ func init() {
 	windowSignature.Params["every"] = semantic.Duration
 	windowSignature.Params["period"] = semantic.Duration
 	windowSignature.Params["round"] = semantic.Duration
-	windowSignature.Params["start"] = semantic.Time
+	// The start time is either an absolute time or a duration relative to now.
+	windowSignature.Params["start"] = semantic.NewConstrainedTypeVar("Start", semantic.Time, semantic.Duration)
 
 	query.RegisterFunction(WindowKind, createWindowOpSpec, windowSignature)
 	query.RegisterOpSpec(WindowKind, newWindowOp)
 	query.RegisterBuiltInValue("inf", infinityVar)
 	plan.RegisterProcedureSpec(WindowKind, newWindowProcedure, WindowKind)
 	execute.RegisterTransformation(WindowKind, createWindowTransformation)
+	plan.RegisterRewriteRule(WindowAggregateRewriteRule{})
 }
 
 func createWindowOpSpec(args query.Arguments, a *query.Administration) (query.OperationSpec, error) {
@@ -117,6 +119,30 @@ func (s *WindowOpSpec) Kind() query.OperationKind {
 	return WindowKind
 }
 
+func (s *WindowOpSpec) CallArguments() []query.Argument {
+	// The triggering of a window cannot be specified by a call and is not an argument.
+	args := []query.Argument{{Key: "every", Value: s.Every}}
+	if s.Period != s.Every {
+		args = append(args, query.Argument{Key: "period", Value: s.Period})
+	}
+	if !s.Start.IsZero() {
+		args = append(args, query.Argument{Key: "start", Value: s.Start})
+	}
+	if s.Round != 0 {
+		args = append(args, query.Argument{Key: "round", Value: s.Round})
+	}
+	if s.TimeCol != execute.DefaultTimeColLabel {
+		args = append(args, query.Argument{Key: "timeCol", Value: s.TimeCol})
+	}
+	if s.StartColLabel != execute.DefaultStartColLabel {
+		args = append(args, query.Argument{Key: "startColLabel", Value: s.StartColLabel})
+	}
+	if s.StopColLabel != execute.DefaultStopColLabel {
+		args = append(args, query.Argument{Key: "stopColLabel", Value: s.StopColLabel})
+	}
+	return args
+}
+
 type WindowProcedureSpec struct {
 	Window     plan.WindowSpec
 	Triggering query.TriggerSpec
@@ -162,6 +188,67 @@ func (s *WindowProcedureSpec) TriggerSpec() query.TriggerSpec {
 	return s.Triggering
 }
 
+// WindowAggregateRewriteRule pushes a window followed by an aggregate down into the storage read,
+// so that storage aggregates the points of each window.
+type WindowAggregateRewriteRule struct {
+}
+
+func (r WindowAggregateRewriteRule) Root() plan.ProcedureKind {
+	return FromKind
+}
+
+func (r WindowAggregateRewriteRule) Rewrite(pr *plan.Procedure, planner plan.PlanRewriter) error {
+	fromSpec := pr.Spec.(*FromProcedureSpec)
+	if fromSpec.WindowSet || fromSpec.AggregateSet || fromSpec.GroupingSet || fromSpec.LimitSet || fromSpec.DescendingSet {
+		return nil
+	}
+	var window *plan.Procedure
+	pr.DoChildren(func(child *plan.Procedure) {
+		if window == nil && isStorageWindowAggregate(child) {
+			window = child
+		}
+	})
+	if window == nil {
+		return nil
+	}
+
+	isoFrom, err := planner.IsolatePath(pr, window)
+	if err != nil {
+		return err
+	}
+	window = isoFrom.Child(0)
+	agg := window.Child(0)
+
+	fromSpec = isoFrom.Spec.(*FromProcedureSpec)
+	fromSpec.WindowSet = true
+	fromSpec.Window = window.Spec.(*WindowProcedureSpec).Window
+	fromSpec.AggregateSet = true
+	fromSpec.AggregateMethod = string(agg.Spec.Kind())
+
+	if err := planner.RemoveProcedure(agg); err != nil {
+		return err
+	}
+	return planner.RemoveProcedure(window)
+}
+
+// isStorageWindowAggregate reports whether the procedure is a window with the default labels
+// whose only child is an aggregate that can be pushed down into a storage read.
+func isStorageWindowAggregate(pr *plan.Procedure) bool {
+	s, ok := pr.Spec.(*WindowProcedureSpec)
+	if !ok {
+		return false
+	}
+	if s.TimeCol != execute.DefaultTimeColLabel ||
+		s.StartColLabel != execute.DefaultStartColLabel ||
+		s.StopColLabel != execute.DefaultStopColLabel {
+		return false
+	}
+	if len(pr.Children) != 1 {
+		return false
+	}
+	return isStorageAggregate(pr.Child(0).Spec)
+}
+
 func createWindowTransformation(id execute.DatasetID, mode execute.AccumulationMode, spec plan.ProcedureSpec, a execute.Administration) (execute.Transformation, execute.Dataset, error) {
 	s, ok := spec.(*WindowProcedureSpec)
 	if !ok {
@@ -246,6 +333,8 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, b execute.Bloc
 
 	newCols := make([]execute.ColMeta, 0, len(b.Cols())+2)
 	keyCols := make([]execute.ColMeta, 0, len(b.Cols())+2)
+	// keyColMap maps the key columns to the columns of the key of the block,
+	// the key columns may be in any order within the block.
 	keyColMap := make([]int, 0, len(b.Cols())+2)
 	startColIdx := -1
 	stopColIdx := -1
@@ -262,7 +351,7 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, b execute.Bloc
 		newCols = append(newCols, c)
 		if keyed {
 			keyCols = append(keyCols, c)
-			keyColMap = append(keyColMap, j)
+			keyColMap = append(keyColMap, execute.ColIdx(c.Label, b.Key().Cols()))
 		}
 	}
 	if startColIdx == -1 {
@@ -273,7 +362,7 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, b execute.Bloc
 		}
 		newCols = append(newCols, c)
 		keyCols = append(keyCols, c)
-		keyColMap = append(keyColMap, startColIdx)
+		keyColMap = append(keyColMap, -1)
 	}
 	if stopColIdx == -1 {
 		stopColIdx = len(newCols)
@@ -283,7 +372,7 @@ func (t *fixedWindowTransformation) Process(id execute.DatasetID, b execute.Bloc
 		}
 		newCols = append(newCols, c)
 		keyCols = append(keyCols, c)
-		keyColMap = append(keyColMap, stopColIdx)
+		keyColMap = append(keyColMap, -1)
 	}
 
 	return b.Do(func(cr execute.ColReader) error {
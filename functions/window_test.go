@@ -94,6 +94,77 @@ func TestFixedWindow_PassThrough(t *testing.T) {
 	})
 }
 
+func TestFixedWindow_ProcessKeyColumns(t *testing.T) {
+	start := execute.Time(time.Date(2017, 10, 10, 10, 0, 0, 0, time.UTC).UnixNano())
+	data := []execute.Block{&executetest.Block{
+		// The key columns are not the leading columns of the block.
+		KeyCols: []string{"_measurement", "host"},
+		ColMeta: []execute.ColMeta{
+			{Label: "_time", Type: execute.TTime},
+			{Label: "_value", Type: execute.TFloat},
+			{Label: "host", Type: execute.TString},
+			{Label: "_measurement", Type: execute.TString},
+		},
+		Data: [][]interface{}{
+			{start, 1.0, "a", "cpu"},
+			{start + execute.Time(time.Minute), 2.0, "a", "cpu"},
+		},
+	}}
+	want := []*executetest.Block{
+		{
+			KeyCols: []string{"host", "_measurement", "_start", "_stop"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_time", Type: execute.TTime},
+				{Label: "_value", Type: execute.TFloat},
+				{Label: "host", Type: execute.TString},
+				{Label: "_measurement", Type: execute.TString},
+				{Label: "_start", Type: execute.TTime},
+				{Label: "_stop", Type: execute.TTime},
+			},
+			Data: [][]interface{}{
+				{start, 1.0, "a", "cpu", start, start + execute.Time(time.Minute)},
+			},
+		},
+		{
+			KeyCols: []string{"host", "_measurement", "_start", "_stop"},
+			ColMeta: []execute.ColMeta{
+				{Label: "_time", Type: execute.TTime},
+				{Label: "_value", Type: execute.TFloat},
+				{Label: "host", Type: execute.TString},
+				{Label: "_measurement", Type: execute.TString},
+				{Label: "_start", Type: execute.TTime},
+				{Label: "_stop", Type: execute.TTime},
+			},
+			Data: [][]interface{}{
+				{start + execute.Time(time.Minute), 2.0, "a", "cpu", start + execute.Time(time.Minute), start + execute.Time(2*time.Minute)},
+			},
+		},
+	}
+	executetest.ProcessTestHelper(
+		t,
+		data,
+		want,
+		func(d execute.Dataset, c execute.BlockBuilderCache) execute.Transformation {
+			return functions.NewFixedWindowTransformation(
+				d,
+				c,
+				execute.Bounds{
+					Start: start,
+					Stop:  start + execute.Time(time.Hour),
+				},
+				execute.Window{
+					Every:  execute.Duration(time.Minute),
+					Period: execute.Duration(time.Minute),
+					Start:  start,
+				},
+				execute.DefaultTimeColLabel,
+				execute.DefaultStartColLabel,
+				execute.DefaultStopColLabel,
+			)
+		},
+	)
+}
+
 func TestFixedWindow_Process(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -56,6 +56,13 @@ func (s *YieldOpSpec) Kind() query.OperationKind {
 	return YieldKind
 }
 
+func (s *YieldOpSpec) CallArguments() []query.Argument {
+	if s.Name == "_result" {
+		return nil
+	}
+	return []query.Argument{{Key: "name", Value: s.Name}}
+}
+
 type YieldProcedureSpec struct {
 	Name string `json:"name"`
 }
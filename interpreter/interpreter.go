@@ -3,6 +3,8 @@ package interpreter
 import (
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/semantic"
@@ -34,6 +36,13 @@ func (itrp interpreter) doStatement(stmt semantic.Statement, scope *Scope) error
 		if err := itrp.doVariableDeclaration(s, scope); err != nil {
 			return err
 		}
+	case *semantic.OptionStatement:
+		value, err := itrp.doExpression(s.Declaration.Init, scope)
+		if err != nil {
+			return err
+		}
+		scope.Set(s.Declaration.Identifier.Name, value)
+		scope.SetOption(s.Declaration.Identifier.Name, value)
 	case *semantic.ExpressionStatement:
 		v, err := itrp.doExpression(s.Expression, scope)
 		if err != nil {
@@ -82,7 +91,7 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (va
 	case semantic.Literal:
 		return itrp.doLiteral(e)
 	case *semantic.ArrayExpression:
-		return itrp.doArray(e, scope)
+		return itrp.doArray(e, scope, false)
 	case *semantic.IdentifierExpression:
 		value, ok := scope.Lookup(e.Name)
 		if !ok {
@@ -101,6 +110,17 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (va
 		if err != nil {
 			return nil, err
 		}
+		if obj.Type().Kind() == semantic.Array {
+			i, err := strconv.Atoi(e.Property)
+			if err != nil {
+				return nil, fmt.Errorf("invalid array index %q", e.Property)
+			}
+			arr := obj.Array()
+			if i < 0 || i >= arr.Len() {
+				return nil, fmt.Errorf("array index %d out of bounds, array has length %d", i, arr.Len())
+			}
+			return arr.Get(i), nil
+		}
 		v, ok := obj.Object().Get(e.Property)
 		if !ok {
 			return nil, fmt.Errorf("object has no property %q", e.Property)
@@ -109,6 +129,9 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (va
 	case *semantic.ObjectExpression:
 		return itrp.doObject(e, scope)
 	case *semantic.UnaryExpression:
+		if e.Operator == ast.ExistsOperator {
+			return itrp.doExists(e.Argument, scope)
+		}
 		v, err := itrp.doExpression(e.Argument, scope)
 		if err != nil {
 			return nil, err
@@ -119,6 +142,17 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (va
 				return nil, fmt.Errorf("operand to unary expression is not a boolean value, got %v", v.Type())
 			}
 			return values.NewBoolValue(!v.Bool()), nil
+		case ast.EmptyOperator, ast.NotEmptyOperator:
+			var empty bool
+			switch v.Type().Kind() {
+			case semantic.String:
+				empty = v.Str() == ""
+			case semantic.Array:
+				empty = v.Array().Len() == 0
+			default:
+				return nil, fmt.Errorf("operand to %v must be a string or an array, got %v", e.Operator, v.Type())
+			}
+			return values.NewBoolValue(empty == (e.Operator == ast.EmptyOperator)), nil
 		case ast.SubtractionOperator:
 			switch t := v.Type(); t {
 			case semantic.Int:
@@ -194,12 +228,49 @@ func (itrp interpreter) doExpression(expr semantic.Expression, scope *Scope) (va
 			e:     e,
 			scope: scope.Nest(),
 		}, nil
+	case *semantic.StringExpression:
+		return itrp.doString(e, scope)
+	case *semantic.ConditionalExpression:
+		t, err := itrp.doExpression(e.Test, scope)
+		if err != nil {
+			return nil, err
+		}
+		if t.Type() != semantic.Bool {
+			return nil, fmt.Errorf("test of conditional expression is not a boolean value, got %v", t.Type())
+		}
+		if t.Bool() {
+			return itrp.doExpression(e.Consequent, scope)
+		}
+		return itrp.doExpression(e.Alternate, scope)
 	default:
 		return nil, fmt.Errorf("unsupported expression %T", expr)
 	}
 }
 
-func (itrp interpreter) doArray(a *semantic.ArrayExpression, scope *Scope) (values.Value, error) {
+func (itrp interpreter) doString(e *semantic.StringExpression, scope *Scope) (values.Value, error) {
+	var b strings.Builder
+	for _, p := range e.Parts {
+		switch p := p.(type) {
+		case *semantic.TextPart:
+			b.WriteString(p.Value)
+		case *semantic.InterpolatedPart:
+			v, err := itrp.doExpression(p.Expression, scope)
+			if err != nil {
+				return nil, err
+			}
+			s, err := values.ToString(v)
+			if err != nil {
+				return nil, err
+			}
+			b.WriteString(s)
+		}
+	}
+	return values.NewStringValue(b.String()), nil
+}
+
+// doArray evaluates the array, whose elements must have the same type unless mixed is true.
+// The element type of a mixed array is the type of its first element.
+func (itrp interpreter) doArray(a *semantic.ArrayExpression, scope *Scope, mixed bool) (values.Value, error) {
 	elements := make([]values.Value, len(a.Elements))
 	elementType := semantic.EmptyArrayType.ElementType()
 	for i, el := range a.Elements {
@@ -210,7 +281,7 @@ func (itrp interpreter) doArray(a *semantic.ArrayExpression, scope *Scope) (valu
 		if i == 0 {
 			elementType = v.Type()
 		}
-		if elementType != v.Type() {
+		if !mixed && elementType != v.Type() {
 			return nil, fmt.Errorf("cannot mix types in an array, found both %v and %v", elementType, v.Type())
 		}
 		elements[i] = v
@@ -218,6 +289,26 @@ func (itrp interpreter) doArray(a *semantic.ArrayExpression, scope *Scope) (valu
 	return values.NewArrayWithBacking(elementType, elements), nil
 }
 
+// doExists reports whether the expression has a value that is not null.
+// A member expression whose object does not have the property has no value.
+func (itrp interpreter) doExists(e semantic.Expression, scope *Scope) (values.Value, error) {
+	if m, ok := e.(*semantic.MemberExpression); ok {
+		obj, err := itrp.doExpression(m.Object, scope)
+		if err != nil {
+			return nil, err
+		}
+		if obj.Type().Kind() == semantic.Object {
+			v, ok := obj.Object().Get(m.Property)
+			return values.NewBoolValue(ok && !values.IsNull(v)), nil
+		}
+	}
+	v, err := itrp.doExpression(e, scope)
+	if err != nil {
+		return nil, err
+	}
+	return values.NewBoolValue(!values.IsNull(v)), nil
+}
+
 func (itrp interpreter) doObject(m *semantic.ObjectExpression, scope *Scope) (values.Value, error) {
 	obj := values.NewObject()
 	for _, p := range m.Properties {
@@ -288,7 +379,8 @@ func (itrp interpreter) doCall(call *semantic.CallExpression, scope *Scope) (val
 		return nil, fmt.Errorf("cannot call function, value is of type %v", callee.Type())
 	}
 	f := callee.Function()
-	argObj, err := itrp.doArguments(call.Arguments, scope)
+	_, formatArgs := semantic.FormatParams(callee.Type())
+	argObj, err := itrp.doArguments(call.Arguments, scope, formatArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -304,13 +396,21 @@ func (itrp interpreter) doCall(call *semantic.CallExpression, scope *Scope) (val
 	return f.Call(argObj)
 }
 
-func (itrp interpreter) doArguments(args *semantic.ObjectExpression, scope *Scope) (values.Object, error) {
+// doArguments evaluates the arguments of a call.
+// The elements of an array literal passed as the formatArgs argument may have different types, see semantic.FunctionSignature.
+func (itrp interpreter) doArguments(args *semantic.ObjectExpression, scope *Scope, formatArgs string) (values.Object, error) {
 	obj := values.NewObject()
 	if args == nil || len(args.Properties) == 0 {
 		return obj, nil
 	}
 	for _, p := range args.Properties {
-		value, err := itrp.doExpression(p.Value, scope)
+		var value values.Value
+		var err error
+		if a, ok := p.Value.(*semantic.ArrayExpression); ok && formatArgs != "" && p.Key.Name == formatArgs {
+			value, err = itrp.doArray(a, scope, true)
+		} else {
+			value, err = itrp.doExpression(p.Value, scope)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -325,6 +425,7 @@ func (itrp interpreter) doArguments(args *semantic.ObjectExpression, scope *Scop
 type Scope struct {
 	parent      *Scope
 	values      map[string]values.Value
+	options     map[string]values.Value
 	returnValue values.Value
 }
 
@@ -354,6 +455,27 @@ func (s *Scope) Set(name string, value values.Value) {
 	s.values[name] = value
 }
 
+// SetOption sets the value of a query-wide option.
+func (s *Scope) SetOption(name string, value values.Value) {
+	if s.options == nil {
+		s.options = make(map[string]values.Value)
+	}
+	s.options[name] = value
+}
+
+// Options returns the options set in this scope and its parents.
+// Options set in this scope take precedence over options set in its parents.
+func (s *Scope) Options() map[string]values.Value {
+	if s == nil {
+		return make(map[string]values.Value)
+	}
+	options := s.parent.Options()
+	for k, v := range s.options {
+		options[k] = v
+	}
+	return options
+}
+
 // SetReturn sets the return value of this scope.
 func (s *Scope) SetReturn(value values.Value) {
 	s.returnValue = value
@@ -395,6 +517,7 @@ func (s *Scope) Copy() *Scope {
 		}
 		curr = curr.parent
 	}
+	c.options = s.Options()
 	return c
 }
 
@@ -554,28 +677,54 @@ func ResolveFunction(f values.Function) (*semantic.FunctionExpression, error) {
 
 // Resolve rewrites the function resolving any identifiers not listed in the function params.
 func (f function) Resolve() (semantic.Node, error) {
+	return f.resolve(make(map[*semantic.FunctionExpression]bool))
+}
+
+// resolve resolves the function, resolving are the functions whose resolution is in progress.
+func (f function) resolve(resolving map[*semantic.FunctionExpression]bool) (semantic.Node, error) {
+	if resolving[f.e] {
+		return nil, errors.New("recursive functions cannot be called from row functions")
+	}
+	resolving[f.e] = true
+	defer delete(resolving, f.e)
+
 	n := f.e.Copy()
-	node, err := f.resolveIdentifiers(n)
+	r := identifierResolver{
+		scope:     f.scope,
+		locals:    make(map[string]bool, len(f.e.Params)),
+		resolving: resolving,
+	}
+	for _, p := range f.e.Params {
+		r.locals[p.Key.Name] = true
+	}
+	node, err := r.resolveIdentifiers(n)
 	if err != nil {
 		return nil, err
 	}
 	return node, nil
 }
 
-func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
+// identifierResolver resolves the identifiers of a function body that are not local to the function.
+type identifierResolver struct {
+	scope *Scope
+	// locals are the parameters and declared variables of the function and the functions it contains.
+	locals map[string]bool
+	// resolving are the functions being resolved, a function that resolves to itself is recursive.
+	resolving map[*semantic.FunctionExpression]bool
+}
+
+func (f identifierResolver) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 	switch n := n.(type) {
 	case *semantic.IdentifierExpression:
-		for _, p := range f.e.Params {
-			if n.Name == p.Key.Name {
-				// Identifier is a parameter do not resolve
-				return n, nil
-			}
+		if f.locals[n.Name] {
+			// Identifier is a parameter or a local variable do not resolve
+			return n, nil
 		}
 		v, ok := f.scope.Lookup(n.Name)
 		if !ok {
-			return nil, fmt.Errorf("name %q does not exist in scope", n.Name)
+			return nil, fmt.Errorf("undefined identifier %q", n.Name)
 		}
-		return resolveValue(v)
+		return f.resolveValue(v)
 	case *semantic.BlockStatement:
 		for i, s := range n.Body {
 			node, err := f.resolveIdentifiers(s)
@@ -602,14 +751,60 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Init = node.(semantic.Expression)
+		f.locals[n.Identifier.Name] = true
 	case *semantic.CallExpression:
+		// Functions of packages and IFQL functions are resolved so they can be called from compiled functions,
+		// any other callee is left as is.
+		switch callee := n.Callee.(type) {
+		case *semantic.MemberExpression, *semantic.FunctionExpression:
+			node, err := f.resolveIdentifiers(callee)
+			if err != nil {
+				return nil, err
+			}
+			n.Callee = node.(semantic.Expression)
+		case *semantic.IdentifierExpression:
+			if f.locals[callee.Name] {
+				break
+			}
+			v, ok := f.scope.Lookup(callee.Name)
+			if !ok {
+				return nil, fmt.Errorf("undefined identifier %q", callee.Name)
+			}
+			if v.Type().Kind() == semantic.Function {
+				if _, ok := v.Function().(Resolver); ok {
+					node, err := f.resolveFunction(v.Function())
+					if err != nil {
+						return nil, err
+					}
+					n.Callee = node.(semantic.Expression)
+				}
+			}
+		}
 		node, err := f.resolveIdentifiers(n.Arguments)
 		if err != nil {
 			return nil, err
 		}
 		n.Arguments = node.(*semantic.ObjectExpression)
 	case *semantic.FunctionExpression:
-		node, err := f.resolveIdentifiers(n.Body)
+		nested := identifierResolver{
+			scope:     f.scope,
+			locals:    make(map[string]bool, len(f.locals)+len(n.Params)),
+			resolving: f.resolving,
+		}
+		for k := range f.locals {
+			nested.locals[k] = true
+		}
+		for _, p := range n.Params {
+			if p.Default != nil {
+				node, err := f.resolveIdentifiers(p.Default)
+				if err != nil {
+					return nil, err
+				}
+				p.Default = node.(semantic.Expression)
+			}
+			nested.locals[p.Key.Name] = true
+		}
+		node, err := nested.resolveIdentifiers(n.Body)
 		if err != nil {
 			return nil, err
 		}
@@ -659,6 +854,21 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			}
 			n.Properties[i] = node.(*semantic.Property)
 		}
+	case *semantic.MemberExpression:
+		// Resolve members of objects in scope, i.e. members of imported packages,
+		// otherwise resolve the object of the member expression.
+		if ident, ok := n.Object.(*semantic.IdentifierExpression); ok && !f.locals[ident.Name] {
+			if obj, ok := f.scope.Lookup(ident.Name); ok && obj.Type().Kind() == semantic.Object {
+				if v, ok := obj.Object().Get(n.Property); ok {
+					return f.resolveValue(v)
+				}
+			}
+		}
+		node, err := f.resolveIdentifiers(n.Object)
+		if err != nil {
+			return nil, err
+		}
+		n.Object = node.(semantic.Expression)
 	case *semantic.ConditionalExpression:
 		node, err := f.resolveIdentifiers(n.Test)
 		if err != nil {
@@ -683,11 +893,33 @@ func (f function) resolveIdentifiers(n semantic.Node) (semantic.Node, error) {
 			return nil, err
 		}
 		n.Value = node.(semantic.Expression)
+	case *semantic.StringExpression:
+		for _, p := range n.Parts {
+			if p, ok := p.(*semantic.InterpolatedPart); ok {
+				node, err := f.resolveIdentifiers(p.Expression)
+				if err != nil {
+					return nil, err
+				}
+				p.Expression = node.(semantic.Expression)
+			}
+		}
 	}
 	return n, nil
 }
 
-func resolveValue(v values.Value) (semantic.Node, error) {
+// resolveFunction resolves a function value, IFQL functions are resolved within the functions being resolved.
+func (f identifierResolver) resolveFunction(fn values.Function) (semantic.Node, error) {
+	if fn, ok := fn.(function); ok {
+		return fn.resolve(f.resolving)
+	}
+	resolver, ok := fn.(Resolver)
+	if !ok {
+		return nil, fmt.Errorf("function is not resolvable %T", fn)
+	}
+	return resolver.Resolve()
+}
+
+func (f identifierResolver) resolveValue(v values.Value) (semantic.Node, error) {
 	switch k := v.Type().Kind(); k {
 	case semantic.String:
 		return &semantic.StringLiteral{
@@ -722,11 +954,7 @@ func resolveValue(v values.Value) (semantic.Node, error) {
 			Value: v.Duration().Duration(),
 		}, nil
 	case semantic.Function:
-		resolver, ok := v.Function().(Resolver)
-		if !ok {
-			return nil, fmt.Errorf("function is not resolvable %T", v.Function())
-		}
-		return resolver.Resolve()
+		return f.resolveFunction(v.Function())
 	case semantic.Array:
 		arr := v.Array()
 		node := new(semantic.ArrayExpression)
@@ -737,7 +965,7 @@ func resolveValue(v values.Value) (semantic.Node, error) {
 				return
 			}
 			var n semantic.Node
-			n, err = resolveValue(el)
+			n, err = f.resolveValue(el)
 			if err != nil {
 				return
 			}
@@ -757,7 +985,7 @@ func resolveValue(v values.Value) (semantic.Node, error) {
 				return
 			}
 			var n semantic.Node
-			n, err = resolveValue(v)
+			n, err = f.resolveValue(v)
 			if err != nil {
 				return
 			}
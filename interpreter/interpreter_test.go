@@ -3,6 +3,7 @@ package interpreter_test
 import (
 	"errors"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -225,6 +226,91 @@ func TestEval(t *testing.T) {
 			six() |> plusOne() == 7.0 or fail()
 			`,
 		},
+		{
+			name: "string interpolation",
+			query: `
+			n = 42
+			x = 4.2
+			d = 5m
+			"the answer is ${n}, not ${n + 1} or ${x}" == "the answer is 42, not 43 or 4.2" or fail()
+			"${d} ${true} ${"nested ${n}"}" == "5m0s true nested 42" or fail()
+			`,
+		},
+		{
+			name: "string interpolation in function",
+			query: `
+			f = (r) => "value ${r}"
+			f(r: 1) == "value 1" or fail()
+			f(r: "a") == "value a" or fail()
+			`,
+		},
+		{
+			name: "string interpolation of function parameter object",
+			query: `
+			f = (r) => "value ${r}"
+			f(r: {a: 1})
+			`,
+			wantErr: true,
+		},
+		{
+			name: "conditional expression",
+			query: `
+			level = (v) => if v > 90 then "crit" else if v > 70 then "warn" else "ok"
+			level(v: 95) == "crit" or fail()
+			level(v: 80) == "warn" or fail()
+			level(v: 10) == "ok" or fail()
+			`,
+		},
+		{
+			name: "conditional expression only evaluates one branch",
+			query: `
+			(if true then true else fail()) or fail()
+			`,
+		},
+		{
+			name: "conditional expression with non boolean test",
+			query: `
+			f = (v) => if v then 1 else 2
+			f(v: 1)
+			`,
+			wantErr: true,
+		},
+		{
+			name: "in operator",
+			query: `
+			hosts = ["a", "b"]
+			"a" in hosts or fail()
+			"c" in hosts and fail()
+			2 in [1, 2, 3] or fail()
+			`,
+		},
+		{
+			name: "empty operator",
+			query: `
+			empty "" or fail()
+			empty "a" and fail()
+			not empty "a" or fail()
+			empty [] or fail()
+			not empty [1] or fail()
+			`,
+		},
+		{
+			name: "empty operator on int",
+			query: `
+			f = (v) => empty v
+			f(v: 1)
+			`,
+			wantErr: true,
+		},
+		{
+			name: "exists operator",
+			query: `
+			o = {a: 1}
+			exists o.a or fail()
+			exists o.b and fail()
+			not exists o.b or fail()
+			`,
+		},
 		{
 			name: "regex match",
 			query: `
@@ -249,6 +335,14 @@ func TestEval(t *testing.T) {
 			"abba" !~ /^a.*a$/ and fail()
 			`,
 		},
+		{
+			name: "type error",
+			query: `
+			f = (r) => r.a + 1
+			f(r: {a: "a"})
+			`,
+			wantErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -260,6 +354,9 @@ func TestEval(t *testing.T) {
 			}
 			graph, err := semantic.New(program, testDeclarations.Copy())
 			if err != nil {
+				if tc.wantErr {
+					return
+				}
 				t.Fatal(err)
 			}
 
@@ -340,6 +437,243 @@ func TestResolver(t *testing.T) {
 	}
 }
 
+func TestResolver_MemberExpression(t *testing.T) {
+	var got semantic.Expression
+	scope := interpreter.NewScope()
+	f := function{
+		name: "resolver",
+		t: semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"f": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{"r": semantic.Int},
+				}),
+			},
+			ReturnType: semantic.Int,
+		}),
+		call: func(args values.Object) (values.Value, error) {
+			f, ok := args.Get("f")
+			if !ok {
+				return nil, errors.New("missing argument f")
+			}
+			g, err := interpreter.ResolveFunction(f.Function())
+			if err != nil {
+				return nil, err
+			}
+			got = g
+			return nil, nil
+		},
+	}
+	scope.Set(f.name, f)
+	pkg := values.NewObject()
+	pkg.Set("x", values.NewIntValue(42))
+	scope.Set("pkg", pkg)
+
+	program, err := parser.NewAST(`resolver(f: (r) => r.a + pkg.x)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graph, err := semantic.New(program, testDeclarations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := interpreter.Eval(graph, scope); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+		Body: &semantic.BinaryExpression{
+			Operator: ast.AdditionOperator,
+			Left: &semantic.MemberExpression{
+				Object:   &semantic.IdentifierExpression{Name: "r"},
+				Property: "a",
+			},
+			Right: &semantic.IntegerLiteral{Value: 42},
+		},
+	}
+	if !cmp.Equal(want, got, semantictest.CmpOptions...) {
+		t.Errorf("unexpected resoved function: -want/+got\n%s", cmp.Diff(want, got, semantictest.CmpOptions...))
+	}
+}
+
+func TestResolver_Locals(t *testing.T) {
+	var got semantic.Expression
+	scope := interpreter.NewScope()
+	f := function{
+		name: "resolver",
+		t: semantic.NewFunctionType(semantic.FunctionSignature{
+			Params: map[string]semantic.Type{
+				"f": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{"r": semantic.Int},
+				}),
+			},
+			ReturnType: semantic.Int,
+		}),
+		call: func(args values.Object) (values.Value, error) {
+			f, ok := args.Get("f")
+			if !ok {
+				return nil, errors.New("missing argument f")
+			}
+			g, err := interpreter.ResolveFunction(f.Function())
+			if err != nil {
+				return nil, err
+			}
+			got = g
+			return nil, nil
+		},
+	}
+	scope.Set(f.name, f)
+
+	program, err := parser.NewAST(`
+	x = 42
+	add = (a, b) => a + b
+	resolver(f: (r) => {
+		y = add(a: r, b: x)
+		double = (v) => v * 2
+		return double(v: y)
+	})
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graph, err := semantic.New(program, testDeclarations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := interpreter.Eval(graph, scope); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &semantic.FunctionExpression{
+		Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+		Body: &semantic.BlockStatement{
+			Body: []semantic.Statement{
+				&semantic.NativeVariableDeclaration{
+					Identifier: &semantic.Identifier{Name: "y"},
+					Init: &semantic.CallExpression{
+						Callee: &semantic.FunctionExpression{
+							Params: []*semantic.FunctionParam{
+								{Key: &semantic.Identifier{Name: "a"}},
+								{Key: &semantic.Identifier{Name: "b"}},
+							},
+							Body: &semantic.BinaryExpression{
+								Operator: ast.AdditionOperator,
+								Left:     &semantic.IdentifierExpression{Name: "a"},
+								Right:    &semantic.IdentifierExpression{Name: "b"},
+							},
+						},
+						Arguments: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								{Key: &semantic.Identifier{Name: "a"}, Value: &semantic.IdentifierExpression{Name: "r"}},
+								{Key: &semantic.Identifier{Name: "b"}, Value: &semantic.IntegerLiteral{Value: 42}},
+							},
+						},
+					},
+				},
+				&semantic.NativeVariableDeclaration{
+					Identifier: &semantic.Identifier{Name: "double"},
+					Init: &semantic.FunctionExpression{
+						Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "v"}}},
+						Body: &semantic.BinaryExpression{
+							Operator: ast.MultiplicationOperator,
+							Left:     &semantic.IdentifierExpression{Name: "v"},
+							Right:    &semantic.IntegerLiteral{Value: 2},
+						},
+					},
+				},
+				&semantic.ReturnStatement{
+					Argument: &semantic.CallExpression{
+						Callee: &semantic.IdentifierExpression{Name: "double"},
+						Arguments: &semantic.ObjectExpression{
+							Properties: []*semantic.Property{
+								{Key: &semantic.Identifier{Name: "v"}, Value: &semantic.IdentifierExpression{Name: "y"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if !cmp.Equal(want, got, semantictest.CmpOptions...) {
+		t.Errorf("unexpected resoved function: -want/+got\n%s", cmp.Diff(want, got, semantictest.CmpOptions...))
+	}
+}
+
+func TestResolver_Errors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		program string
+		wantErr string
+	}{
+		{
+			name: "recursive function",
+			program: `
+	fact = (n) => if n <= 1 then 1 else n * fact(n: n - 1)
+	resolver(f: (r) => fact(n: r))
+`,
+			wantErr: "recursive functions cannot be called from row functions",
+		},
+		{
+			name:    "undefined function",
+			program: `resolver(f: (r) => nosuch(x: r))`,
+			wantErr: `undefined identifier "nosuch"`,
+		},
+		{
+			name:    "undefined package",
+			program: `resolver(f: (r) => math.abs(x: r))`,
+			wantErr: `undefined identifier "math"`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			scope := interpreter.NewScope()
+			f := function{
+				name: "resolver",
+				t: semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{
+						"f": semantic.NewFunctionType(semantic.FunctionSignature{
+							Params: map[string]semantic.Type{"r": semantic.Int},
+						}),
+					},
+					ReturnType: semantic.Int,
+				}),
+				call: func(args values.Object) (values.Value, error) {
+					f, ok := args.Get("f")
+					if !ok {
+						return nil, errors.New("missing argument f")
+					}
+					_, err := interpreter.ResolveFunction(f.Function())
+					return nil, err
+				},
+			}
+			scope.Set(f.name, f)
+
+			program, err := parser.NewAST(tc.program)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			graph, err := semantic.New(program, testDeclarations)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = interpreter.Eval(graph, scope)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tc.wantErr) {
+				t.Errorf("unexpected error: want: %q got: %q", tc.wantErr, err.Error())
+			}
+		})
+	}
+}
+
 type function struct {
 	name string
 	t    semantic.Type
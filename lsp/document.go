@@ -0,0 +1,514 @@
+package lsp
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/complete"
+	"github.com/influxdata/ifql/interpreter"
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+)
+
+// document is an analyzed IFQL document.
+type document struct {
+	uri     string
+	lines   [][]rune
+	program *ast.Program
+
+	diagnostics []Diagnostic
+	// scope and declarations are the builtins, imported packages and the variables declared by the document.
+	scope        *interpreter.Scope
+	declarations semantic.DeclarationScope
+	// bindings are the names declared in the document.
+	bindings []binding
+	// references are the identifiers in the document that may refer to a binding.
+	references []reference
+}
+
+// binding is a name declared in the document and the part of the document where it is visible.
+type binding struct {
+	name string
+	// node is the identifier or import declaration that declares the name.
+	node     ast.Node
+	from, to ast.Position
+	// local reports whether the name is a function parameter or is declared in a function.
+	local bool
+}
+
+// reference is an identifier, or the property of a member expression whose object is an identifier.
+type reference struct {
+	id     *ast.Identifier
+	object *ast.Identifier
+}
+
+// analyze parses the text and reports its syntax and semantic errors.
+// The statements are analyzed one at a time so every statement with an error is reported.
+func analyze(uri, text string, searchPath []string) *document {
+	doc := &document{
+		uri: uri,
+	}
+	for _, l := range strings.Split(text, "\n") {
+		doc.lines = append(doc.lines, []rune(l))
+	}
+	program, diagnostics := parser.NewASTWithDiagnostics(text)
+	doc.program = program
+	for _, d := range diagnostics {
+		msg := d.Message
+		if len(d.Expected) > 0 {
+			msg += ", expected " + strings.Join(d.Expected, ", ")
+		}
+		doc.addDiagnostic(&d.Location, msg)
+	}
+
+	values, declarations, importer := query.BuiltInsWithImporter(searchPath)
+	doc.scope = interpreter.NewScopeWithValues(values)
+	doc.declarations = declarations
+
+	end := ast.Position{Line: math.MaxInt32}
+	for _, imp := range program.Imports {
+		if err := importer.Import([]*ast.ImportDeclaration{imp}, doc.scope, doc.declarations); err != nil {
+			doc.addDiagnostic(imp.Location(), err.Error())
+			continue
+		}
+		name := ""
+		if imp.As != nil {
+			name = imp.As.Name
+		} else if pkg, err := importer.Package(imp.Path.Value); err == nil {
+			name = pkg.Name
+		}
+		var node ast.Node = imp
+		if imp.As != nil {
+			node = imp.As
+		}
+		doc.bindings = append(doc.bindings, binding{
+			name: name,
+			node: node,
+			from: imp.Location().End,
+			to:   end,
+		})
+	}
+
+	for _, s := range program.Body {
+		_, err := semantic.New(&ast.Program{Body: []ast.Statement{s}}, doc.declarations)
+		if err != nil {
+			if te, ok := err.(*semantic.TypeError); ok && te.Location != nil {
+				doc.addDiagnostic(te.Location, "type error: "+te.Msg)
+			} else {
+				doc.addDiagnostic(s.Location(), err.Error())
+			}
+		}
+	}
+
+	ast.Walk(bindingVisitor{doc: doc, end: end}, program)
+	ast.Walk(referenceVisitor{doc: doc}, program)
+	return doc
+}
+
+func (d *document) addDiagnostic(loc *ast.SourceLocation, msg string) {
+	var r Range
+	if loc != nil {
+		r = d.lspRange(loc)
+	}
+	d.diagnostics = append(d.diagnostics, Diagnostic{
+		Range:    r,
+		Severity: SeverityError,
+		Source:   "ifql",
+		Message:  msg,
+	})
+}
+
+// bindingVisitor records the names declared in the document.
+// Variables are visible after their declaration until the end of the enclosing block or program,
+// function parameters are visible in the function.
+type bindingVisitor struct {
+	doc   *document
+	end   ast.Position
+	local bool
+}
+
+func (v bindingVisitor) Visit(n ast.Node) ast.Visitor {
+	loc := n.Location()
+	if loc == nil {
+		return v
+	}
+	switch n := n.(type) {
+	case *ast.VariableDeclaration:
+		for _, d := range n.Declarations {
+			v.doc.bindings = append(v.doc.bindings, binding{
+				name:  d.ID.Name,
+				node:  d.ID,
+				from:  loc.End,
+				to:    v.end,
+				local: v.local,
+			})
+		}
+	case *ast.OptionStatement:
+		v.doc.bindings = append(v.doc.bindings, binding{
+			name:  n.Declaration.ID.Name,
+			node:  n.Declaration.ID,
+			from:  loc.End,
+			to:    v.end,
+			local: v.local,
+		})
+	case *ast.ArrowFunctionExpression:
+		for _, p := range n.Params {
+			v.doc.bindings = append(v.doc.bindings, binding{
+				name:  p.Key.Name,
+				node:  p.Key,
+				from:  loc.Start,
+				to:    loc.End,
+				local: true,
+			})
+		}
+		return bindingVisitor{doc: v.doc, end: loc.End, local: true}
+	case *ast.BlockStatement:
+		return bindingVisitor{doc: v.doc, end: loc.End, local: v.local}
+	}
+	return v
+}
+
+func (v bindingVisitor) Done() {}
+
+// referenceVisitor records the identifiers that may refer to a binding,
+// which excludes the keys of objects and properties of member expressions.
+type referenceVisitor struct {
+	doc *document
+}
+
+func (v referenceVisitor) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.MemberExpression:
+		ast.Walk(v, n.Object)
+		if prop, ok := n.Property.(*ast.Identifier); ok {
+			if obj, ok := n.Object.(*ast.Identifier); ok {
+				v.doc.references = append(v.doc.references, reference{id: prop, object: obj})
+			}
+		}
+		return nil
+	case *ast.Property:
+		if n.Value != nil {
+			ast.Walk(v, n.Value)
+		}
+		return nil
+	case *ast.Identifier:
+		v.doc.references = append(v.doc.references, reference{id: n})
+	}
+	return v
+}
+
+func (v referenceVisitor) Done() {}
+
+// referenceAt returns the reference at the position, if any.
+func (d *document) referenceAt(pos ast.Position) *reference {
+	for i, r := range d.references {
+		loc := r.id.Location()
+		if loc != nil && !before(pos, loc.Start) && !before(loc.End, pos) {
+			return &d.references[i]
+		}
+	}
+	return nil
+}
+
+// resolve returns the binding the identifier refers to.
+// Identifiers that are not declared in the document, i.e. builtins, have no binding.
+func (d *document) resolve(id *ast.Identifier) *binding {
+	var found *binding
+	pos := id.Location().Start
+	for i, b := range d.bindings {
+		if b.node == id {
+			return &d.bindings[i]
+		}
+		if b.name != id.Name || before(pos, b.from) || before(b.to, pos) {
+			continue
+		}
+		// The innermost binding is the one visible from the latest position.
+		if found == nil || !before(b.from, found.from) {
+			found = &d.bindings[i]
+		}
+	}
+	return found
+}
+
+// definition returns the location of the declaration of the variable at the position.
+func (d *document) definition(p Position) *Location {
+	ref := d.referenceAt(d.astPosition(p))
+	if ref == nil || ref.object != nil {
+		return nil
+	}
+	b := d.resolve(ref.id)
+	if b == nil {
+		return nil
+	}
+	return &Location{
+		URI:   d.uri,
+		Range: d.lspRange(b.node.Location()),
+	}
+}
+
+// hover describes the variable, function or package member at the position.
+func (d *document) hover(p Position) *Hover {
+	ref := d.referenceAt(d.astPosition(p))
+	if ref == nil {
+		return nil
+	}
+	var desc string
+	if ref.object != nil {
+		if b := d.resolve(ref.object); b != nil && b.local {
+			return nil
+		}
+		t, ok := d.memberType(ref.object.Name, ref.id.Name)
+		if !ok {
+			return nil
+		}
+		desc = describe(ref.object.Name+"."+ref.id.Name, t)
+	} else {
+		if b := d.resolve(ref.id); b != nil && b.local {
+			return nil
+		}
+		decl, ok := d.declarations[ref.id.Name]
+		if !ok {
+			return nil
+		}
+		desc = describe(ref.id.Name, decl.InitType())
+	}
+	r := d.lspRange(ref.id.Location())
+	return &Hover{
+		Contents: MarkupContent{
+			Kind:  "markdown",
+			Value: "```ifql\n" + desc + "\n```",
+		},
+		Range: &r,
+	}
+}
+
+// memberType returns the type of the property of the declared object.
+func (d *document) memberType(object, property string) (semantic.Type, bool) {
+	decl, ok := d.declarations[object]
+	if !ok {
+		return nil, false
+	}
+	t := decl.InitType()
+	if t.Kind() != semantic.Object {
+		return nil, false
+	}
+	pt, ok := t.Properties()[property]
+	return pt, ok
+}
+
+type functionType interface {
+	Params() map[string]semantic.Type
+}
+
+// describe returns the signature of a function, or the name and kind of any other value.
+func describe(name string, t semantic.Type) string {
+	if t.Kind() != semantic.Function {
+		return name + ": " + t.Kind().String()
+	}
+	ft, ok := t.(functionType)
+	if !ok {
+		return name + ": " + t.Kind().String()
+	}
+	pipe := t.PipeArgument()
+	var params []string
+	for k, pt := range ft.Params() {
+		if k != pipe {
+			params = append(params, k+": "+pt.Kind().String())
+		}
+	}
+	sort.Strings(params)
+	if pipe != "" {
+		params = append([]string{pipe + "=<-"}, params...)
+	}
+	sig := name + "(" + strings.Join(params, ", ") + ")"
+	if rt := t.ReturnType(); rt != nil {
+		sig += " " + rt.Kind().String()
+	}
+	return sig
+}
+
+// completion returns the items that complete the word before the position.
+// After a dot the members of the object are completed,
+// within the arguments of a call the parameters of the function are completed in addition to all names in scope.
+func (d *document) completion(p Position) CompletionList {
+	pos := d.astPosition(p)
+	line := d.lines[pos.Line-1]
+	col := pos.Column - 1
+	start := col
+	for start > 0 && isWordRune(line[start-1]) {
+		start--
+	}
+	prefix := string(line[start:col])
+
+	items := []CompletionItem{}
+	add := func(item CompletionItem) {
+		if strings.HasPrefix(item.Label, prefix) {
+			items = append(items, item)
+		}
+	}
+
+	if start > 0 && line[start-1] == '.' {
+		objEnd := start - 1
+		objStart := objEnd
+		for objStart > 0 && isWordRune(line[objStart-1]) {
+			objStart--
+		}
+		if decl, ok := d.declarations[string(line[objStart:objEnd])]; ok && decl.InitType().Kind() == semantic.Object {
+			for name, t := range decl.InitType().Properties() {
+				add(CompletionItem{
+					Label:  name,
+					Kind:   CompletionField,
+					Detail: describe(name, t),
+				})
+			}
+		}
+		sortItems(items)
+		return CompletionList{Items: items}
+	}
+
+	c := complete.NewCompleter(d.scope, d.declarations)
+	if fn := d.enclosingCall(pos.Line-1, start); fn != "" {
+		if s, err := c.FunctionSuggestion(fn); err == nil {
+			pipe := d.declarations[fn].InitType().PipeArgument()
+			for name, kind := range s.Params {
+				if name == pipe {
+					continue
+				}
+				add(CompletionItem{
+					Label:      name,
+					Kind:       CompletionProperty,
+					Detail:     kind,
+					InsertText: name + ": ",
+				})
+			}
+		}
+	}
+	functions := make(map[string]bool)
+	for _, name := range c.FunctionNames() {
+		functions[name] = true
+		add(CompletionItem{
+			Label:  name,
+			Kind:   CompletionFunction,
+			Detail: describe(name, d.declarations[name].InitType()),
+		})
+	}
+	for name, decl := range d.declarations {
+		if !functions[name] {
+			add(CompletionItem{
+				Label:  name,
+				Kind:   CompletionVariable,
+				Detail: decl.InitType().Kind().String(),
+			})
+		}
+	}
+	sortItems(items)
+	return CompletionList{Items: items}
+}
+
+// enclosingCall returns the name of the function whose arguments contain the position,
+// found by scanning back for an unclosed parenthesis preceded by a name.
+func (d *document) enclosingCall(line, col int) string {
+	depth := 0
+	for l := line; l >= 0; l-- {
+		text := d.lines[l]
+		end := len(text)
+		if l == line {
+			end = col
+		}
+		for i := end - 1; i >= 0; i-- {
+			switch text[i] {
+			case ')', ']', '}':
+				depth++
+			case '(', '[', '{':
+				if depth > 0 {
+					depth--
+					continue
+				}
+				if text[i] != '(' {
+					return ""
+				}
+				start := i
+				for start > 0 && isWordRune(text[start-1]) {
+					start--
+				}
+				return string(text[start:i])
+			}
+		}
+	}
+	return ""
+}
+
+func sortItems(items []CompletionItem) {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Kind != items[j].Kind {
+			// Parameters are listed first.
+			return items[i].Kind == CompletionProperty
+		}
+		return items[i].Label < items[j].Label
+	})
+}
+
+// astPosition converts the protocol position into a position of the syntax tree,
+// which counts lines and runes from one.
+func (d *document) astPosition(p Position) ast.Position {
+	line := p.Line
+	if line >= len(d.lines) {
+		line = len(d.lines) - 1
+	}
+	if line < 0 {
+		line = 0
+	}
+	col, units := 0, 0
+	for _, r := range d.lines[line] {
+		if units >= p.Character {
+			break
+		}
+		units += utf16Len(r)
+		col++
+	}
+	return ast.Position{Line: line + 1, Column: col + 1}
+}
+
+// lspPosition converts a position of the syntax tree into a protocol position.
+func (d *document) lspPosition(pos ast.Position) Position {
+	line := pos.Line - 1
+	if line < 0 || line >= len(d.lines) {
+		return Position{Line: line}
+	}
+	units := 0
+	for i, r := range d.lines[line] {
+		if i >= pos.Column-1 {
+			break
+		}
+		units += utf16Len(r)
+	}
+	return Position{Line: line, Character: units}
+}
+
+func (d *document) lspRange(loc *ast.SourceLocation) Range {
+	return Range{
+		Start: d.lspPosition(loc.Start),
+		End:   d.lspPosition(loc.End),
+	}
+}
+
+// utf16Len returns the number of UTF-16 code units of the rune.
+func utf16Len(r rune) int {
+	if r1, _ := utf16.EncodeRune(r); r1 != unicode.ReplacementChar {
+		return 2
+	}
+	return 1
+}
+
+func before(a, b ast.Position) bool {
+	return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,197 @@
+// Package lsp implements a Language Server Protocol server for IFQL.
+//
+// The server offers completion of variables, functions and function parameters,
+// hover with function signatures, go-to-definition of variables declared in a document,
+// and diagnostics for syntax and semantic errors, published whenever a document changes.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+)
+
+// Server is a language server for IFQL documents.
+// Requests are handled one at a time in the order they are received.
+type Server struct {
+	searchPath []string
+	docs       map[string]*document
+	w          io.Writer
+	shutdown   bool
+}
+
+// NewServer creates a server that imports packages from the directories of the search path.
+func NewServer(searchPath []string) *Server {
+	return &Server{
+		searchPath: searchPath,
+		docs:       make(map[string]*document),
+	}
+}
+
+// Serve reads requests from r and writes responses and notifications to w until it receives an exit notification.
+// An error is returned if the client exits without a shutdown request.
+func (s *Server) Serve(r io.Reader, w io.Writer) error {
+	s.w = w
+	br := bufio.NewReader(r)
+	for {
+		content, err := readMessage(br)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var req request
+		if err := json.Unmarshal(content, &req); err != nil {
+			if err := s.respond(nil, nil, &rpcError{Code: codeParseError, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return errors.New("exit notification received before shutdown request")
+			}
+			return nil
+		}
+		result, err := s.handle(req)
+		if req.ID == nil {
+			// Notifications have no response.
+			if err != nil {
+				log.Printf("Error handling %s notification: %v", req.Method, err)
+			}
+			continue
+		}
+		if err := s.respond(req.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+// handle handles the request and returns its result.
+// A panic while handling the request is returned as an internal error, so a single document cannot stop the server.
+func (s *Server) handle(req request) (result interface{}, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = &rpcError{Code: codeInternalError, Message: fmt.Sprintf("panic handling %s: %v", req.Method, e)}
+		}
+	}()
+	switch req.Method {
+	case "initialize":
+		return InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync: TextDocumentSyncFull,
+				CompletionProvider: &CompletionOptions{
+					TriggerCharacters: []string{".", "(", ","},
+				},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+		}, nil
+	case "initialized":
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshalParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		return nil, s.update(params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshalParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		if len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		return nil, s.update(params.TextDocument.URI, text)
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshalParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, params.TextDocument.URI)
+		return nil, s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case "textDocument/completion", "textDocument/hover", "textDocument/definition":
+		var params TextDocumentPositionParams
+		if err := unmarshalParams(req.Params, &params); err != nil {
+			return nil, err
+		}
+		doc, ok := s.docs[params.TextDocument.URI]
+		if !ok {
+			return nil, &rpcError{Code: codeInvalidParams, Message: fmt.Sprintf("unknown document %q", params.TextDocument.URI)}
+		}
+		switch req.Method {
+		case "textDocument/completion":
+			return doc.completion(params.Position), nil
+		case "textDocument/hover":
+			if h := doc.hover(params.Position); h != nil {
+				return h, nil
+			}
+		case "textDocument/definition":
+			if l := doc.definition(params.Position); l != nil {
+				return l, nil
+			}
+		}
+		return nil, nil
+	default:
+		return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+}
+
+// update analyzes the new text of the document and publishes its diagnostics.
+func (s *Server) update(uri, text string) error {
+	doc := analyze(uri, text, s.searchPath)
+	s.docs[uri] = doc
+	return s.notify("textDocument/publishDiagnostics", PublishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: doc.diagnostics,
+	})
+}
+
+func (s *Server) respond(id *json.RawMessage, result interface{}, err error) error {
+	resp := response{
+		JSONRPC: "2.0",
+		ID:      id,
+	}
+	if err != nil {
+		rerr, ok := err.(*rpcError)
+		if !ok {
+			rerr = &rpcError{Code: codeInternalError, Message: err.Error()}
+		}
+		resp.Error = rerr
+	} else {
+		content, err := json.Marshal(result)
+		if err != nil {
+			return err
+		}
+		raw := json.RawMessage(content)
+		resp.Result = &raw
+	}
+	return writeMessage(s.w, resp)
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	return writeMessage(s.w, notification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	})
+}
+
+func unmarshalParams(params json.RawMessage, v interface{}) error {
+	if err := json.Unmarshal(params, v); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
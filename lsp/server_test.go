@@ -0,0 +1,255 @@
+package lsp_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/influxdata/ifql"
+	"github.com/influxdata/ifql/lsp"
+)
+
+const uri = "file:///query.ifql"
+
+const text = `import "mypkg"
+data = from(db: "telegraf")
+    |> range(start: -1h)
+f = (r) => r._value * mypkg.threshold
+data |> map(fn: f) |> filter(fn: (r) => r.x == )
+data |> ran
+y = mypkg.
+z = 1 + "a"`
+
+type message struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+// serve sends the requests to a server and returns the responses by ID and the published diagnostics.
+func serve(t *testing.T, requests []interface{}) (map[int]message, []lsp.PublishDiagnosticsParams) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "ifql-lsp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "mypkg"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	pkg := "package mypkg\nthreshold = 5\nscale = (v) => v * 2\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "mypkg", "a.ifql"), []byte(pkg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var in bytes.Buffer
+	for _, r := range requests {
+		content, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(content), content)
+	}
+	var out bytes.Buffer
+	if err := lsp.NewServer([]string{dir}).Serve(&in, &out); err != nil {
+		t.Fatal(err)
+	}
+
+	responses := make(map[int]message)
+	var diagnostics []lsp.PublishDiagnosticsParams
+	r := bufio.NewReader(&out)
+	for {
+		header, err := textproto.NewReader(r).ReadMIMEHeader()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		n, err := strconv.Atoi(header.Get("Content-Length"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		content := make([]byte, n)
+		if _, err := io.ReadFull(r, content); err != nil {
+			t.Fatal(err)
+		}
+		var m message
+		if err := json.Unmarshal(content, &m); err != nil {
+			t.Fatal(err)
+		}
+		if m.ID != nil {
+			responses[*m.ID] = m
+		} else if m.Method == "textDocument/publishDiagnostics" {
+			var p lsp.PublishDiagnosticsParams
+			if err := json.Unmarshal(m.Params, &p); err != nil {
+				t.Fatal(err)
+			}
+			diagnostics = append(diagnostics, p)
+		}
+	}
+	return responses, diagnostics
+}
+
+func req(id int, method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "id": id, "method": method, "params": params}
+}
+
+func notification(method string, params interface{}) map[string]interface{} {
+	return map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+}
+
+func at(line, character int) lsp.TextDocumentPositionParams {
+	return lsp.TextDocumentPositionParams{
+		TextDocument: lsp.TextDocumentIdentifier{URI: uri},
+		Position:     lsp.Position{Line: line, Character: character},
+	}
+}
+
+func rng(line, start, end int) lsp.Range {
+	return lsp.Range{
+		Start: lsp.Position{Line: line, Character: start},
+		End:   lsp.Position{Line: line, Character: end},
+	}
+}
+
+func TestServer(t *testing.T) {
+	requests := []interface{}{
+		req(0, "initialize", map[string]interface{}{}),
+		notification("initialized", map[string]interface{}{}),
+		notification("textDocument/didOpen", lsp.DidOpenTextDocumentParams{
+			TextDocument: lsp.TextDocumentItem{URI: uri, LanguageID: "ifql", Version: 1, Text: text},
+		}),
+		req(1, "textDocument/completion", at(5, 11)),
+		req(2, "textDocument/completion", at(6, 10)),
+		req(3, "textDocument/completion", at(2, 13)),
+		req(4, "textDocument/hover", at(2, 8)),
+		req(5, "textDocument/hover", at(3, 30)),
+		req(6, "textDocument/hover", at(3, 12)),
+		req(7, "textDocument/definition", at(4, 16)),
+		req(8, "textDocument/definition", at(3, 11)),
+		req(9, "textDocument/definition", at(5, 0)),
+		req(10, "textDocument/definition", at(2, 8)),
+		req(11, "unknown", nil),
+		req(12, "shutdown", nil),
+		notification("exit", nil),
+	}
+	responses, diagnostics := serve(t, requests)
+
+	var init lsp.InitializeResult
+	if err := json.Unmarshal(responses[0].Result, &init); err != nil {
+		t.Fatal(err)
+	}
+	if !init.Capabilities.HoverProvider || !init.Capabilities.DefinitionProvider || init.Capabilities.CompletionProvider == nil {
+		t.Errorf("unexpected capabilities %+v", init.Capabilities)
+	}
+
+	wantDiagnostics := []lsp.PublishDiagnosticsParams{{
+		URI: uri,
+		Diagnostics: []lsp.Diagnostic{
+			{Range: rng(4, 47, 48), Severity: lsp.SeverityError, Source: "ifql"},
+			{Range: rng(5, 11, 11), Severity: lsp.SeverityError, Source: "ifql"},
+			{Range: rng(6, 10, 10), Severity: lsp.SeverityError, Source: "ifql"},
+			{Range: rng(7, 4, 11), Severity: lsp.SeverityError, Source: "ifql"},
+		},
+	}}
+	ignoreMessage := cmp.FilterPath(func(p cmp.Path) bool {
+		return p.Last().String() == ".Message"
+	}, cmp.Ignore())
+	if !cmp.Equal(wantDiagnostics, diagnostics, ignoreMessage) {
+		t.Errorf("unexpected diagnostics -want/+got:\n%s", cmp.Diff(wantDiagnostics, diagnostics, ignoreMessage))
+	}
+
+	completions := []struct {
+		id   int
+		want []string
+	}{
+		{id: 1, want: []string{"range"}},
+		{id: 2, want: []string{"scale", "threshold"}},
+		{id: 3, want: []string{"start", "stop"}},
+	}
+	for _, c := range completions {
+		var list lsp.CompletionList
+		if err := json.Unmarshal(responses[c.id].Result, &list); err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for _, item := range list.Items {
+			if len(got) < len(c.want) {
+				got = append(got, item.Label)
+			}
+		}
+		if !cmp.Equal(c.want, got) {
+			t.Errorf("unexpected completion %d -want/+got:\n%s", c.id, cmp.Diff(c.want, got))
+		}
+	}
+
+	hovers := []struct {
+		id   int
+		want string
+	}{
+		{id: 4, want: "```ifql\nrange(table=<-, start: typevar, stop: typevar) object\n```"},
+		{id: 5, want: "```ifql\nmypkg.threshold: int\n```"},
+		{id: 6, want: "null"},
+	}
+	for _, h := range hovers {
+		got := string(responses[h.id].Result)
+		if got != "null" {
+			var hover lsp.Hover
+			if err := json.Unmarshal(responses[h.id].Result, &hover); err != nil {
+				t.Fatal(err)
+			}
+			got = hover.Contents.Value
+		}
+		if got != h.want {
+			t.Errorf("unexpected hover %d -want/+got:\n%s", h.id, cmp.Diff(h.want, got))
+		}
+	}
+
+	definitions := []struct {
+		id   int
+		want *lsp.Location
+	}{
+		{id: 7, want: &lsp.Location{URI: uri, Range: rng(3, 0, 1)}},
+		{id: 8, want: &lsp.Location{URI: uri, Range: rng(3, 5, 6)}},
+		{id: 9, want: &lsp.Location{URI: uri, Range: rng(1, 0, 4)}},
+		{id: 10, want: nil},
+	}
+	for _, d := range definitions {
+		var got *lsp.Location
+		if err := json.Unmarshal(responses[d.id].Result, &got); err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(d.want, got) {
+			t.Errorf("unexpected definition %d -want/+got:\n%s", d.id, cmp.Diff(d.want, got))
+		}
+	}
+
+	if e := responses[11].Error; e == nil || e.Code != -32601 {
+		t.Errorf("expected method not found error, got %+v", responses[11])
+	}
+	if got := string(responses[12].Result); got != "null" {
+		t.Errorf("unexpected shutdown result %s", got)
+	}
+}
+
+func TestServer_ExitWithoutShutdown(t *testing.T) {
+	content := `{"jsonrpc":"2.0","method":"exit"}`
+	in := bytes.NewBufferString(fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(content), content))
+	if err := lsp.NewServer(nil).Serve(in, ioutil.Discard); err == nil {
+		t.Error("expected an error when exiting without shutdown")
+	}
+}
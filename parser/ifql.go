@@ -1,3 +1,5 @@
+// Code generated by pigeon; DO NOT EDIT.
+
 package parser
 
 import (
@@ -28,29 +30,29 @@ var g = &grammar{
 					pos: position{line: 9, col: 5, offset: 112},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -58,7 +60,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -76,29 +78,29 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -106,7 +108,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -116,9 +118,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 483, col: 5, offset: 8851},
+							pos: position{line: 575, col: 5, offset: 10871},
 							expr: &anyMatcher{
-								line: 483, col: 6, offset: 8852,
+								line: 575, col: 6, offset: 10872,
 							},
 						},
 					},
@@ -131,120 +133,304 @@ var g = &grammar{
 			expr: &actionExpr{
 				pos: position{line: 14, col: 5, offset: 185},
 				run: (*parser).callonProgram1,
-				expr: &labeledExpr{
-					pos:   position{line: 14, col: 5, offset: 185},
-					label: "body",
-					expr: &ruleRefExpr{
-						pos:  position{line: 14, col: 10, offset: 190},
-						name: "SourceElements",
-					},
-				},
-			},
-		},
-		{
-			name: "SourceElements",
-			pos:  position{line: 18, col: 1, offset: 256},
-			expr: &actionExpr{
-				pos: position{line: 19, col: 5, offset: 275},
-				run: (*parser).callonSourceElements1,
 				expr: &seqExpr{
-					pos: position{line: 19, col: 5, offset: 275},
+					pos: position{line: 14, col: 5, offset: 185},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 19, col: 5, offset: 275},
-							label: "head",
-							expr: &ruleRefExpr{
-								pos:  position{line: 19, col: 10, offset: 280},
-								name: "SourceElement",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 19, col: 24, offset: 294},
-							label: "tail",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 19, col: 29, offset: 299},
-								expr: &seqExpr{
-									pos: position{line: 19, col: 30, offset: 300},
-									exprs: []interface{}{
-										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
-											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
-												alternatives: []interface{}{
-													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
-														val:        "[ \\t\\r\\n]",
-														chars:      []rune{' ', '\t', '\r', '\n'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
-																val:        "//",
-																ignoreCase: false,
-															},
-															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
-																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
-																	val:        "[^\\r\\n]",
-																	chars:      []rune{'\r', '\n'},
-																	ignoreCase: false,
-																	inverted:   true,
-																},
-															},
-															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
-																val:        "\n",
-																ignoreCase: false,
-															},
-														},
-													},
+							pos:   position{line: 14, col: 5, offset: 185},
+							label: "pkg",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 14, col: 9, offset: 189},
+								expr: &actionExpr{
+									pos: position{line: 19, col: 5, offset: 342},
+									run: (*parser).callonProgram5,
+									expr: &seqExpr{
+										pos: position{line: 19, col: 5, offset: 342},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 528, col: 5, offset: 10377},
+												val:        "package",
+												ignoreCase: false,
+											},
+											&notExpr{
+												pos: position{line: 528, col: 15, offset: 10387},
+												expr: &charClassMatcher{
+													pos:        position{line: 528, col: 16, offset: 10388},
+													val:        "[_0-9\\pL]",
+													chars:      []rune{'_'},
+													ranges:     []rune{'0', '9'},
+													classes:    []*unicode.RangeTable{rangeTable("L")},
+													ignoreCase: false,
+													inverted:   false,
 												},
 											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 19, col: 33, offset: 303},
-											name: "SourceElement",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
-											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
-												alternatives: []interface{}{
-													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
-														val:        "[ \\t\\r\\n]",
-														chars:      []rune{' ', '\t', '\r', '\n'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+											&oneOrMoreExpr{
+												pos: position{line: 19, col: 20, offset: 357},
+												expr: &charClassMatcher{
+													pos:        position{line: 566, col: 5, offset: 10811},
+													val:        "[ \\t\\r\\n]",
+													chars:      []rune{' ', '\t', '\r', '\n'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 19, col: 24, offset: 361},
+												label: "name",
+												expr: &actionExpr{
+													pos: position{line: 510, col: 5, offset: 10106},
+													run: (*parser).callonProgram13,
+													expr: &seqExpr{
+														pos: position{line: 510, col: 5, offset: 10106},
 														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
-																val:        "//",
+															&notExpr{
+																pos: position{line: 510, col: 5, offset: 10106},
+																expr: &choiceExpr{
+																	pos: position{line: 516, col: 5, offset: 10193},
+																	alternatives: []interface{}{
+																		&seqExpr{
+																			pos: position{line: 528, col: 5, offset: 10377},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 528, col: 5, offset: 10377},
+																					val:        "package",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 528, col: 15, offset: 10387},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 528, col: 16, offset: 10388},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 531, col: 5, offset: 10417},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 531, col: 5, offset: 10417},
+																					val:        "import",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 531, col: 14, offset: 10426},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 531, col: 15, offset: 10427},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 534, col: 5, offset: 10456},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 534, col: 5, offset: 10456},
+																					val:        "option",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 534, col: 14, offset: 10465},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 534, col: 15, offset: 10466},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 537, col: 5, offset: 10495},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 537, col: 5, offset: 10495},
+																					val:        "return",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 537, col: 14, offset: 10504},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 537, col: 15, offset: 10505},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 540, col: 5, offset: 10530},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 540, col: 5, offset: 10530},
+																					val:        "if",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 540, col: 10, offset: 10535},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 540, col: 11, offset: 10536},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 543, col: 5, offset: 10563},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 543, col: 5, offset: 10563},
+																					val:        "then",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 543, col: 12, offset: 10570},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 543, col: 13, offset: 10571},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 546, col: 5, offset: 10598},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 546, col: 5, offset: 10598},
+																					val:        "else",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 546, col: 12, offset: 10605},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 546, col: 13, offset: 10606},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 549, col: 5, offset: 10631},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 549, col: 5, offset: 10631},
+																					val:        "in",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 549, col: 10, offset: 10636},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 549, col: 11, offset: 10637},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 552, col: 5, offset: 10665},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 552, col: 5, offset: 10665},
+																					val:        "empty",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 552, col: 13, offset: 10673},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 552, col: 14, offset: 10674},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																		&seqExpr{
+																			pos: position{line: 555, col: 5, offset: 10703},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 555, col: 5, offset: 10703},
+																					val:        "exists",
+																					ignoreCase: false,
+																				},
+																				&notExpr{
+																					pos: position{line: 555, col: 14, offset: 10712},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 555, col: 15, offset: 10713},
+																						val:        "[_0-9\\pL]",
+																						chars:      []rune{'_'},
+																						ranges:     []rune{'0', '9'},
+																						classes:    []*unicode.RangeTable{rangeTable("L")},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															&charClassMatcher{
+																pos:        position{line: 510, col: 14, offset: 10115},
+																val:        "[_\\pL]",
+																chars:      []rune{'_'},
+																classes:    []*unicode.RangeTable{rangeTable("L")},
 																ignoreCase: false,
+																inverted:   false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 510, col: 20, offset: 10121},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
-																	val:        "[^\\r\\n]",
-																	chars:      []rune{'\r', '\n'},
+																	pos:        position{line: 510, col: 20, offset: 10121},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
 																	ignoreCase: false,
-																	inverted:   true,
+																	inverted:   false,
 																},
 															},
-															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
-																val:        "\n",
-																ignoreCase: false,
-															},
 														},
 													},
 												},
@@ -254,97 +440,30 @@ var g = &grammar{
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "SourceElement",
-			pos:  position{line: 23, col: 1, offset: 365},
-			expr: &ruleRefExpr{
-				pos:  position{line: 24, col: 5, offset: 383},
-				name: "Statement",
-			},
-		},
-		{
-			name: "Statement",
-			pos:  position{line: 26, col: 1, offset: 394},
-			expr: &choiceExpr{
-				pos: position{line: 27, col: 5, offset: 408},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 27, col: 5, offset: 408},
-						name: "VariableStatement",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 28, col: 5, offset: 430},
-						name: "ReturnStatement",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 29, col: 5, offset: 450},
-						name: "ExpressionStatement",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 30, col: 5, offset: 474},
-						name: "BlockStatement",
-					},
-				},
-			},
-		},
-		{
-			name: "VariableStatement",
-			pos:  position{line: 33, col: 1, offset: 491},
-			expr: &actionExpr{
-				pos: position{line: 34, col: 5, offset: 513},
-				run: (*parser).callonVariableStatement1,
-				expr: &labeledExpr{
-					pos:   position{line: 34, col: 5, offset: 513},
-					label: "declaration",
-					expr: &ruleRefExpr{
-						pos:  position{line: 34, col: 17, offset: 525},
-						name: "VariableDeclaration",
-					},
-				},
-			},
-		},
-		{
-			name: "ReturnStatement",
-			pos:  position{line: 38, col: 1, offset: 604},
-			expr: &actionExpr{
-				pos: position{line: 39, col: 5, offset: 624},
-				run: (*parser).callonReturnStatement1,
-				expr: &seqExpr{
-					pos: position{line: 39, col: 5, offset: 624},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 39, col: 5, offset: 624},
-							val:        "return",
-							ignoreCase: false,
-						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -352,7 +471,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -362,71 +481,1267 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 39, col: 17, offset: 636},
-							label: "argument",
-							expr: &ruleRefExpr{
-								pos:  position{line: 39, col: 26, offset: 645},
-								name: "Expr",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ExpressionStatement",
-			pos:  position{line: 43, col: 1, offset: 708},
-			expr: &actionExpr{
-				pos: position{line: 44, col: 5, offset: 732},
-				run: (*parser).callonExpressionStatement1,
-				expr: &labeledExpr{
-					pos:   position{line: 44, col: 5, offset: 732},
-					label: "expr",
-					expr: &ruleRefExpr{
-						pos:  position{line: 44, col: 10, offset: 737},
-						name: "Expr",
-					},
-				},
-			},
-		},
-		{
-			name: "BlockStatement",
-			pos:  position{line: 48, col: 1, offset: 796},
-			expr: &actionExpr{
-				pos: position{line: 49, col: 5, offset: 815},
-				run: (*parser).callonBlockStatement1,
-				expr: &seqExpr{
-					pos: position{line: 49, col: 5, offset: 815},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 49, col: 5, offset: 815},
-							val:        "{",
-							ignoreCase: false,
+							pos:   position{line: 14, col: 27, offset: 207},
+							label: "imports",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 14, col: 35, offset: 215},
+								expr: &actionExpr{
+									pos: position{line: 24, col: 5, offset: 453},
+									run: (*parser).callonProgram70,
+									expr: &seqExpr{
+										pos: position{line: 24, col: 5, offset: 453},
+										exprs: []interface{}{
+											&labeledExpr{
+												pos:   position{line: 24, col: 5, offset: 453},
+												label: "head",
+												expr: &actionExpr{
+													pos: position{line: 29, col: 5, offset: 573},
+													run: (*parser).callonProgram73,
+													expr: &seqExpr{
+														pos: position{line: 29, col: 5, offset: 573},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 531, col: 5, offset: 10417},
+																val:        "import",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 531, col: 14, offset: 10426},
+																expr: &charClassMatcher{
+																	pos:        position{line: 531, col: 15, offset: 10427},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+															&oneOrMoreExpr{
+																pos: position{line: 29, col: 19, offset: 587},
+																expr: &charClassMatcher{
+																	pos:        position{line: 566, col: 5, offset: 10811},
+																	val:        "[ \\t\\r\\n]",
+																	chars:      []rune{' ', '\t', '\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+															&labeledExpr{
+																pos:   position{line: 29, col: 23, offset: 591},
+																label: "as",
+																expr: &zeroOrOneExpr{
+																	pos: position{line: 29, col: 26, offset: 594},
+																	expr: &seqExpr{
+																		pos: position{line: 29, col: 27, offset: 595},
+																		exprs: []interface{}{
+																			&actionExpr{
+																				pos: position{line: 510, col: 5, offset: 10106},
+																				run: (*parser).callonProgram83,
+																				expr: &seqExpr{
+																					pos: position{line: 510, col: 5, offset: 10106},
+																					exprs: []interface{}{
+																						&notExpr{
+																							pos: position{line: 510, col: 5, offset: 10106},
+																							expr: &choiceExpr{
+																								pos: position{line: 516, col: 5, offset: 10193},
+																								alternatives: []interface{}{
+																									&seqExpr{
+																										pos: position{line: 528, col: 5, offset: 10377},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 528, col: 5, offset: 10377},
+																												val:        "package",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 528, col: 15, offset: 10387},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 528, col: 16, offset: 10388},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 531, col: 5, offset: 10417},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 531, col: 5, offset: 10417},
+																												val:        "import",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 531, col: 14, offset: 10426},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 531, col: 15, offset: 10427},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 534, col: 5, offset: 10456},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 534, col: 5, offset: 10456},
+																												val:        "option",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 534, col: 14, offset: 10465},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 534, col: 15, offset: 10466},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 537, col: 5, offset: 10495},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 537, col: 5, offset: 10495},
+																												val:        "return",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 537, col: 14, offset: 10504},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 537, col: 15, offset: 10505},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 540, col: 5, offset: 10530},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 540, col: 5, offset: 10530},
+																												val:        "if",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 540, col: 10, offset: 10535},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 540, col: 11, offset: 10536},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 543, col: 5, offset: 10563},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 543, col: 5, offset: 10563},
+																												val:        "then",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 543, col: 12, offset: 10570},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 543, col: 13, offset: 10571},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 546, col: 5, offset: 10598},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 546, col: 5, offset: 10598},
+																												val:        "else",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 546, col: 12, offset: 10605},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 546, col: 13, offset: 10606},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 549, col: 5, offset: 10631},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 549, col: 5, offset: 10631},
+																												val:        "in",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 549, col: 10, offset: 10636},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 549, col: 11, offset: 10637},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 552, col: 5, offset: 10665},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 552, col: 5, offset: 10665},
+																												val:        "empty",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 552, col: 13, offset: 10673},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 552, col: 14, offset: 10674},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																									&seqExpr{
+																										pos: position{line: 555, col: 5, offset: 10703},
+																										exprs: []interface{}{
+																											&litMatcher{
+																												pos:        position{line: 555, col: 5, offset: 10703},
+																												val:        "exists",
+																												ignoreCase: false,
+																											},
+																											&notExpr{
+																												pos: position{line: 555, col: 14, offset: 10712},
+																												expr: &charClassMatcher{
+																													pos:        position{line: 555, col: 15, offset: 10713},
+																													val:        "[_0-9\\pL]",
+																													chars:      []rune{'_'},
+																													ranges:     []rune{'0', '9'},
+																													classes:    []*unicode.RangeTable{rangeTable("L")},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&charClassMatcher{
+																							pos:        position{line: 510, col: 14, offset: 10115},
+																							val:        "[_\\pL]",
+																							chars:      []rune{'_'},
+																							classes:    []*unicode.RangeTable{rangeTable("L")},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																						&zeroOrMoreExpr{
+																							pos: position{line: 510, col: 20, offset: 10121},
+																							expr: &charClassMatcher{
+																								pos:        position{line: 510, col: 20, offset: 10121},
+																								val:        "[_0-9\\pL]",
+																								chars:      []rune{'_'},
+																								ranges:     []rune{'0', '9'},
+																								classes:    []*unicode.RangeTable{rangeTable("L")},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																			&oneOrMoreExpr{
+																				pos: position{line: 29, col: 38, offset: 606},
+																				expr: &charClassMatcher{
+																					pos:        position{line: 566, col: 5, offset: 10811},
+																					val:        "[ \\t\\r\\n]",
+																					chars:      []rune{' ', '\t', '\r', '\n'},
+																					ignoreCase: false,
+																					inverted:   false,
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															&labeledExpr{
+																pos:   position{line: 29, col: 44, offset: 612},
+																label: "path",
+																expr: &choiceExpr{
+																	pos: position{line: 408, col: 5, offset: 8109},
+																	alternatives: []interface{}{
+																		&actionExpr{
+																			pos: position{line: 408, col: 5, offset: 8109},
+																			run: (*parser).callonProgram134,
+																			expr: &seqExpr{
+																				pos: position{line: 408, col: 7, offset: 8111},
+																				exprs: []interface{}{
+																					&litMatcher{
+																						pos:        position{line: 408, col: 7, offset: 8111},
+																						val:        "\"",
+																						ignoreCase: false,
+																					},
+																					&zeroOrMoreExpr{
+																						pos: position{line: 408, col: 11, offset: 8115},
+																						expr: &choiceExpr{
+																							pos: position{line: 416, col: 5, offset: 8297},
+																							alternatives: []interface{}{
+																								&seqExpr{
+																									pos: position{line: 416, col: 5, offset: 8297},
+																									exprs: []interface{}{
+																										&notExpr{
+																											pos: position{line: 416, col: 5, offset: 8297},
+																											expr: &choiceExpr{
+																												pos: position{line: 416, col: 8, offset: 8300},
+																												alternatives: []interface{}{
+																													&charClassMatcher{
+																														pos:        position{line: 416, col: 8, offset: 8300},
+																														val:        "[\"\\\\\\n]",
+																														chars:      []rune{'"', '\\', '\n'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&litMatcher{
+																														pos:        position{line: 416, col: 27, offset: 8319},
+																														val:        "${",
+																														ignoreCase: false,
+																													},
+																												},
+																											},
+																										},
+																										&anyMatcher{
+																											line: 558, col: 5, offset: 10739,
+																										},
+																									},
+																								},
+																								&seqExpr{
+																									pos: position{line: 417, col: 5, offset: 8341},
+																									exprs: []interface{}{
+																										&litMatcher{
+																											pos:        position{line: 417, col: 5, offset: 8341},
+																											val:        "\\",
+																											ignoreCase: false,
+																										},
+																										&choiceExpr{
+																											pos: position{line: 420, col: 5, offset: 8389},
+																											alternatives: []interface{}{
+																												&charClassMatcher{
+																													pos:        position{line: 420, col: 7, offset: 8391},
+																													val:        "[\"\\\\$nrt]",
+																													chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&seqExpr{
+																													pos: position{line: 420, col: 44, offset: 8428},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 420, col: 44, offset: 8428},
+																															val:        "x",
+																															ignoreCase: false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 426, col: 5, offset: 8562},
+																															val:        "[0-9a-fA-F]",
+																															ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 426, col: 5, offset: 8562},
+																															val:        "[0-9a-fA-F]",
+																															ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																												&actionExpr{
+																													pos: position{line: 421, col: 5, offset: 8456},
+																													run: (*parser).callonProgram153,
+																													expr: &choiceExpr{
+																														pos: position{line: 421, col: 7, offset: 8458},
+																														alternatives: []interface{}{
+																															&anyMatcher{
+																																line: 558, col: 5, offset: 10739,
+																															},
+																															&litMatcher{
+																																pos:        position{line: 572, col: 5, offset: 10857},
+																																val:        "\n",
+																																ignoreCase: false,
+																															},
+																															&notExpr{
+																																pos: position{line: 575, col: 5, offset: 10871},
+																																expr: &anyMatcher{
+																																	line: 575, col: 6, offset: 10872,
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&litMatcher{
+																						pos:        position{line: 408, col: 29, offset: 8133},
+																						val:        "\"",
+																						ignoreCase: false,
+																					},
+																				},
+																			},
+																		},
+																		&actionExpr{
+																			pos: position{line: 411, col: 5, offset: 8193},
+																			run: (*parser).callonProgram160,
+																			expr: &seqExpr{
+																				pos: position{line: 411, col: 7, offset: 8195},
+																				exprs: []interface{}{
+																					&litMatcher{
+																						pos:        position{line: 411, col: 7, offset: 8195},
+																						val:        "\"",
+																						ignoreCase: false,
+																					},
+																					&zeroOrMoreExpr{
+																						pos: position{line: 411, col: 11, offset: 8199},
+																						expr: &choiceExpr{
+																							pos: position{line: 416, col: 5, offset: 8297},
+																							alternatives: []interface{}{
+																								&seqExpr{
+																									pos: position{line: 416, col: 5, offset: 8297},
+																									exprs: []interface{}{
+																										&notExpr{
+																											pos: position{line: 416, col: 5, offset: 8297},
+																											expr: &choiceExpr{
+																												pos: position{line: 416, col: 8, offset: 8300},
+																												alternatives: []interface{}{
+																													&charClassMatcher{
+																														pos:        position{line: 416, col: 8, offset: 8300},
+																														val:        "[\"\\\\\\n]",
+																														chars:      []rune{'"', '\\', '\n'},
+																														ignoreCase: false,
+																														inverted:   false,
+																													},
+																													&litMatcher{
+																														pos:        position{line: 416, col: 27, offset: 8319},
+																														val:        "${",
+																														ignoreCase: false,
+																													},
+																												},
+																											},
+																										},
+																										&anyMatcher{
+																											line: 558, col: 5, offset: 10739,
+																										},
+																									},
+																								},
+																								&seqExpr{
+																									pos: position{line: 417, col: 5, offset: 8341},
+																									exprs: []interface{}{
+																										&litMatcher{
+																											pos:        position{line: 417, col: 5, offset: 8341},
+																											val:        "\\",
+																											ignoreCase: false,
+																										},
+																										&choiceExpr{
+																											pos: position{line: 420, col: 5, offset: 8389},
+																											alternatives: []interface{}{
+																												&charClassMatcher{
+																													pos:        position{line: 420, col: 7, offset: 8391},
+																													val:        "[\"\\\\$nrt]",
+																													chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+																													ignoreCase: false,
+																													inverted:   false,
+																												},
+																												&seqExpr{
+																													pos: position{line: 420, col: 44, offset: 8428},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 420, col: 44, offset: 8428},
+																															val:        "x",
+																															ignoreCase: false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 426, col: 5, offset: 8562},
+																															val:        "[0-9a-fA-F]",
+																															ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																														&charClassMatcher{
+																															pos:        position{line: 426, col: 5, offset: 8562},
+																															val:        "[0-9a-fA-F]",
+																															ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																															ignoreCase: false,
+																															inverted:   false,
+																														},
+																													},
+																												},
+																												&actionExpr{
+																													pos: position{line: 421, col: 5, offset: 8456},
+																													run: (*parser).callonProgram179,
+																													expr: &choiceExpr{
+																														pos: position{line: 421, col: 7, offset: 8458},
+																														alternatives: []interface{}{
+																															&anyMatcher{
+																																line: 558, col: 5, offset: 10739,
+																															},
+																															&litMatcher{
+																																pos:        position{line: 572, col: 5, offset: 10857},
+																																val:        "\n",
+																																ignoreCase: false,
+																															},
+																															&notExpr{
+																																pos: position{line: 575, col: 5, offset: 10871},
+																																expr: &anyMatcher{
+																																	line: 575, col: 6, offset: 10872,
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																					&choiceExpr{
+																						pos: position{line: 411, col: 31, offset: 8219},
+																						alternatives: []interface{}{
+																							&litMatcher{
+																								pos:        position{line: 572, col: 5, offset: 10857},
+																								val:        "\n",
+																								ignoreCase: false,
+																							},
+																							&notExpr{
+																								pos: position{line: 575, col: 5, offset: 10871},
+																								expr: &anyMatcher{
+																									line: 575, col: 6, offset: 10872,
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 24, col: 28, offset: 476},
+												label: "tail",
+												expr: &zeroOrMoreExpr{
+													pos: position{line: 24, col: 33, offset: 481},
+													expr: &seqExpr{
+														pos: position{line: 24, col: 34, offset: 482},
+														exprs: []interface{}{
+															&zeroOrMoreExpr{
+																pos: position{line: 560, col: 5, offset: 10748},
+																expr: &choiceExpr{
+																	pos: position{line: 560, col: 7, offset: 10750},
+																	alternatives: []interface{}{
+																		&charClassMatcher{
+																			pos:        position{line: 566, col: 5, offset: 10811},
+																			val:        "[ \\t\\r\\n]",
+																			chars:      []rune{' ', '\t', '\r', '\n'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																		&seqExpr{
+																			pos: position{line: 563, col: 5, offset: 10785},
+																			exprs: []interface{}{
+																				&litMatcher{
+																					pos:        position{line: 563, col: 5, offset: 10785},
+																					val:        "//",
+																					ignoreCase: false,
+																				},
+																				&zeroOrMoreExpr{
+																					pos: position{line: 563, col: 10, offset: 10790},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 563, col: 10, offset: 10790},
+																						val:        "[^\\r\\n]",
+																						chars:      []rune{'\r', '\n'},
+																						ignoreCase: false,
+																						inverted:   true,
+																					},
+																				},
+																				&litMatcher{
+																					pos:        position{line: 572, col: 5, offset: 10857},
+																					val:        "\n",
+																					ignoreCase: false,
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+															&actionExpr{
+																pos: position{line: 29, col: 5, offset: 573},
+																run: (*parser).callonProgram200,
+																expr: &seqExpr{
+																	pos: position{line: 29, col: 5, offset: 573},
+																	exprs: []interface{}{
+																		&litMatcher{
+																			pos:        position{line: 531, col: 5, offset: 10417},
+																			val:        "import",
+																			ignoreCase: false,
+																		},
+																		&notExpr{
+																			pos: position{line: 531, col: 14, offset: 10426},
+																			expr: &charClassMatcher{
+																				pos:        position{line: 531, col: 15, offset: 10427},
+																				val:        "[_0-9\\pL]",
+																				chars:      []rune{'_'},
+																				ranges:     []rune{'0', '9'},
+																				classes:    []*unicode.RangeTable{rangeTable("L")},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																		&oneOrMoreExpr{
+																			pos: position{line: 29, col: 19, offset: 587},
+																			expr: &charClassMatcher{
+																				pos:        position{line: 566, col: 5, offset: 10811},
+																				val:        "[ \\t\\r\\n]",
+																				chars:      []rune{' ', '\t', '\r', '\n'},
+																				ignoreCase: false,
+																				inverted:   false,
+																			},
+																		},
+																		&labeledExpr{
+																			pos:   position{line: 29, col: 23, offset: 591},
+																			label: "as",
+																			expr: &zeroOrOneExpr{
+																				pos: position{line: 29, col: 26, offset: 594},
+																				expr: &seqExpr{
+																					pos: position{line: 29, col: 27, offset: 595},
+																					exprs: []interface{}{
+																						&actionExpr{
+																							pos: position{line: 510, col: 5, offset: 10106},
+																							run: (*parser).callonProgram83,
+																							expr: &seqExpr{
+																								pos: position{line: 510, col: 5, offset: 10106},
+																								exprs: []interface{}{
+																									&notExpr{
+																										pos: position{line: 510, col: 5, offset: 10106},
+																										expr: &choiceExpr{
+																											pos: position{line: 516, col: 5, offset: 10193},
+																											alternatives: []interface{}{
+																												&seqExpr{
+																													pos: position{line: 528, col: 5, offset: 10377},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 528, col: 5, offset: 10377},
+																															val:        "package",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 528, col: 15, offset: 10387},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 528, col: 16, offset: 10388},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 531, col: 5, offset: 10417},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 531, col: 5, offset: 10417},
+																															val:        "import",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 531, col: 14, offset: 10426},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 531, col: 15, offset: 10427},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 534, col: 5, offset: 10456},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 534, col: 5, offset: 10456},
+																															val:        "option",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 534, col: 14, offset: 10465},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 534, col: 15, offset: 10466},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 537, col: 5, offset: 10495},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 537, col: 5, offset: 10495},
+																															val:        "return",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 537, col: 14, offset: 10504},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 537, col: 15, offset: 10505},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 540, col: 5, offset: 10530},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 540, col: 5, offset: 10530},
+																															val:        "if",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 540, col: 10, offset: 10535},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 540, col: 11, offset: 10536},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 543, col: 5, offset: 10563},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 543, col: 5, offset: 10563},
+																															val:        "then",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 543, col: 12, offset: 10570},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 543, col: 13, offset: 10571},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 546, col: 5, offset: 10598},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 546, col: 5, offset: 10598},
+																															val:        "else",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 546, col: 12, offset: 10605},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 546, col: 13, offset: 10606},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 549, col: 5, offset: 10631},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 549, col: 5, offset: 10631},
+																															val:        "in",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 549, col: 10, offset: 10636},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 549, col: 11, offset: 10637},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 552, col: 5, offset: 10665},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 552, col: 5, offset: 10665},
+																															val:        "empty",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 552, col: 13, offset: 10673},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 552, col: 14, offset: 10674},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																												&seqExpr{
+																													pos: position{line: 555, col: 5, offset: 10703},
+																													exprs: []interface{}{
+																														&litMatcher{
+																															pos:        position{line: 555, col: 5, offset: 10703},
+																															val:        "exists",
+																															ignoreCase: false,
+																														},
+																														&notExpr{
+																															pos: position{line: 555, col: 14, offset: 10712},
+																															expr: &charClassMatcher{
+																																pos:        position{line: 555, col: 15, offset: 10713},
+																																val:        "[_0-9\\pL]",
+																																chars:      []rune{'_'},
+																																ranges:     []rune{'0', '9'},
+																																classes:    []*unicode.RangeTable{rangeTable("L")},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																									&charClassMatcher{
+																										pos:        position{line: 510, col: 14, offset: 10115},
+																										val:        "[_\\pL]",
+																										chars:      []rune{'_'},
+																										classes:    []*unicode.RangeTable{rangeTable("L")},
+																										ignoreCase: false,
+																										inverted:   false,
+																									},
+																									&zeroOrMoreExpr{
+																										pos: position{line: 510, col: 20, offset: 10121},
+																										expr: &charClassMatcher{
+																											pos:        position{line: 510, col: 20, offset: 10121},
+																											val:        "[_0-9\\pL]",
+																											chars:      []rune{'_'},
+																											ranges:     []rune{'0', '9'},
+																											classes:    []*unicode.RangeTable{rangeTable("L")},
+																											ignoreCase: false,
+																											inverted:   false,
+																										},
+																									},
+																								},
+																							},
+																						},
+																						&oneOrMoreExpr{
+																							pos: position{line: 29, col: 38, offset: 606},
+																							expr: &charClassMatcher{
+																								pos:        position{line: 566, col: 5, offset: 10811},
+																								val:        "[ \\t\\r\\n]",
+																								chars:      []rune{' ', '\t', '\r', '\n'},
+																								ignoreCase: false,
+																								inverted:   false,
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																		&labeledExpr{
+																			pos:   position{line: 29, col: 44, offset: 612},
+																			label: "path",
+																			expr: &choiceExpr{
+																				pos: position{line: 408, col: 5, offset: 8109},
+																				alternatives: []interface{}{
+																					&actionExpr{
+																						pos: position{line: 408, col: 5, offset: 8109},
+																						run: (*parser).callonProgram261,
+																						expr: &seqExpr{
+																							pos: position{line: 408, col: 7, offset: 8111},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 408, col: 7, offset: 8111},
+																									val:        "\"",
+																									ignoreCase: false,
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 408, col: 11, offset: 8115},
+																									expr: &choiceExpr{
+																										pos: position{line: 416, col: 5, offset: 8297},
+																										alternatives: []interface{}{
+																											&seqExpr{
+																												pos: position{line: 416, col: 5, offset: 8297},
+																												exprs: []interface{}{
+																													&notExpr{
+																														pos: position{line: 416, col: 5, offset: 8297},
+																														expr: &choiceExpr{
+																															pos: position{line: 416, col: 8, offset: 8300},
+																															alternatives: []interface{}{
+																																&charClassMatcher{
+																																	pos:        position{line: 416, col: 8, offset: 8300},
+																																	val:        "[\"\\\\\\n]",
+																																	chars:      []rune{'"', '\\', '\n'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&litMatcher{
+																																	pos:        position{line: 416, col: 27, offset: 8319},
+																																	val:        "${",
+																																	ignoreCase: false,
+																																},
+																															},
+																														},
+																													},
+																													&anyMatcher{
+																														line: 558, col: 5, offset: 10739,
+																													},
+																												},
+																											},
+																											&seqExpr{
+																												pos: position{line: 417, col: 5, offset: 8341},
+																												exprs: []interface{}{
+																													&litMatcher{
+																														pos:        position{line: 417, col: 5, offset: 8341},
+																														val:        "\\",
+																														ignoreCase: false,
+																													},
+																													&choiceExpr{
+																														pos: position{line: 420, col: 5, offset: 8389},
+																														alternatives: []interface{}{
+																															&charClassMatcher{
+																																pos:        position{line: 420, col: 7, offset: 8391},
+																																val:        "[\"\\\\$nrt]",
+																																chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&seqExpr{
+																																pos: position{line: 420, col: 44, offset: 8428},
+																																exprs: []interface{}{
+																																	&litMatcher{
+																																		pos:        position{line: 420, col: 44, offset: 8428},
+																																		val:        "x",
+																																		ignoreCase: false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 426, col: 5, offset: 8562},
+																																		val:        "[0-9a-fA-F]",
+																																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 426, col: 5, offset: 8562},
+																																		val:        "[0-9a-fA-F]",
+																																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																															},
+																															&actionExpr{
+																																pos: position{line: 421, col: 5, offset: 8456},
+																																run: (*parser).callonProgram280,
+																																expr: &choiceExpr{
+																																	pos: position{line: 421, col: 7, offset: 8458},
+																																	alternatives: []interface{}{
+																																		&anyMatcher{
+																																			line: 558, col: 5, offset: 10739,
+																																		},
+																																		&litMatcher{
+																																			pos:        position{line: 572, col: 5, offset: 10857},
+																																			val:        "\n",
+																																			ignoreCase: false,
+																																		},
+																																		&notExpr{
+																																			pos: position{line: 575, col: 5, offset: 10871},
+																																			expr: &anyMatcher{
+																																				line: 575, col: 6, offset: 10872,
+																																			},
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																								&litMatcher{
+																									pos:        position{line: 408, col: 29, offset: 8133},
+																									val:        "\"",
+																									ignoreCase: false,
+																								},
+																							},
+																						},
+																					},
+																					&actionExpr{
+																						pos: position{line: 411, col: 5, offset: 8193},
+																						run: (*parser).callonProgram287,
+																						expr: &seqExpr{
+																							pos: position{line: 411, col: 7, offset: 8195},
+																							exprs: []interface{}{
+																								&litMatcher{
+																									pos:        position{line: 411, col: 7, offset: 8195},
+																									val:        "\"",
+																									ignoreCase: false,
+																								},
+																								&zeroOrMoreExpr{
+																									pos: position{line: 411, col: 11, offset: 8199},
+																									expr: &choiceExpr{
+																										pos: position{line: 416, col: 5, offset: 8297},
+																										alternatives: []interface{}{
+																											&seqExpr{
+																												pos: position{line: 416, col: 5, offset: 8297},
+																												exprs: []interface{}{
+																													&notExpr{
+																														pos: position{line: 416, col: 5, offset: 8297},
+																														expr: &choiceExpr{
+																															pos: position{line: 416, col: 8, offset: 8300},
+																															alternatives: []interface{}{
+																																&charClassMatcher{
+																																	pos:        position{line: 416, col: 8, offset: 8300},
+																																	val:        "[\"\\\\\\n]",
+																																	chars:      []rune{'"', '\\', '\n'},
+																																	ignoreCase: false,
+																																	inverted:   false,
+																																},
+																																&litMatcher{
+																																	pos:        position{line: 416, col: 27, offset: 8319},
+																																	val:        "${",
+																																	ignoreCase: false,
+																																},
+																															},
+																														},
+																													},
+																													&anyMatcher{
+																														line: 558, col: 5, offset: 10739,
+																													},
+																												},
+																											},
+																											&seqExpr{
+																												pos: position{line: 417, col: 5, offset: 8341},
+																												exprs: []interface{}{
+																													&litMatcher{
+																														pos:        position{line: 417, col: 5, offset: 8341},
+																														val:        "\\",
+																														ignoreCase: false,
+																													},
+																													&choiceExpr{
+																														pos: position{line: 420, col: 5, offset: 8389},
+																														alternatives: []interface{}{
+																															&charClassMatcher{
+																																pos:        position{line: 420, col: 7, offset: 8391},
+																																val:        "[\"\\\\$nrt]",
+																																chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+																																ignoreCase: false,
+																																inverted:   false,
+																															},
+																															&seqExpr{
+																																pos: position{line: 420, col: 44, offset: 8428},
+																																exprs: []interface{}{
+																																	&litMatcher{
+																																		pos:        position{line: 420, col: 44, offset: 8428},
+																																		val:        "x",
+																																		ignoreCase: false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 426, col: 5, offset: 8562},
+																																		val:        "[0-9a-fA-F]",
+																																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																	&charClassMatcher{
+																																		pos:        position{line: 426, col: 5, offset: 8562},
+																																		val:        "[0-9a-fA-F]",
+																																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																																		ignoreCase: false,
+																																		inverted:   false,
+																																	},
+																																},
+																															},
+																															&actionExpr{
+																																pos: position{line: 421, col: 5, offset: 8456},
+																																run: (*parser).callonProgram306,
+																																expr: &choiceExpr{
+																																	pos: position{line: 421, col: 7, offset: 8458},
+																																	alternatives: []interface{}{
+																																		&anyMatcher{
+																																			line: 558, col: 5, offset: 10739,
+																																		},
+																																		&litMatcher{
+																																			pos:        position{line: 572, col: 5, offset: 10857},
+																																			val:        "\n",
+																																			ignoreCase: false,
+																																		},
+																																		&notExpr{
+																																			pos: position{line: 575, col: 5, offset: 10871},
+																																			expr: &anyMatcher{
+																																				line: 575, col: 6, offset: 10872,
+																																			},
+																																		},
+																																	},
+																																},
+																															},
+																														},
+																													},
+																												},
+																											},
+																										},
+																									},
+																								},
+																								&choiceExpr{
+																									pos: position{line: 411, col: 31, offset: 8219},
+																									alternatives: []interface{}{
+																										&litMatcher{
+																											pos:        position{line: 572, col: 5, offset: 10857},
+																											val:        "\n",
+																											ignoreCase: false,
+																										},
+																										&notExpr{
+																											pos: position{line: 575, col: 5, offset: 10871},
+																											expr: &anyMatcher{
+																												line: 575, col: 6, offset: 10872,
+																											},
+																										},
+																									},
+																								},
+																							},
+																						},
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -434,7 +1749,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -444,37 +1759,69 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 49, col: 12, offset: 822},
+							pos:   position{line: 14, col: 58, offset: 238},
 							label: "body",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 14, col: 63, offset: 243},
+								expr: &ruleRefExpr{
+									pos:  position{line: 14, col: 63, offset: 243},
+									name: "SourceElements",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SourceElements",
+			pos:  position{line: 33, col: 1, offset: 689},
+			expr: &actionExpr{
+				pos: position{line: 34, col: 5, offset: 708},
+				run: (*parser).callonSourceElements1,
+				expr: &seqExpr{
+					pos: position{line: 34, col: 5, offset: 708},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 34, col: 5, offset: 708},
+							label: "head",
+							expr: &ruleRefExpr{
+								pos:  position{line: 34, col: 10, offset: 713},
+								name: "SourceElement",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 34, col: 24, offset: 727},
+							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 49, col: 17, offset: 827},
+								pos: position{line: 34, col: 29, offset: 732},
 								expr: &seqExpr{
-									pos: position{line: 49, col: 19, offset: 829},
+									pos: position{line: 34, col: 30, offset: 733},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -482,7 +1829,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -492,33 +1839,33 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 49, col: 22, offset: 832},
-											name: "Statement",
+											pos:  position{line: 34, col: 33, offset: 736},
+											name: "SourceElement",
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -526,7 +1873,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -539,30 +1886,166 @@ var g = &grammar{
 								},
 							},
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "SourceElement",
+			pos:  position{line: 38, col: 1, offset: 798},
+			expr: &choiceExpr{
+				pos: position{line: 39, col: 5, offset: 816},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 39, col: 5, offset: 816},
+						name: "OptionStatement",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 40, col: 5, offset: 836},
+						name: "Statement",
+					},
+				},
+			},
+		},
+		{
+			name: "Statement",
+			pos:  position{line: 42, col: 1, offset: 847},
+			expr: &choiceExpr{
+				pos: position{line: 43, col: 5, offset: 861},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 43, col: 5, offset: 861},
+						name: "VariableStatement",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 44, col: 5, offset: 883},
+						name: "ReturnStatement",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 45, col: 5, offset: 903},
+						name: "ExpressionStatement",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 46, col: 5, offset: 927},
+						name: "BlockStatement",
+					},
+				},
+			},
+		},
+		{
+			name: "OptionStatement",
+			pos:  position{line: 49, col: 1, offset: 944},
+			expr: &actionExpr{
+				pos: position{line: 50, col: 5, offset: 964},
+				run: (*parser).callonOptionStatement1,
+				expr: &seqExpr{
+					pos: position{line: 50, col: 5, offset: 964},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 534, col: 5, offset: 10456},
+							val:        "option",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 534, col: 14, offset: 10465},
+							expr: &charClassMatcher{
+								pos:        position{line: 534, col: 15, offset: 10466},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 50, col: 19, offset: 978},
+							expr: &charClassMatcher{
+								pos:        position{line: 566, col: 5, offset: 10811},
+								val:        "[ \\t\\r\\n]",
+								chars:      []rune{' ', '\t', '\r', '\n'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 50, col: 23, offset: 982},
+							label: "declaration",
+							expr: &ruleRefExpr{
+								pos:  position{line: 50, col: 35, offset: 994},
+								name: "VariableDeclaration",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "VariableStatement",
+			pos:  position{line: 54, col: 1, offset: 1075},
+			expr: &actionExpr{
+				pos: position{line: 55, col: 5, offset: 1097},
+				run: (*parser).callonVariableStatement1,
+				expr: &labeledExpr{
+					pos:   position{line: 55, col: 5, offset: 1097},
+					label: "declaration",
+					expr: &ruleRefExpr{
+						pos:  position{line: 55, col: 17, offset: 1109},
+						name: "VariableDeclaration",
+					},
+				},
+			},
+		},
+		{
+			name: "ReturnStatement",
+			pos:  position{line: 59, col: 1, offset: 1188},
+			expr: &actionExpr{
+				pos: position{line: 60, col: 5, offset: 1208},
+				run: (*parser).callonReturnStatement1,
+				expr: &seqExpr{
+					pos: position{line: 60, col: 5, offset: 1208},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 537, col: 5, offset: 10495},
+							val:        "return",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 537, col: 14, offset: 10504},
+							expr: &charClassMatcher{
+								pos:        position{line: 537, col: 15, offset: 10505},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -570,7 +2053,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -579,81 +2062,72 @@ var g = &grammar{
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 49, col: 41, offset: 851},
-							val:        "}",
-							ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 60, col: 22, offset: 1225},
+							label: "argument",
+							expr: &ruleRefExpr{
+								pos:  position{line: 60, col: 31, offset: 1234},
+								name: "Expr",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "VariableDeclaration",
-			pos:  position{line: 53, col: 1, offset: 908},
+			name: "ExpressionStatement",
+			pos:  position{line: 64, col: 1, offset: 1297},
 			expr: &actionExpr{
-				pos: position{line: 54, col: 5, offset: 932},
-				run: (*parser).callonVariableDeclaration1,
+				pos: position{line: 65, col: 5, offset: 1321},
+				run: (*parser).callonExpressionStatement1,
+				expr: &labeledExpr{
+					pos:   position{line: 65, col: 5, offset: 1321},
+					label: "expr",
+					expr: &ruleRefExpr{
+						pos:  position{line: 65, col: 10, offset: 1326},
+						name: "Expr",
+					},
+				},
+			},
+		},
+		{
+			name: "BlockStatement",
+			pos:  position{line: 69, col: 1, offset: 1385},
+			expr: &actionExpr{
+				pos: position{line: 70, col: 5, offset: 1404},
+				run: (*parser).callonBlockStatement1,
 				expr: &seqExpr{
-					pos: position{line: 54, col: 5, offset: 932},
+					pos: position{line: 70, col: 5, offset: 1404},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 54, col: 5, offset: 932},
-							label: "id",
-							expr: &actionExpr{
-								pos: position{line: 460, col: 5, offset: 8638},
-								run: (*parser).callonVariableDeclaration4,
-								expr: &seqExpr{
-									pos: position{line: 460, col: 5, offset: 8638},
-									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 460, col: 5, offset: 8638},
-											val:        "[_\\pL]",
-											chars:      []rune{'_'},
-											classes:    []*unicode.RangeTable{rangeTable("L")},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 460, col: 11, offset: 8644},
-											expr: &charClassMatcher{
-												pos:        position{line: 460, col: 11, offset: 8644},
-												val:        "[_0-9\\pL]",
-												chars:      []rune{'_'},
-												ranges:     []rune{'0', '9'},
-												classes:    []*unicode.RangeTable{rangeTable("L")},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-							},
+						&litMatcher{
+							pos:        position{line: 70, col: 5, offset: 1404},
+							val:        "{",
+							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -661,7 +2135,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -670,35 +2144,126 @@ var g = &grammar{
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 54, col: 22, offset: 949},
-							val:        "=",
-							ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 70, col: 12, offset: 1411},
+							label: "body",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 70, col: 17, offset: 1416},
+								expr: &seqExpr{
+									pos: position{line: 70, col: 19, offset: 1418},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 560, col: 5, offset: 10748},
+											expr: &choiceExpr{
+												pos: position{line: 560, col: 7, offset: 10750},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 566, col: 5, offset: 10811},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 563, col: 5, offset: 10785},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 563, col: 5, offset: 10785},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 563, col: 10, offset: 10790},
+																expr: &charClassMatcher{
+																	pos:        position{line: 563, col: 10, offset: 10790},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 572, col: 5, offset: 10857},
+																val:        "\n",
+																ignoreCase: false,
+															},
+														},
+													},
+												},
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 70, col: 22, offset: 1421},
+											name: "Statement",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 560, col: 5, offset: 10748},
+											expr: &choiceExpr{
+												pos: position{line: 560, col: 7, offset: 10750},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 566, col: 5, offset: 10811},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 563, col: 5, offset: 10785},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 563, col: 5, offset: 10785},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 563, col: 10, offset: 10790},
+																expr: &charClassMatcher{
+																	pos:        position{line: 563, col: 10, offset: 10790},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 572, col: 5, offset: 10857},
+																val:        "\n",
+																ignoreCase: false,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -706,7 +2271,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -715,727 +2280,711 @@ var g = &grammar{
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 54, col: 29, offset: 956},
-							label: "init",
-							expr: &ruleRefExpr{
-								pos:  position{line: 54, col: 34, offset: 961},
-								name: "Expr",
-							},
+						&litMatcher{
+							pos:        position{line: 70, col: 41, offset: 1440},
+							val:        "}",
+							ignoreCase: false,
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "MemberExpressions",
-			pos:  position{line: 59, col: 1, offset: 1022},
+			name: "VariableDeclaration",
+			pos:  position{line: 74, col: 1, offset: 1497},
 			expr: &actionExpr{
-				pos: position{line: 60, col: 5, offset: 1044},
-				run: (*parser).callonMemberExpressions1,
+				pos: position{line: 75, col: 5, offset: 1521},
+				run: (*parser).callonVariableDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 60, col: 5, offset: 1044},
+					pos: position{line: 75, col: 5, offset: 1521},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 60, col: 5, offset: 1044},
-							label: "head",
+							pos:   position{line: 75, col: 5, offset: 1521},
+							label: "id",
 							expr: &actionExpr{
-								pos: position{line: 460, col: 5, offset: 8638},
-								run: (*parser).callonMemberExpressions4,
+								pos: position{line: 510, col: 5, offset: 10106},
+								run: (*parser).callonVariableDeclaration4,
 								expr: &seqExpr{
-									pos: position{line: 460, col: 5, offset: 8638},
+									pos: position{line: 510, col: 5, offset: 10106},
 									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 460, col: 5, offset: 8638},
-											val:        "[_\\pL]",
-											chars:      []rune{'_'},
-											classes:    []*unicode.RangeTable{rangeTable("L")},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 460, col: 11, offset: 8644},
-											expr: &charClassMatcher{
-												pos:        position{line: 460, col: 11, offset: 8644},
-												val:        "[_0-9\\pL]",
-												chars:      []rune{'_'},
-												ranges:     []rune{'0', '9'},
-												classes:    []*unicode.RangeTable{rangeTable("L")},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 61, col: 5, offset: 1091},
-							label: "tail",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 61, col: 10, offset: 1096},
-								expr: &actionExpr{
-									pos: position{line: 62, col: 10, offset: 1107},
-									run: (*parser).callonMemberExpressions11,
-									expr: &seqExpr{
-										pos: position{line: 62, col: 10, offset: 1107},
-										exprs: []interface{}{
-											&zeroOrMoreExpr{
-												pos: position{line: 468, col: 5, offset: 8728},
-												expr: &choiceExpr{
-													pos: position{line: 468, col: 7, offset: 8730},
-													alternatives: []interface{}{
-														&charClassMatcher{
-															pos:        position{line: 474, col: 5, offset: 8791},
-															val:        "[ \\t\\r\\n]",
-															chars:      []rune{' ', '\t', '\r', '\n'},
-															ignoreCase: false,
-															inverted:   false,
+										&notExpr{
+											pos: position{line: 510, col: 5, offset: 10106},
+											expr: &choiceExpr{
+												pos: position{line: 516, col: 5, offset: 10193},
+												alternatives: []interface{}{
+													&seqExpr{
+														pos: position{line: 528, col: 5, offset: 10377},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 528, col: 5, offset: 10377},
+																val:        "package",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 528, col: 15, offset: 10387},
+																expr: &charClassMatcher{
+																	pos:        position{line: 528, col: 16, offset: 10388},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
-														&seqExpr{
-															pos: position{line: 471, col: 5, offset: 8765},
-															exprs: []interface{}{
-																&litMatcher{
-																	pos:        position{line: 471, col: 5, offset: 8765},
-																	val:        "//",
+													},
+													&seqExpr{
+														pos: position{line: 531, col: 5, offset: 10417},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 531, col: 5, offset: 10417},
+																val:        "import",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 531, col: 14, offset: 10426},
+																expr: &charClassMatcher{
+																	pos:        position{line: 531, col: 15, offset: 10427},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
 																	ignoreCase: false,
+																	inverted:   false,
 																},
-																&zeroOrMoreExpr{
-																	pos: position{line: 471, col: 10, offset: 8770},
-																	expr: &charClassMatcher{
-																		pos:        position{line: 471, col: 10, offset: 8770},
-																		val:        "[^\\r\\n]",
-																		chars:      []rune{'\r', '\n'},
-																		ignoreCase: false,
-																		inverted:   true,
-																	},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 534, col: 5, offset: 10456},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 534, col: 5, offset: 10456},
+																val:        "option",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 534, col: 14, offset: 10465},
+																expr: &charClassMatcher{
+																	pos:        position{line: 534, col: 15, offset: 10466},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
 																},
-																&litMatcher{
-																	pos:        position{line: 480, col: 5, offset: 8837},
-																	val:        "\n",
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 537, col: 5, offset: 10495},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 537, col: 5, offset: 10495},
+																val:        "return",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 537, col: 14, offset: 10504},
+																expr: &charClassMatcher{
+																	pos:        position{line: 537, col: 15, offset: 10505},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
 																	ignoreCase: false,
+																	inverted:   false,
 																},
 															},
 														},
 													},
-												},
-											},
-											&labeledExpr{
-												pos:   position{line: 62, col: 13, offset: 1110},
-												label: "property",
-												expr: &ruleRefExpr{
-													pos:  position{line: 62, col: 22, offset: 1119},
-													name: "MemberExpressionProperty",
-												},
-											},
-										},
-									},
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "MemberExpressionProperty",
-			pos:  position{line: 70, col: 1, offset: 1259},
-			expr: &choiceExpr{
-				pos: position{line: 71, col: 5, offset: 1288},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 71, col: 5, offset: 1288},
-						run: (*parser).callonMemberExpressionProperty2,
-						expr: &seqExpr{
-							pos: position{line: 71, col: 5, offset: 1288},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 71, col: 5, offset: 1288},
-									val:        ".",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 540, col: 5, offset: 10530},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 540, col: 5, offset: 10530},
+																val:        "if",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 540, col: 10, offset: 10535},
+																expr: &charClassMatcher{
+																	pos:        position{line: 540, col: 11, offset: 10536},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
+													&seqExpr{
+														pos: position{line: 543, col: 5, offset: 10563},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 543, col: 5, offset: 10563},
+																val:        "then",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 543, col: 12, offset: 10570},
+																expr: &charClassMatcher{
+																	pos:        position{line: 543, col: 13, offset: 10571},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 546, col: 5, offset: 10598},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 546, col: 5, offset: 10598},
+																val:        "else",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 546, col: 12, offset: 10605},
+																expr: &charClassMatcher{
+																	pos:        position{line: 546, col: 13, offset: 10606},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 549, col: 5, offset: 10631},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 549, col: 5, offset: 10631},
+																val:        "in",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 549, col: 10, offset: 10636},
+																expr: &charClassMatcher{
+																	pos:        position{line: 549, col: 11, offset: 10637},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 552, col: 5, offset: 10665},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 552, col: 5, offset: 10665},
+																val:        "empty",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 552, col: 13, offset: 10673},
+																expr: &charClassMatcher{
+																	pos:        position{line: 552, col: 14, offset: 10674},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 555, col: 5, offset: 10703},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 555, col: 5, offset: 10703},
+																val:        "exists",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 555, col: 14, offset: 10712},
+																expr: &charClassMatcher{
+																	pos:        position{line: 555, col: 15, offset: 10713},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
 												},
 											},
 										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 71, col: 12, offset: 1295},
-									label: "property",
-									expr: &actionExpr{
-										pos: position{line: 460, col: 5, offset: 8638},
-										run: (*parser).callonMemberExpressionProperty14,
-										expr: &seqExpr{
-											pos: position{line: 460, col: 5, offset: 8638},
-											exprs: []interface{}{
-												&charClassMatcher{
-													pos:        position{line: 460, col: 5, offset: 8638},
-													val:        "[_\\pL]",
-													chars:      []rune{'_'},
-													classes:    []*unicode.RangeTable{rangeTable("L")},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 460, col: 11, offset: 8644},
-													expr: &charClassMatcher{
-														pos:        position{line: 460, col: 11, offset: 8644},
-														val:        "[_0-9\\pL]",
-														chars:      []rune{'_'},
-														ranges:     []rune{'0', '9'},
-														classes:    []*unicode.RangeTable{rangeTable("L")},
-														ignoreCase: false,
-														inverted:   false,
-													},
-												},
+										&charClassMatcher{
+											pos:        position{line: 510, col: 14, offset: 10115},
+											val:        "[_\\pL]",
+											chars:      []rune{'_'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 510, col: 20, offset: 10121},
+											expr: &charClassMatcher{
+												pos:        position{line: 510, col: 20, offset: 10121},
+												val:        "[_0-9\\pL]",
+												chars:      []rune{'_'},
+												ranges:     []rune{'0', '9'},
+												classes:    []*unicode.RangeTable{rangeTable("L")},
+												ignoreCase: false,
+												inverted:   false,
 											},
 										},
 									},
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 74, col: 7, offset: 1356},
-						run: (*parser).callonMemberExpressionProperty19,
-						expr: &seqExpr{
-							pos: position{line: 74, col: 7, offset: 1356},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 74, col: 7, offset: 1356},
-									val:        "[",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
 												ignoreCase: false,
-												inverted:   false,
 											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
-														},
-													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
-													},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
 												},
 											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
 										},
 									},
 								},
-								&labeledExpr{
-									pos:   position{line: 74, col: 14, offset: 1363},
-									label: "property",
-									expr: &ruleRefExpr{
-										pos:  position{line: 74, col: 23, offset: 1372},
-										name: "Primary",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 75, col: 22, offset: 1538},
+							val:        "=",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
 									},
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
 												ignoreCase: false,
-												inverted:   false,
 											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
-														},
-													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
-													},
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 74, col: 34, offset: 1383},
-									val:        "]",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
-														},
-													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
-													},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
 												},
 											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
 										},
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 75, col: 29, offset: 1545},
+							label: "init",
+							expr: &ruleRefExpr{
+								pos:  position{line: 75, col: 34, offset: 1550},
+								name: "Expr",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "CallExpression",
-			pos:  position{line: 78, col: 1, offset: 1426},
+			name: "MemberExpressions",
+			pos:  position{line: 80, col: 1, offset: 1611},
 			expr: &actionExpr{
-				pos: position{line: 79, col: 5, offset: 1445},
-				run: (*parser).callonCallExpression1,
+				pos: position{line: 81, col: 5, offset: 1633},
+				run: (*parser).callonMemberExpressions1,
 				expr: &seqExpr{
-					pos: position{line: 79, col: 5, offset: 1445},
+					pos: position{line: 81, col: 5, offset: 1633},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 79, col: 5, offset: 1445},
+							pos:   position{line: 81, col: 5, offset: 1633},
 							label: "head",
 							expr: &actionExpr{
-								pos: position{line: 80, col: 7, offset: 1458},
-								run: (*parser).callonCallExpression4,
+								pos: position{line: 510, col: 5, offset: 10106},
+								run: (*parser).callonMemberExpressions4,
 								expr: &seqExpr{
-									pos: position{line: 80, col: 7, offset: 1458},
+									pos: position{line: 510, col: 5, offset: 10106},
 									exprs: []interface{}{
-										&labeledExpr{
-											pos:   position{line: 80, col: 7, offset: 1458},
-											label: "callee",
-											expr: &ruleRefExpr{
-												pos:  position{line: 80, col: 14, offset: 1465},
-												name: "MemberExpressions",
-											},
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+										&notExpr{
+											pos: position{line: 510, col: 5, offset: 10106},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 516, col: 5, offset: 10193},
 												alternatives: []interface{}{
-													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
-														val:        "[ \\t\\r\\n]",
-														chars:      []rune{' ', '\t', '\r', '\n'},
-														ignoreCase: false,
-														inverted:   false,
+													&seqExpr{
+														pos: position{line: 528, col: 5, offset: 10377},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 528, col: 5, offset: 10377},
+																val:        "package",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 528, col: 15, offset: 10387},
+																expr: &charClassMatcher{
+																	pos:        position{line: 528, col: 16, offset: 10388},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 531, col: 5, offset: 10417},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
-																val:        "//",
+																pos:        position{line: 531, col: 5, offset: 10417},
+																val:        "import",
 																ignoreCase: false,
 															},
-															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+															&notExpr{
+																pos: position{line: 531, col: 14, offset: 10426},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
-																	val:        "[^\\r\\n]",
-																	chars:      []rune{'\r', '\n'},
+																	pos:        position{line: 531, col: 15, offset: 10427},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
 																	ignoreCase: false,
-																	inverted:   true,
+																	inverted:   false,
 																},
 															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 534, col: 5, offset: 10456},
+														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
-																val:        "\n",
+																pos:        position{line: 534, col: 5, offset: 10456},
+																val:        "option",
 																ignoreCase: false,
 															},
+															&notExpr{
+																pos: position{line: 534, col: 14, offset: 10465},
+																expr: &charClassMatcher{
+																	pos:        position{line: 534, col: 15, offset: 10466},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
-												},
-											},
-										},
-										&labeledExpr{
-											pos:   position{line: 80, col: 35, offset: 1486},
-											label: "args",
-											expr: &ruleRefExpr{
-												pos:  position{line: 80, col: 40, offset: 1491},
-												name: "Arguments",
-											},
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 84, col: 5, offset: 1574},
-							label: "tail",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 84, col: 10, offset: 1579},
-								expr: &choiceExpr{
-									pos: position{line: 85, col: 9, offset: 1589},
-									alternatives: []interface{}{
-										&actionExpr{
-											pos: position{line: 85, col: 9, offset: 1589},
-											run: (*parser).callonCallExpression21,
-											expr: &seqExpr{
-												pos: position{line: 85, col: 9, offset: 1589},
-												exprs: []interface{}{
-													&zeroOrMoreExpr{
-														pos: position{line: 468, col: 5, offset: 8728},
-														expr: &choiceExpr{
-															pos: position{line: 468, col: 7, offset: 8730},
-															alternatives: []interface{}{
-																&charClassMatcher{
-																	pos:        position{line: 474, col: 5, offset: 8791},
-																	val:        "[ \\t\\r\\n]",
-																	chars:      []rune{' ', '\t', '\r', '\n'},
+													&seqExpr{
+														pos: position{line: 537, col: 5, offset: 10495},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 537, col: 5, offset: 10495},
+																val:        "return",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 537, col: 14, offset: 10504},
+																expr: &charClassMatcher{
+																	pos:        position{line: 537, col: 15, offset: 10505},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
 																	ignoreCase: false,
 																	inverted:   false,
 																},
-																&seqExpr{
-																	pos: position{line: 471, col: 5, offset: 8765},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 471, col: 5, offset: 8765},
-																			val:        "//",
-																			ignoreCase: false,
-																		},
-																		&zeroOrMoreExpr{
-																			pos: position{line: 471, col: 10, offset: 8770},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 471, col: 10, offset: 8770},
-																				val:        "[^\\r\\n]",
-																				chars:      []rune{'\r', '\n'},
-																				ignoreCase: false,
-																				inverted:   true,
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
-																			val:        "\n",
-																			ignoreCase: false,
-																		},
-																	},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 540, col: 5, offset: 10530},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 540, col: 5, offset: 10530},
+																val:        "if",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 540, col: 10, offset: 10535},
+																expr: &charClassMatcher{
+																	pos:        position{line: 540, col: 11, offset: 10536},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
 																},
 															},
 														},
 													},
-													&labeledExpr{
-														pos:   position{line: 85, col: 12, offset: 1592},
-														label: "args",
-														expr: &ruleRefExpr{
-															pos:  position{line: 85, col: 17, offset: 1597},
-															name: "Arguments",
+													&seqExpr{
+														pos: position{line: 543, col: 5, offset: 10563},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 543, col: 5, offset: 10563},
+																val:        "then",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 543, col: 12, offset: 10570},
+																expr: &charClassMatcher{
+																	pos:        position{line: 543, col: 13, offset: 10571},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
-												},
-											},
-										},
-										&actionExpr{
-											pos: position{line: 88, col: 10, offset: 1680},
-											run: (*parser).callonCallExpression33,
-											expr: &seqExpr{
-												pos: position{line: 88, col: 10, offset: 1680},
-												exprs: []interface{}{
-													&zeroOrMoreExpr{
-														pos: position{line: 468, col: 5, offset: 8728},
-														expr: &choiceExpr{
-															pos: position{line: 468, col: 7, offset: 8730},
-															alternatives: []interface{}{
-																&charClassMatcher{
-																	pos:        position{line: 474, col: 5, offset: 8791},
-																	val:        "[ \\t\\r\\n]",
-																	chars:      []rune{' ', '\t', '\r', '\n'},
+													&seqExpr{
+														pos: position{line: 546, col: 5, offset: 10598},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 546, col: 5, offset: 10598},
+																val:        "else",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 546, col: 12, offset: 10605},
+																expr: &charClassMatcher{
+																	pos:        position{line: 546, col: 13, offset: 10606},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
 																	ignoreCase: false,
 																	inverted:   false,
 																},
-																&seqExpr{
-																	pos: position{line: 471, col: 5, offset: 8765},
-																	exprs: []interface{}{
-																		&litMatcher{
-																			pos:        position{line: 471, col: 5, offset: 8765},
-																			val:        "//",
-																			ignoreCase: false,
-																		},
-																		&zeroOrMoreExpr{
-																			pos: position{line: 471, col: 10, offset: 8770},
-																			expr: &charClassMatcher{
-																				pos:        position{line: 471, col: 10, offset: 8770},
-																				val:        "[^\\r\\n]",
-																				chars:      []rune{'\r', '\n'},
-																				ignoreCase: false,
-																				inverted:   true,
-																			},
-																		},
-																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
-																			val:        "\n",
-																			ignoreCase: false,
-																		},
-																	},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 549, col: 5, offset: 10631},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 549, col: 5, offset: 10631},
+																val:        "in",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 549, col: 10, offset: 10636},
+																expr: &charClassMatcher{
+																	pos:        position{line: 549, col: 11, offset: 10637},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
 																},
 															},
 														},
 													},
-													&labeledExpr{
-														pos:   position{line: 88, col: 13, offset: 1683},
-														label: "property",
-														expr: &ruleRefExpr{
-															pos:  position{line: 88, col: 22, offset: 1692},
-															name: "MemberExpressionProperty",
+													&seqExpr{
+														pos: position{line: 552, col: 5, offset: 10665},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 552, col: 5, offset: 10665},
+																val:        "empty",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 552, col: 13, offset: 10673},
+																expr: &charClassMatcher{
+																	pos:        position{line: 552, col: 14, offset: 10674},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 555, col: 5, offset: 10703},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 555, col: 5, offset: 10703},
+																val:        "exists",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 555, col: 14, offset: 10712},
+																expr: &charClassMatcher{
+																	pos:        position{line: 555, col: 15, offset: 10713},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
 												},
 											},
 										},
+										&charClassMatcher{
+											pos:        position{line: 510, col: 14, offset: 10115},
+											val:        "[_\\pL]",
+											chars:      []rune{'_'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 510, col: 20, offset: 10121},
+											expr: &charClassMatcher{
+												pos:        position{line: 510, col: 20, offset: 10121},
+												val:        "[_0-9\\pL]",
+												chars:      []rune{'_'},
+												ranges:     []rune{'0', '9'},
+												classes:    []*unicode.RangeTable{rangeTable("L")},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "PipeExpression",
-			pos:  position{line: 96, col: 1, offset: 1857},
-			expr: &actionExpr{
-				pos: position{line: 97, col: 5, offset: 1876},
-				run: (*parser).callonPipeExpression1,
-				expr: &seqExpr{
-					pos: position{line: 97, col: 5, offset: 1876},
-					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 97, col: 5, offset: 1876},
-							label: "head",
-							expr: &ruleRefExpr{
-								pos:  position{line: 97, col: 10, offset: 1881},
-								name: "PipeExpressionHead",
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+							pos:   position{line: 82, col: 5, offset: 1680},
+							label: "tail",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 82, col: 10, offset: 1685},
+								expr: &actionExpr{
+									pos: position{line: 83, col: 10, offset: 1696},
+									run: (*parser).callonMemberExpressions53,
+									expr: &seqExpr{
+										pos: position{line: 83, col: 10, offset: 1696},
 										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
-												ignoreCase: false,
-											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 97, col: 32, offset: 1903},
-							label: "tail",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 97, col: 37, offset: 1908},
-								expr: &seqExpr{
-									pos: position{line: 97, col: 38, offset: 1909},
-									exprs: []interface{}{
-										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
-											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
-												alternatives: []interface{}{
-													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
-														val:        "[ \\t\\r\\n]",
-														chars:      []rune{' ', '\t', '\r', '\n'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
-																val:        "//",
-																ignoreCase: false,
-															},
-															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
-																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
-																	val:        "[^\\r\\n]",
-																	chars:      []rune{'\r', '\n'},
+												pos: position{line: 560, col: 5, offset: 10748},
+												expr: &choiceExpr{
+													pos: position{line: 560, col: 7, offset: 10750},
+													alternatives: []interface{}{
+														&charClassMatcher{
+															pos:        position{line: 566, col: 5, offset: 10811},
+															val:        "[ \\t\\r\\n]",
+															chars:      []rune{' ', '\t', '\r', '\n'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&seqExpr{
+															pos: position{line: 563, col: 5, offset: 10785},
+															exprs: []interface{}{
+																&litMatcher{
+																	pos:        position{line: 563, col: 5, offset: 10785},
+																	val:        "//",
 																	ignoreCase: false,
-																	inverted:   true,
 																},
-															},
-															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
-																val:        "\n",
-																ignoreCase: false,
-															},
-														},
-													},
-												},
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 97, col: 41, offset: 1912},
-											name: "PipeExpressionPipe",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
-											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
-												alternatives: []interface{}{
-													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
-														val:        "[ \\t\\r\\n]",
-														chars:      []rune{' ', '\t', '\r', '\n'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
-														exprs: []interface{}{
-															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
-																val:        "//",
-																ignoreCase: false,
-															},
-															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
-																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
-																	val:        "[^\\r\\n]",
-																	chars:      []rune{'\r', '\n'},
+																&zeroOrMoreExpr{
+																	pos: position{line: 563, col: 10, offset: 10790},
+																	expr: &charClassMatcher{
+																		pos:        position{line: 563, col: 10, offset: 10790},
+																		val:        "[^\\r\\n]",
+																		chars:      []rune{'\r', '\n'},
+																		ignoreCase: false,
+																		inverted:   true,
+																	},
+																},
+																&litMatcher{
+																	pos:        position{line: 572, col: 5, offset: 10857},
+																	val:        "\n",
 																	ignoreCase: false,
-																	inverted:   true,
 																},
 															},
-															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
-																val:        "\n",
-																ignoreCase: false,
-															},
 														},
 													},
 												},
 											},
+											&labeledExpr{
+												pos:   position{line: 83, col: 13, offset: 1699},
+												label: "property",
+												expr: &ruleRefExpr{
+													pos:  position{line: 83, col: 22, offset: 1708},
+													name: "MemberExpressionProperty",
+												},
+											},
 										},
 									},
 								},
@@ -1446,195 +2995,319 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "PipeExpressionHead",
-			pos:  position{line: 101, col: 1, offset: 1995},
+			name: "MemberExpressionProperty",
+			pos:  position{line: 91, col: 1, offset: 1848},
 			expr: &choiceExpr{
-				pos: position{line: 102, col: 5, offset: 2018},
+				pos: position{line: 92, col: 5, offset: 1877},
 				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 102, col: 5, offset: 2018},
-						name: "CallExpression",
-					},
 					&actionExpr{
-						pos: position{line: 378, col: 5, offset: 7123},
-						run: (*parser).callonPipeExpressionHead3,
+						pos: position{line: 92, col: 5, offset: 1877},
+						run: (*parser).callonMemberExpressionProperty2,
 						expr: &seqExpr{
-							pos: position{line: 378, col: 7, offset: 7125},
+							pos: position{line: 92, col: 5, offset: 1877},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 378, col: 7, offset: 7125},
-									val:        "\"",
+									pos:        position{line: 92, col: 5, offset: 1877},
+									val:        ".",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 378, col: 11, offset: 7129},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
-											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
-												exprs: []interface{}{
-													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
-														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
-															val:        "[\"\\\\\\n]",
-															chars:      []rune{'"', '\\', '\n'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
-													},
-												},
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
-														val:        "\\",
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
 														ignoreCase: false,
 													},
-													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
-														alternatives: []interface{}{
-															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
-																val:        "\"",
-																ignoreCase: false,
-															},
-															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
-																run: (*parser).callonPipeExpressionHead16,
-																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
-																	alternatives: []interface{}{
-																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
-																			val:        "\n",
-																			ignoreCase: false,
-																		},
-																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
-																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 378, col: 29, offset: 7147},
-									val:        "\"",
-									ignoreCase: false,
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 381, col: 5, offset: 7207},
-						run: (*parser).callonPipeExpressionHead23,
-						expr: &seqExpr{
-							pos: position{line: 381, col: 7, offset: 7209},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 381, col: 7, offset: 7209},
-									val:        "\"",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 381, col: 11, offset: 7213},
-									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
-										alternatives: []interface{}{
-											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
-												exprs: []interface{}{
-													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
-															val:        "[\"\\\\\\n]",
-															chars:      []rune{'"', '\\', '\n'},
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
-															inverted:   false,
+															inverted:   true,
 														},
 													},
-													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
-													},
-												},
-											},
-											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
-												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
-														val:        "\\",
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
 														ignoreCase: false,
 													},
-													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
-														alternatives: []interface{}{
-															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
-																val:        "\"",
-																ignoreCase: false,
-															},
-															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
-																run: (*parser).callonPipeExpressionHead36,
-																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
-																	alternatives: []interface{}{
-																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
-																		},
-																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
-																			val:        "\n",
-																			ignoreCase: false,
-																		},
-																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
-																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
-																			},
-																		},
-																	},
-																},
-															},
-														},
-													},
 												},
 											},
 										},
 									},
 								},
-								&choiceExpr{
-									pos: position{line: 381, col: 31, offset: 7233},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 480, col: 5, offset: 8837},
-											val:        "\n",
-											ignoreCase: false,
-										},
-										&notExpr{
-											pos: position{line: 483, col: 5, offset: 8851},
-											expr: &anyMatcher{
-												line: 483, col: 6, offset: 8852,
+								&labeledExpr{
+									pos:   position{line: 92, col: 12, offset: 1884},
+									label: "property",
+									expr: &actionExpr{
+										pos: position{line: 510, col: 5, offset: 10106},
+										run: (*parser).callonMemberExpressionProperty14,
+										expr: &seqExpr{
+											pos: position{line: 510, col: 5, offset: 10106},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 510, col: 5, offset: 10106},
+													expr: &choiceExpr{
+														pos: position{line: 516, col: 5, offset: 10193},
+														alternatives: []interface{}{
+															&seqExpr{
+																pos: position{line: 528, col: 5, offset: 10377},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 528, col: 5, offset: 10377},
+																		val:        "package",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 528, col: 15, offset: 10387},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 528, col: 16, offset: 10388},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 531, col: 5, offset: 10417},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 531, col: 5, offset: 10417},
+																		val:        "import",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 531, col: 14, offset: 10426},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 531, col: 15, offset: 10427},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 534, col: 5, offset: 10456},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 534, col: 5, offset: 10456},
+																		val:        "option",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 534, col: 14, offset: 10465},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 534, col: 15, offset: 10466},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 537, col: 5, offset: 10495},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 537, col: 5, offset: 10495},
+																		val:        "return",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 537, col: 14, offset: 10504},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 537, col: 15, offset: 10505},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 540, col: 5, offset: 10530},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 540, col: 5, offset: 10530},
+																		val:        "if",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 540, col: 10, offset: 10535},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 540, col: 11, offset: 10536},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 543, col: 5, offset: 10563},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 543, col: 5, offset: 10563},
+																		val:        "then",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 543, col: 12, offset: 10570},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 543, col: 13, offset: 10571},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 546, col: 5, offset: 10598},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 546, col: 5, offset: 10598},
+																		val:        "else",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 546, col: 12, offset: 10605},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 546, col: 13, offset: 10606},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 549, col: 5, offset: 10631},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 549, col: 5, offset: 10631},
+																		val:        "in",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 549, col: 10, offset: 10636},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 549, col: 11, offset: 10637},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 552, col: 5, offset: 10665},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 552, col: 5, offset: 10665},
+																		val:        "empty",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 552, col: 13, offset: 10673},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 552, col: 14, offset: 10674},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 555, col: 5, offset: 10703},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 555, col: 5, offset: 10703},
+																		val:        "exists",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 555, col: 14, offset: 10712},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 555, col: 15, offset: 10713},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												&charClassMatcher{
+													pos:        position{line: 510, col: 14, offset: 10115},
+													val:        "[_\\pL]",
+													chars:      []rune{'_'},
+													classes:    []*unicode.RangeTable{rangeTable("L")},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 510, col: 20, offset: 10121},
+													expr: &charClassMatcher{
+														pos:        position{line: 510, col: 20, offset: 10121},
+														val:        "[_0-9\\pL]",
+														chars:      []rune{'_'},
+														ranges:     []rune{'0', '9'},
+														classes:    []*unicode.RangeTable{rangeTable("L")},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
 											},
 										},
 									},
@@ -1643,35 +3316,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 426, col: 5, offset: 8041},
-						run: (*parser).callonPipeExpressionHead46,
+						pos: position{line: 95, col: 7, offset: 1945},
+						run: (*parser).callonMemberExpressionProperty61,
 						expr: &seqExpr{
-							pos: position{line: 426, col: 5, offset: 8041},
+							pos: position{line: 95, col: 7, offset: 1945},
 							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 95, col: 7, offset: 1945},
+									val:        "[",
+									ignoreCase: false,
+								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1679,7 +3357,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1688,35 +3366,38 @@ var g = &grammar{
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 426, col: 8, offset: 8044},
-									val:        "true",
-									ignoreCase: false,
+								&labeledExpr{
+									pos:   position{line: 95, col: 14, offset: 1952},
+									label: "property",
+									expr: &ruleRefExpr{
+										pos:  position{line: 95, col: 23, offset: 1961},
+										name: "Primary",
+									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1724,7 +3405,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1733,39 +3414,35 @@ var g = &grammar{
 										},
 									},
 								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 429, col: 5, offset: 8115},
-						run: (*parser).callonPipeExpressionHead65,
-						expr: &seqExpr{
-							pos: position{line: 429, col: 5, offset: 8115},
-							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 95, col: 34, offset: 1972},
+									val:        "]",
+									ignoreCase: false,
+								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -1773,7 +3450,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -1782,475 +3459,366 @@ var g = &grammar{
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 429, col: 8, offset: 8118},
-									val:        "false",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CallExpression",
+			pos:  position{line: 99, col: 1, offset: 2015},
+			expr: &actionExpr{
+				pos: position{line: 100, col: 5, offset: 2034},
+				run: (*parser).callonCallExpression1,
+				expr: &seqExpr{
+					pos: position{line: 100, col: 5, offset: 2034},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 100, col: 5, offset: 2034},
+							label: "head",
+							expr: &actionExpr{
+								pos: position{line: 101, col: 7, offset: 2047},
+								run: (*parser).callonCallExpression4,
+								expr: &seqExpr{
+									pos: position{line: 101, col: 7, offset: 2047},
+									exprs: []interface{}{
+										&labeledExpr{
+											pos:   position{line: 101, col: 7, offset: 2047},
+											label: "callee",
+											expr: &ruleRefExpr{
+												pos:  position{line: 101, col: 14, offset: 2054},
+												name: "MemberExpressions",
 											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 560, col: 5, offset: 10748},
+											expr: &choiceExpr{
+												pos: position{line: 560, col: 7, offset: 10750},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 566, col: 5, offset: 10811},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
+														inverted:   false,
 													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
+													&seqExpr{
+														pos: position{line: 563, col: 5, offset: 10785},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 563, col: 5, offset: 10785},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 563, col: 10, offset: 10790},
+																expr: &charClassMatcher{
+																	pos:        position{line: 563, col: 10, offset: 10790},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 572, col: 5, offset: 10857},
+																val:        "\n",
+																ignoreCase: false,
+															},
 														},
 													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
-													},
 												},
 											},
 										},
+										&labeledExpr{
+											pos:   position{line: 101, col: 35, offset: 2075},
+											label: "args",
+											expr: &ruleRefExpr{
+												pos:  position{line: 101, col: 40, offset: 2080},
+												name: "Arguments",
+											},
+										},
 									},
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 7543},
-						run: (*parser).callonPipeExpressionHead84,
-						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 7543},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 397, col: 5, offset: 7543},
-									val:        "/",
-									ignoreCase: false,
-								},
-								&labeledExpr{
-									pos:   position{line: 397, col: 9, offset: 7547},
-									label: "pattern",
-									expr: &actionExpr{
-										pos: position{line: 402, col: 5, offset: 7624},
-										run: (*parser).callonPipeExpressionHead88,
-										expr: &labeledExpr{
-											pos:   position{line: 402, col: 5, offset: 7624},
-											label: "chars",
-											expr: &oneOrMoreExpr{
-												pos: position{line: 402, col: 11, offset: 7630},
-												expr: &choiceExpr{
-													pos: position{line: 407, col: 5, offset: 7714},
-													alternatives: []interface{}{
-														&actionExpr{
-															pos: position{line: 407, col: 5, offset: 7714},
-															run: (*parser).callonPipeExpressionHead92,
-															expr: &seqExpr{
-																pos: position{line: 407, col: 5, offset: 7714},
-																exprs: []interface{}{
-																	&notExpr{
-																		pos: position{line: 407, col: 5, offset: 7714},
-																		expr: &charClassMatcher{
-																			pos:        position{line: 407, col: 6, offset: 7715},
-																			val:        "[\\\\/]",
-																			chars:      []rune{'\\', '/'},
+						&labeledExpr{
+							pos:   position{line: 105, col: 5, offset: 2163},
+							label: "tail",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 105, col: 10, offset: 2168},
+								expr: &choiceExpr{
+									pos: position{line: 106, col: 9, offset: 2178},
+									alternatives: []interface{}{
+										&actionExpr{
+											pos: position{line: 106, col: 9, offset: 2178},
+											run: (*parser).callonCallExpression21,
+											expr: &seqExpr{
+												pos: position{line: 106, col: 9, offset: 2178},
+												exprs: []interface{}{
+													&zeroOrMoreExpr{
+														pos: position{line: 560, col: 5, offset: 10748},
+														expr: &choiceExpr{
+															pos: position{line: 560, col: 7, offset: 10750},
+															alternatives: []interface{}{
+																&charClassMatcher{
+																	pos:        position{line: 566, col: 5, offset: 10811},
+																	val:        "[ \\t\\r\\n]",
+																	chars:      []rune{' ', '\t', '\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&seqExpr{
+																	pos: position{line: 563, col: 5, offset: 10785},
+																	exprs: []interface{}{
+																		&litMatcher{
+																			pos:        position{line: 563, col: 5, offset: 10785},
+																			val:        "//",
 																			ignoreCase: false,
-																			inverted:   false,
 																		},
-																	},
-																	&labeledExpr{
-																		pos:   position{line: 407, col: 12, offset: 7721},
-																		label: "re",
-																		expr: &actionExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
-																			run: (*parser).callonPipeExpressionHead97,
-																			expr: &seqExpr{
-																				pos: position{line: 421, col: 5, offset: 7961},
-																				exprs: []interface{}{
-																					&notExpr{
-																						pos: position{line: 421, col: 5, offset: 7961},
-																						expr: &charClassMatcher{
-																							pos:        position{line: 477, col: 5, offset: 8821},
-																							val:        "[\\n\\r]",
-																							chars:      []rune{'\n', '\r'},
-																							ignoreCase: false,
-																							inverted:   false,
-																						},
-																					},
-																					&anyMatcher{
-																						line: 466, col: 5, offset: 8719,
-																					},
-																				},
+																		&zeroOrMoreExpr{
+																			pos: position{line: 563, col: 10, offset: 10790},
+																			expr: &charClassMatcher{
+																				pos:        position{line: 563, col: 10, offset: 10790},
+																				val:        "[^\\r\\n]",
+																				chars:      []rune{'\r', '\n'},
+																				ignoreCase: false,
+																				inverted:   true,
 																			},
 																		},
+																		&litMatcher{
+																			pos:        position{line: 572, col: 5, offset: 10857},
+																			val:        "\n",
+																			ignoreCase: false,
+																		},
 																	},
 																},
 															},
 														},
-														&actionExpr{
-															pos: position{line: 413, col: 5, offset: 7830},
-															run: (*parser).callonPipeExpressionHead102,
-															expr: &litMatcher{
-																pos:        position{line: 413, col: 5, offset: 7830},
-																val:        "\\/",
-																ignoreCase: false,
-															},
+													},
+													&labeledExpr{
+														pos:   position{line: 106, col: 12, offset: 2181},
+														label: "args",
+														expr: &ruleRefExpr{
+															pos:  position{line: 106, col: 17, offset: 2186},
+															name: "Arguments",
 														},
-														&actionExpr{
-															pos: position{line: 416, col: 5, offset: 7878},
-															run: (*parser).callonPipeExpressionHead104,
-															expr: &seqExpr{
-																pos: position{line: 416, col: 5, offset: 7878},
-																exprs: []interface{}{
-																	&litMatcher{
-																		pos:        position{line: 416, col: 5, offset: 7878},
-																		val:        "\\",
-																		ignoreCase: false,
-																	},
-																	&actionExpr{
-																		pos: position{line: 421, col: 5, offset: 7961},
-																		run: (*parser).callonPipeExpressionHead107,
-																		expr: &seqExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
-																			exprs: []interface{}{
-																				&notExpr{
-																					pos: position{line: 421, col: 5, offset: 7961},
-																					expr: &charClassMatcher{
-																						pos:        position{line: 477, col: 5, offset: 8821},
-																						val:        "[\\n\\r]",
-																						chars:      []rune{'\n', '\r'},
-																						ignoreCase: false,
-																						inverted:   false,
-																					},
-																				},
-																				&anyMatcher{
-																					line: 466, col: 5, offset: 8719,
-																				},
+													},
+												},
+											},
+										},
+										&actionExpr{
+											pos: position{line: 109, col: 10, offset: 2269},
+											run: (*parser).callonCallExpression33,
+											expr: &seqExpr{
+												pos: position{line: 109, col: 10, offset: 2269},
+												exprs: []interface{}{
+													&zeroOrMoreExpr{
+														pos: position{line: 560, col: 5, offset: 10748},
+														expr: &choiceExpr{
+															pos: position{line: 560, col: 7, offset: 10750},
+															alternatives: []interface{}{
+																&charClassMatcher{
+																	pos:        position{line: 566, col: 5, offset: 10811},
+																	val:        "[ \\t\\r\\n]",
+																	chars:      []rune{' ', '\t', '\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&seqExpr{
+																	pos: position{line: 563, col: 5, offset: 10785},
+																	exprs: []interface{}{
+																		&litMatcher{
+																			pos:        position{line: 563, col: 5, offset: 10785},
+																			val:        "//",
+																			ignoreCase: false,
+																		},
+																		&zeroOrMoreExpr{
+																			pos: position{line: 563, col: 10, offset: 10790},
+																			expr: &charClassMatcher{
+																				pos:        position{line: 563, col: 10, offset: 10790},
+																				val:        "[^\\r\\n]",
+																				chars:      []rune{'\r', '\n'},
+																				ignoreCase: false,
+																				inverted:   true,
 																			},
 																		},
+																		&litMatcher{
+																			pos:        position{line: 572, col: 5, offset: 10857},
+																			val:        "\n",
+																			ignoreCase: false,
+																		},
 																	},
 																},
 															},
 														},
 													},
+													&labeledExpr{
+														pos:   position{line: 109, col: 13, offset: 2272},
+														label: "property",
+														expr: &ruleRefExpr{
+															pos:  position{line: 109, col: 22, offset: 2281},
+															name: "MemberExpressionProperty",
+														},
+													},
 												},
 											},
 										},
 									},
 								},
-								&litMatcher{
-									pos:        position{line: 397, col: 28, offset: 7566},
-									val:        "/",
-									ignoreCase: false,
-								},
 							},
 						},
 					},
-					&actionExpr{
-						pos: position{line: 453, col: 5, offset: 8452},
-						run: (*parser).callonPipeExpressionHead113,
-						expr: &litMatcher{
-							pos:        position{line: 453, col: 5, offset: 8452},
-							val:        "<-",
-							ignoreCase: false,
+				},
+			},
+		},
+		{
+			name: "PipeExpression",
+			pos:  position{line: 117, col: 1, offset: 2446},
+			expr: &actionExpr{
+				pos: position{line: 118, col: 5, offset: 2465},
+				run: (*parser).callonPipeExpression1,
+				expr: &seqExpr{
+					pos: position{line: 118, col: 5, offset: 2465},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 118, col: 5, offset: 2465},
+							label: "head",
+							expr: &ruleRefExpr{
+								pos:  position{line: 118, col: 10, offset: 2470},
+								name: "PipeExpressionHead",
+							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 373, col: 5, offset: 7036},
-						run: (*parser).callonPipeExpressionHead115,
-						expr: &oneOrMoreExpr{
-							pos: position{line: 373, col: 5, offset: 7036},
-							expr: &seqExpr{
-								pos: position{line: 370, col: 5, offset: 6993},
-								exprs: []interface{}{
-									&choiceExpr{
-										pos: position{line: 439, col: 6, offset: 8288},
-										alternatives: []interface{}{
-											&litMatcher{
-												pos:        position{line: 439, col: 6, offset: 8288},
-												val:        "0",
-												ignoreCase: false,
-											},
-											&seqExpr{
-												pos: position{line: 439, col: 12, offset: 8294},
-												exprs: []interface{}{
-													&charClassMatcher{
-														pos:        position{line: 447, col: 5, offset: 8412},
-														val:        "[1-9]",
-														ranges:     []rune{'1', '9'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 439, col: 25, offset: 8307},
-														expr: &charClassMatcher{
-															pos:        position{line: 450, col: 5, offset: 8429},
-															val:        "[0-9]",
-															ranges:     []rune{'0', '9'},
-															ignoreCase: false,
-															inverted:   false,
-														},
-													},
-												},
-											},
-										},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
 									},
-									&choiceExpr{
-										pos: position{line: 361, col: 9, offset: 6843},
-										alternatives: []interface{}{
-											&litMatcher{
-												pos:        position{line: 342, col: 5, offset: 6676},
-												val:        "ns",
-												ignoreCase: false,
-											},
-											&litMatcher{
-												pos:        position{line: 345, col: 6, offset: 6704},
-												val:        "us",
-												ignoreCase: false,
-											},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 345, col: 13, offset: 6711},
-												val:        "µs",
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
 												ignoreCase: false,
 											},
-											&litMatcher{
-												pos:        position{line: 345, col: 20, offset: 6719},
-												val:        "μs",
-												ignoreCase: false,
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
 											},
 											&litMatcher{
-												pos:        position{line: 348, col: 5, offset: 6748},
-												val:        "ms",
-												ignoreCase: false,
-											},
-											&charClassMatcher{
-												pos:        position{line: 351, col: 5, offset: 6770},
-												val:        "[smh]",
-												chars:      []rune{'s', 'm', 'h'},
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
 												ignoreCase: false,
-												inverted:   false,
 											},
 										},
 									},
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 337, col: 5, offset: 6588},
-						run: (*parser).callonPipeExpressionHead131,
-						expr: &seqExpr{
-							pos: position{line: 337, col: 5, offset: 6588},
-							exprs: []interface{}{
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&litMatcher{
-									pos:        position{line: 331, col: 18, offset: 6503},
-									val:        "-",
-									ignoreCase: false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&litMatcher{
-									pos:        position{line: 331, col: 32, offset: 6517},
-									val:        "-",
-									ignoreCase: false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&litMatcher{
-									pos:        position{line: 337, col: 14, offset: 6597},
-									val:        "T",
-									ignoreCase: false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&litMatcher{
-									pos:        position{line: 328, col: 14, offset: 6433},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&litMatcher{
-									pos:        position{line: 328, col: 29, offset: 6448},
-									val:        ":",
-									ignoreCase: false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
-									val:        "[0-9]",
-									ranges:     []rune{'0', '9'},
-									ignoreCase: false,
-									inverted:   false,
-								},
-								&zeroOrOneExpr{
-									pos: position{line: 328, col: 44, offset: 6463},
-									expr: &seqExpr{
-										pos: position{line: 319, col: 5, offset: 6303},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 319, col: 5, offset: 6303},
-												val:        ".",
-												ignoreCase: false,
-											},
-											&oneOrMoreExpr{
-												pos: position{line: 319, col: 9, offset: 6307},
-												expr: &charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
-													val:        "[0-9]",
-													ranges:     []rune{'0', '9'},
-													ignoreCase: false,
-													inverted:   false,
+						&labeledExpr{
+							pos:   position{line: 118, col: 32, offset: 2492},
+							label: "tail",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 118, col: 37, offset: 2497},
+								expr: &seqExpr{
+									pos: position{line: 118, col: 38, offset: 2498},
+									exprs: []interface{}{
+										&zeroOrMoreExpr{
+											pos: position{line: 560, col: 5, offset: 10748},
+											expr: &choiceExpr{
+												pos: position{line: 560, col: 7, offset: 10750},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 566, col: 5, offset: 10811},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 563, col: 5, offset: 10785},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 563, col: 5, offset: 10785},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 563, col: 10, offset: 10790},
+																expr: &charClassMatcher{
+																	pos:        position{line: 563, col: 10, offset: 10790},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 572, col: 5, offset: 10857},
+																val:        "\n",
+																ignoreCase: false,
+															},
+														},
+													},
 												},
 											},
 										},
-									},
-								},
-								&choiceExpr{
-									pos: position{line: 325, col: 6, offset: 6386},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 325, col: 6, offset: 6386},
-											val:        "Z",
-											ignoreCase: false,
+										&ruleRefExpr{
+											pos:  position{line: 118, col: 41, offset: 2501},
+											name: "PipeExpressionPipe",
 										},
-										&seqExpr{
-											pos: position{line: 322, col: 5, offset: 6333},
-											exprs: []interface{}{
-												&charClassMatcher{
-													pos:        position{line: 322, col: 6, offset: 6334},
-													val:        "[+-]",
-													chars:      []rune{'+', '-'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
-													val:        "[0-9]",
-													ranges:     []rune{'0', '9'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
-													val:        "[0-9]",
-													ranges:     []rune{'0', '9'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&litMatcher{
-													pos:        position{line: 322, col: 26, offset: 6354},
-													val:        ":",
-													ignoreCase: false,
-												},
-												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
-													val:        "[0-9]",
-													ranges:     []rune{'0', '9'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
-													val:        "[0-9]",
-													ranges:     []rune{'0', '9'},
-													ignoreCase: false,
-													inverted:   false,
+										&zeroOrMoreExpr{
+											pos: position{line: 560, col: 5, offset: 10748},
+											expr: &choiceExpr{
+												pos: position{line: 560, col: 7, offset: 10750},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 566, col: 5, offset: 10811},
+														val:        "[ \\t\\r\\n]",
+														chars:      []rune{' ', '\t', '\r', '\n'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 563, col: 5, offset: 10785},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 563, col: 5, offset: 10785},
+																val:        "//",
+																ignoreCase: false,
+															},
+															&zeroOrMoreExpr{
+																pos: position{line: 563, col: 10, offset: 10790},
+																expr: &charClassMatcher{
+																	pos:        position{line: 563, col: 10, offset: 10790},
+																	val:        "[^\\r\\n]",
+																	chars:      []rune{'\r', '\n'},
+																	ignoreCase: false,
+																	inverted:   true,
+																},
+															},
+															&litMatcher{
+																pos:        position{line: 572, col: 5, offset: 10857},
+																val:        "\n",
+																ignoreCase: false,
+															},
+														},
+													},
 												},
 											},
 										},
@@ -2259,368 +3827,2677 @@ var g = &grammar{
 							},
 						},
 					},
+				},
+			},
+		},
+		{
+			name: "PipeExpressionHead",
+			pos:  position{line: 122, col: 1, offset: 2584},
+			expr: &choiceExpr{
+				pos: position{line: 123, col: 5, offset: 2607},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 123, col: 5, offset: 2607},
+						name: "CallExpression",
+					},
 					&actionExpr{
-						pos: position{line: 434, col: 5, offset: 8206},
-						run: (*parser).callonPipeExpressionHead166,
+						pos: position{line: 408, col: 5, offset: 8109},
+						run: (*parser).callonPipeExpressionHead3,
 						expr: &seqExpr{
-							pos: position{line: 434, col: 5, offset: 8206},
+							pos: position{line: 408, col: 7, offset: 8111},
 							exprs: []interface{}{
-								&choiceExpr{
-									pos: position{line: 439, col: 6, offset: 8288},
-									alternatives: []interface{}{
-										&litMatcher{
-											pos:        position{line: 439, col: 6, offset: 8288},
-											val:        "0",
-											ignoreCase: false,
-										},
-										&seqExpr{
-											pos: position{line: 439, col: 12, offset: 8294},
-											exprs: []interface{}{
-												&charClassMatcher{
-													pos:        position{line: 447, col: 5, offset: 8412},
-													val:        "[1-9]",
-													ranges:     []rune{'1', '9'},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 439, col: 25, offset: 8307},
-													expr: &charClassMatcher{
-														pos:        position{line: 450, col: 5, offset: 8429},
-														val:        "[0-9]",
-														ranges:     []rune{'0', '9'},
-														ignoreCase: false,
-														inverted:   false,
-													},
-												},
-											},
-										},
-									},
-								},
 								&litMatcher{
-									pos:        position{line: 434, col: 13, offset: 8214},
-									val:        ".",
+									pos:        position{line: 408, col: 7, offset: 8111},
+									val:        "\"",
 									ignoreCase: false,
 								},
-								&oneOrMoreExpr{
-									pos: position{line: 434, col: 17, offset: 8218},
-									expr: &charClassMatcher{
-										pos:        position{line: 450, col: 5, offset: 8429},
-										val:        "[0-9]",
-										ranges:     []rune{'0', '9'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 442, col: 5, offset: 8335},
-						run: (*parser).callonPipeExpressionHead177,
-						expr: &choiceExpr{
-							pos: position{line: 439, col: 6, offset: 8288},
-							alternatives: []interface{}{
-								&litMatcher{
-									pos:        position{line: 439, col: 6, offset: 8288},
-									val:        "0",
-									ignoreCase: false,
-								},
-								&seqExpr{
-									pos: position{line: 439, col: 12, offset: 8294},
-									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 447, col: 5, offset: 8412},
-											val:        "[1-9]",
-											ranges:     []rune{'1', '9'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 439, col: 25, offset: 8307},
-											expr: &charClassMatcher{
-												pos:        position{line: 450, col: 5, offset: 8429},
-												val:        "[0-9]",
-												ranges:     []rune{'0', '9'},
-												ignoreCase: false,
-												inverted:   false,
+								&zeroOrMoreExpr{
+									pos: position{line: 408, col: 11, offset: 8115},
+									expr: &choiceExpr{
+										pos: position{line: 416, col: 5, offset: 8297},
+										alternatives: []interface{}{
+											&seqExpr{
+												pos: position{line: 416, col: 5, offset: 8297},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 416, col: 5, offset: 8297},
+														expr: &choiceExpr{
+															pos: position{line: 416, col: 8, offset: 8300},
+															alternatives: []interface{}{
+																&charClassMatcher{
+																	pos:        position{line: 416, col: 8, offset: 8300},
+																	val:        "[\"\\\\\\n]",
+																	chars:      []rune{'"', '\\', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&litMatcher{
+																	pos:        position{line: 416, col: 27, offset: 8319},
+																	val:        "${",
+																	ignoreCase: false,
+																},
+															},
+														},
+													},
+													&anyMatcher{
+														line: 558, col: 5, offset: 10739,
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 417, col: 5, offset: 8341},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 417, col: 5, offset: 8341},
+														val:        "\\",
+														ignoreCase: false,
+													},
+													&choiceExpr{
+														pos: position{line: 420, col: 5, offset: 8389},
+														alternatives: []interface{}{
+															&charClassMatcher{
+																pos:        position{line: 420, col: 7, offset: 8391},
+																val:        "[\"\\\\$nrt]",
+																chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+															&seqExpr{
+																pos: position{line: 420, col: 44, offset: 8428},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 420, col: 44, offset: 8428},
+																		val:        "x",
+																		ignoreCase: false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+															&actionExpr{
+																pos: position{line: 421, col: 5, offset: 8456},
+																run: (*parser).callonPipeExpressionHead22,
+																expr: &choiceExpr{
+																	pos: position{line: 421, col: 7, offset: 8458},
+																	alternatives: []interface{}{
+																		&anyMatcher{
+																			line: 558, col: 5, offset: 10739,
+																		},
+																		&litMatcher{
+																			pos:        position{line: 572, col: 5, offset: 10857},
+																			val:        "\n",
+																			ignoreCase: false,
+																		},
+																		&notExpr{
+																			pos: position{line: 575, col: 5, offset: 10871},
+																			expr: &anyMatcher{
+																				line: 575, col: 6, offset: 10872,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
 								},
+								&litMatcher{
+									pos:        position{line: 408, col: 29, offset: 8133},
+									val:        "\"",
+									ignoreCase: false,
+								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 104, col: 5, offset: 2171},
-						name: "Array",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 105, col: 5, offset: 2181},
-						name: "MemberExpressions",
-					},
 					&actionExpr{
-						pos: position{line: 460, col: 5, offset: 8638},
-						run: (*parser).callonPipeExpressionHead186,
+						pos: position{line: 411, col: 5, offset: 8193},
+						run: (*parser).callonPipeExpressionHead29,
 						expr: &seqExpr{
-							pos: position{line: 460, col: 5, offset: 8638},
+							pos: position{line: 411, col: 7, offset: 8195},
 							exprs: []interface{}{
-								&charClassMatcher{
-									pos:        position{line: 460, col: 5, offset: 8638},
-									val:        "[_\\pL]",
-									chars:      []rune{'_'},
-									classes:    []*unicode.RangeTable{rangeTable("L")},
+								&litMatcher{
+									pos:        position{line: 411, col: 7, offset: 8195},
+									val:        "\"",
 									ignoreCase: false,
-									inverted:   false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 460, col: 11, offset: 8644},
-									expr: &charClassMatcher{
-										pos:        position{line: 460, col: 11, offset: 8644},
-										val:        "[_0-9\\pL]",
-										chars:      []rune{'_'},
-										ranges:     []rune{'0', '9'},
-										classes:    []*unicode.RangeTable{rangeTable("L")},
-										ignoreCase: false,
-										inverted:   false,
+									pos: position{line: 411, col: 11, offset: 8199},
+									expr: &choiceExpr{
+										pos: position{line: 416, col: 5, offset: 8297},
+										alternatives: []interface{}{
+											&seqExpr{
+												pos: position{line: 416, col: 5, offset: 8297},
+												exprs: []interface{}{
+													&notExpr{
+														pos: position{line: 416, col: 5, offset: 8297},
+														expr: &choiceExpr{
+															pos: position{line: 416, col: 8, offset: 8300},
+															alternatives: []interface{}{
+																&charClassMatcher{
+																	pos:        position{line: 416, col: 8, offset: 8300},
+																	val:        "[\"\\\\\\n]",
+																	chars:      []rune{'"', '\\', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&litMatcher{
+																	pos:        position{line: 416, col: 27, offset: 8319},
+																	val:        "${",
+																	ignoreCase: false,
+																},
+															},
+														},
+													},
+													&anyMatcher{
+														line: 558, col: 5, offset: 10739,
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 417, col: 5, offset: 8341},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 417, col: 5, offset: 8341},
+														val:        "\\",
+														ignoreCase: false,
+													},
+													&choiceExpr{
+														pos: position{line: 420, col: 5, offset: 8389},
+														alternatives: []interface{}{
+															&charClassMatcher{
+																pos:        position{line: 420, col: 7, offset: 8391},
+																val:        "[\"\\\\$nrt]",
+																chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+															&seqExpr{
+																pos: position{line: 420, col: 44, offset: 8428},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 420, col: 44, offset: 8428},
+																		val:        "x",
+																		ignoreCase: false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
+															},
+															&actionExpr{
+																pos: position{line: 421, col: 5, offset: 8456},
+																run: (*parser).callonPipeExpressionHead48,
+																expr: &choiceExpr{
+																	pos: position{line: 421, col: 7, offset: 8458},
+																	alternatives: []interface{}{
+																		&anyMatcher{
+																			line: 558, col: 5, offset: 10739,
+																		},
+																		&litMatcher{
+																			pos:        position{line: 572, col: 5, offset: 10857},
+																			val:        "\n",
+																			ignoreCase: false,
+																		},
+																		&notExpr{
+																			pos: position{line: 575, col: 5, offset: 10871},
+																			expr: &anyMatcher{
+																				line: 575, col: 6, offset: 10872,
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 411, col: 31, offset: 8219},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 572, col: 5, offset: 10857},
+											val:        "\n",
+											ignoreCase: false,
+										},
+										&notExpr{
+											pos: position{line: 575, col: 5, offset: 10871},
+											expr: &anyMatcher{
+												line: 575, col: 6, offset: 10872,
+											},
+										},
 									},
 								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 107, col: 5, offset: 2218},
-						name: "ObjectExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 108, col: 5, offset: 2239},
-						name: "ArrowFunctionExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 109, col: 5, offset: 2267},
-						name: "Parens",
-					},
-				},
-			},
-		},
-		{
-			name: "PipeExpressionPipe",
-			pos:  position{line: 111, col: 1, offset: 2275},
-			expr: &actionExpr{
-				pos: position{line: 112, col: 5, offset: 2298},
-				run: (*parser).callonPipeExpressionPipe1,
-				expr: &seqExpr{
-					pos: position{line: 112, col: 5, offset: 2298},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 112, col: 5, offset: 2298},
-							val:        "|>",
-							ignoreCase: false,
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
+					&actionExpr{
+						pos: position{line: 475, col: 5, offset: 9481},
+						run: (*parser).callonPipeExpressionHead58,
+						expr: &seqExpr{
+							pos: position{line: 475, col: 5, offset: 9481},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
+												inverted:   false,
 											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 475, col: 8, offset: 9484},
+									val:        "true",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
 												},
 											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
 										},
 									},
 								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 112, col: 13, offset: 2306},
-							label: "call",
-							expr: &ruleRefExpr{
-								pos:  position{line: 112, col: 18, offset: 2311},
-								name: "CallExpression",
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "Arguments",
-			pos:  position{line: 116, col: 1, offset: 2388},
-			expr: &actionExpr{
-				pos: position{line: 117, col: 5, offset: 2402},
-				run: (*parser).callonArguments1,
-				expr: &seqExpr{
-					pos: position{line: 117, col: 5, offset: 2402},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 117, col: 5, offset: 2402},
-							val:        "(",
-							ignoreCase: false,
+					&actionExpr{
+						pos: position{line: 478, col: 5, offset: 9555},
+						run: (*parser).callonPipeExpressionHead77,
+						expr: &seqExpr{
+							pos: position{line: 478, col: 5, offset: 9555},
+							exprs: []interface{}{
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 478, col: 8, offset: 9558},
+									val:        "false",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
+					},
+					&actionExpr{
+						pos: position{line: 446, col: 5, offset: 8983},
+						run: (*parser).callonPipeExpressionHead96,
+						expr: &seqExpr{
+							pos: position{line: 446, col: 5, offset: 8983},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 446, col: 5, offset: 8983},
+									val:        "/",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 446, col: 9, offset: 8987},
+									label: "pattern",
+									expr: &actionExpr{
+										pos: position{line: 451, col: 5, offset: 9064},
+										run: (*parser).callonPipeExpressionHead100,
+										expr: &labeledExpr{
+											pos:   position{line: 451, col: 5, offset: 9064},
+											label: "chars",
+											expr: &oneOrMoreExpr{
+												pos: position{line: 451, col: 11, offset: 9070},
+												expr: &choiceExpr{
+													pos: position{line: 456, col: 5, offset: 9154},
+													alternatives: []interface{}{
+														&actionExpr{
+															pos: position{line: 456, col: 5, offset: 9154},
+															run: (*parser).callonPipeExpressionHead104,
+															expr: &seqExpr{
+																pos: position{line: 456, col: 5, offset: 9154},
+																exprs: []interface{}{
+																	&notExpr{
+																		pos: position{line: 456, col: 5, offset: 9154},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 456, col: 6, offset: 9155},
+																			val:        "[\\\\/]",
+																			chars:      []rune{'\\', '/'},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																	&labeledExpr{
+																		pos:   position{line: 456, col: 12, offset: 9161},
+																		label: "re",
+																		expr: &actionExpr{
+																			pos: position{line: 470, col: 5, offset: 9401},
+																			run: (*parser).callonPipeExpressionHead109,
+																			expr: &seqExpr{
+																				pos: position{line: 470, col: 5, offset: 9401},
+																				exprs: []interface{}{
+																					&notExpr{
+																						pos: position{line: 470, col: 5, offset: 9401},
+																						expr: &charClassMatcher{
+																							pos:        position{line: 569, col: 5, offset: 10841},
+																							val:        "[\\n\\r]",
+																							chars:      []rune{'\n', '\r'},
+																							ignoreCase: false,
+																							inverted:   false,
+																						},
+																					},
+																					&anyMatcher{
+																						line: 558, col: 5, offset: 10739,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+														&actionExpr{
+															pos: position{line: 462, col: 5, offset: 9270},
+															run: (*parser).callonPipeExpressionHead114,
+															expr: &litMatcher{
+																pos:        position{line: 462, col: 5, offset: 9270},
+																val:        "\\/",
+																ignoreCase: false,
+															},
+														},
+														&actionExpr{
+															pos: position{line: 465, col: 5, offset: 9318},
+															run: (*parser).callonPipeExpressionHead116,
+															expr: &seqExpr{
+																pos: position{line: 465, col: 5, offset: 9318},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 465, col: 5, offset: 9318},
+																		val:        "\\",
+																		ignoreCase: false,
+																	},
+																	&actionExpr{
+																		pos: position{line: 470, col: 5, offset: 9401},
+																		run: (*parser).callonPipeExpressionHead119,
+																		expr: &seqExpr{
+																			pos: position{line: 470, col: 5, offset: 9401},
+																			exprs: []interface{}{
+																				&notExpr{
+																					pos: position{line: 470, col: 5, offset: 9401},
+																					expr: &charClassMatcher{
+																						pos:        position{line: 569, col: 5, offset: 10841},
+																						val:        "[\\n\\r]",
+																						chars:      []rune{'\n', '\r'},
+																						ignoreCase: false,
+																						inverted:   false,
+																					},
+																				},
+																				&anyMatcher{
+																					line: 558, col: 5, offset: 10739,
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
 									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
-										exprs: []interface{}{
+								},
+								&litMatcher{
+									pos:        position{line: 446, col: 28, offset: 9006},
+									val:        "/",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 502, col: 5, offset: 9892},
+						run: (*parser).callonPipeExpressionHead125,
+						expr: &litMatcher{
+							pos:        position{line: 502, col: 5, offset: 9892},
+							val:        "<-",
+							ignoreCase: false,
+						},
+					},
+					&actionExpr{
+						pos: position{line: 403, col: 5, offset: 8022},
+						run: (*parser).callonPipeExpressionHead127,
+						expr: &oneOrMoreExpr{
+							pos: position{line: 403, col: 5, offset: 8022},
+							expr: &seqExpr{
+								pos: position{line: 400, col: 5, offset: 7979},
+								exprs: []interface{}{
+									&choiceExpr{
+										pos: position{line: 488, col: 6, offset: 9728},
+										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
+												pos:        position{line: 488, col: 6, offset: 9728},
+												val:        "0",
 												ignoreCase: false,
 											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
+											&seqExpr{
+												pos: position{line: 488, col: 12, offset: 9734},
+												exprs: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 496, col: 5, offset: 9852},
+														val:        "[1-9]",
+														ranges:     []rune{'1', '9'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 488, col: 25, offset: 9747},
+														expr: &charClassMatcher{
+															pos:        position{line: 499, col: 5, offset: 9869},
+															val:        "[0-9]",
+															ranges:     []rune{'0', '9'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+										},
+									},
+									&choiceExpr{
+										pos: position{line: 391, col: 9, offset: 7829},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 372, col: 5, offset: 7662},
+												val:        "ns",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 375, col: 6, offset: 7690},
+												val:        "us",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 375, col: 13, offset: 7697},
+												val:        "µs",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 375, col: 20, offset: 7705},
+												val:        "μs",
+												ignoreCase: false,
+											},
+											&litMatcher{
+												pos:        position{line: 378, col: 5, offset: 7734},
+												val:        "ms",
+												ignoreCase: false,
+											},
+											&charClassMatcher{
+												pos:        position{line: 381, col: 5, offset: 7756},
+												val:        "[smh]",
+												chars:      []rune{'s', 'm', 'h'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 367, col: 5, offset: 7574},
+						run: (*parser).callonPipeExpressionHead143,
+						expr: &seqExpr{
+							pos: position{line: 367, col: 5, offset: 7574},
+							exprs: []interface{}{
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&litMatcher{
+									pos:        position{line: 361, col: 18, offset: 7489},
+									val:        "-",
+									ignoreCase: false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&litMatcher{
+									pos:        position{line: 361, col: 32, offset: 7503},
+									val:        "-",
+									ignoreCase: false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&litMatcher{
+									pos:        position{line: 367, col: 14, offset: 7583},
+									val:        "T",
+									ignoreCase: false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&litMatcher{
+									pos:        position{line: 358, col: 14, offset: 7419},
+									val:        ":",
+									ignoreCase: false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&litMatcher{
+									pos:        position{line: 358, col: 29, offset: 7434},
+									val:        ":",
+									ignoreCase: false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 499, col: 5, offset: 9869},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 358, col: 44, offset: 7449},
+									expr: &seqExpr{
+										pos: position{line: 349, col: 5, offset: 7289},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 349, col: 5, offset: 7289},
+												val:        ".",
+												ignoreCase: false,
+											},
+											&oneOrMoreExpr{
+												pos: position{line: 349, col: 9, offset: 7293},
+												expr: &charClassMatcher{
+													pos:        position{line: 499, col: 5, offset: 9869},
+													val:        "[0-9]",
+													ranges:     []rune{'0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+											},
+										},
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 355, col: 6, offset: 7372},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 355, col: 6, offset: 7372},
+											val:        "Z",
+											ignoreCase: false,
+										},
+										&seqExpr{
+											pos: position{line: 352, col: 5, offset: 7319},
+											exprs: []interface{}{
+												&charClassMatcher{
+													pos:        position{line: 352, col: 6, offset: 7320},
+													val:        "[+-]",
+													chars:      []rune{'+', '-'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&charClassMatcher{
+													pos:        position{line: 499, col: 5, offset: 9869},
+													val:        "[0-9]",
+													ranges:     []rune{'0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&charClassMatcher{
+													pos:        position{line: 499, col: 5, offset: 9869},
+													val:        "[0-9]",
+													ranges:     []rune{'0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&litMatcher{
+													pos:        position{line: 352, col: 26, offset: 7340},
+													val:        ":",
+													ignoreCase: false,
+												},
+												&charClassMatcher{
+													pos:        position{line: 499, col: 5, offset: 9869},
+													val:        "[0-9]",
+													ranges:     []rune{'0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&charClassMatcher{
+													pos:        position{line: 499, col: 5, offset: 9869},
+													val:        "[0-9]",
+													ranges:     []rune{'0', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 483, col: 5, offset: 9646},
+						run: (*parser).callonPipeExpressionHead178,
+						expr: &seqExpr{
+							pos: position{line: 483, col: 5, offset: 9646},
+							exprs: []interface{}{
+								&choiceExpr{
+									pos: position{line: 488, col: 6, offset: 9728},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 488, col: 6, offset: 9728},
+											val:        "0",
+											ignoreCase: false,
+										},
+										&seqExpr{
+											pos: position{line: 488, col: 12, offset: 9734},
+											exprs: []interface{}{
+												&charClassMatcher{
+													pos:        position{line: 496, col: 5, offset: 9852},
+													val:        "[1-9]",
+													ranges:     []rune{'1', '9'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 488, col: 25, offset: 9747},
+													expr: &charClassMatcher{
+														pos:        position{line: 499, col: 5, offset: 9869},
+														val:        "[0-9]",
+														ranges:     []rune{'0', '9'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 483, col: 13, offset: 9654},
+									val:        ".",
+									ignoreCase: false,
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 483, col: 17, offset: 9658},
+									expr: &charClassMatcher{
+										pos:        position{line: 499, col: 5, offset: 9869},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 491, col: 5, offset: 9775},
+						run: (*parser).callonPipeExpressionHead189,
+						expr: &choiceExpr{
+							pos: position{line: 488, col: 6, offset: 9728},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 488, col: 6, offset: 9728},
+									val:        "0",
+									ignoreCase: false,
+								},
+								&seqExpr{
+									pos: position{line: 488, col: 12, offset: 9734},
+									exprs: []interface{}{
+										&charClassMatcher{
+											pos:        position{line: 496, col: 5, offset: 9852},
+											val:        "[1-9]",
+											ranges:     []rune{'1', '9'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 488, col: 25, offset: 9747},
+											expr: &charClassMatcher{
+												pos:        position{line: 499, col: 5, offset: 9869},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 125, col: 5, offset: 2760},
+						name: "StringExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 126, col: 5, offset: 2781},
+						name: "Array",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 127, col: 5, offset: 2791},
+						name: "MemberExpressions",
+					},
+					&actionExpr{
+						pos: position{line: 510, col: 5, offset: 10106},
+						run: (*parser).callonPipeExpressionHead199,
+						expr: &seqExpr{
+							pos: position{line: 510, col: 5, offset: 10106},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 510, col: 5, offset: 10106},
+									expr: &choiceExpr{
+										pos: position{line: 516, col: 5, offset: 10193},
+										alternatives: []interface{}{
+											&seqExpr{
+												pos: position{line: 528, col: 5, offset: 10377},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 528, col: 5, offset: 10377},
+														val:        "package",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 528, col: 15, offset: 10387},
+														expr: &charClassMatcher{
+															pos:        position{line: 528, col: 16, offset: 10388},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 531, col: 5, offset: 10417},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 531, col: 5, offset: 10417},
+														val:        "import",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 531, col: 14, offset: 10426},
+														expr: &charClassMatcher{
+															pos:        position{line: 531, col: 15, offset: 10427},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 534, col: 5, offset: 10456},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 534, col: 5, offset: 10456},
+														val:        "option",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 534, col: 14, offset: 10465},
+														expr: &charClassMatcher{
+															pos:        position{line: 534, col: 15, offset: 10466},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 537, col: 5, offset: 10495},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 537, col: 5, offset: 10495},
+														val:        "return",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 537, col: 14, offset: 10504},
+														expr: &charClassMatcher{
+															pos:        position{line: 537, col: 15, offset: 10505},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 540, col: 5, offset: 10530},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 540, col: 5, offset: 10530},
+														val:        "if",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 540, col: 10, offset: 10535},
+														expr: &charClassMatcher{
+															pos:        position{line: 540, col: 11, offset: 10536},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 543, col: 5, offset: 10563},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 543, col: 5, offset: 10563},
+														val:        "then",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 543, col: 12, offset: 10570},
+														expr: &charClassMatcher{
+															pos:        position{line: 543, col: 13, offset: 10571},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 546, col: 5, offset: 10598},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 546, col: 5, offset: 10598},
+														val:        "else",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 546, col: 12, offset: 10605},
+														expr: &charClassMatcher{
+															pos:        position{line: 546, col: 13, offset: 10606},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 549, col: 5, offset: 10631},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 549, col: 5, offset: 10631},
+														val:        "in",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 549, col: 10, offset: 10636},
+														expr: &charClassMatcher{
+															pos:        position{line: 549, col: 11, offset: 10637},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 552, col: 5, offset: 10665},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 552, col: 5, offset: 10665},
+														val:        "empty",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 552, col: 13, offset: 10673},
+														expr: &charClassMatcher{
+															pos:        position{line: 552, col: 14, offset: 10674},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 555, col: 5, offset: 10703},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 555, col: 5, offset: 10703},
+														val:        "exists",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 555, col: 14, offset: 10712},
+														expr: &charClassMatcher{
+															pos:        position{line: 555, col: 15, offset: 10713},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+								&charClassMatcher{
+									pos:        position{line: 510, col: 14, offset: 10115},
+									val:        "[_\\pL]",
+									chars:      []rune{'_'},
+									classes:    []*unicode.RangeTable{rangeTable("L")},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 510, col: 20, offset: 10121},
+									expr: &charClassMatcher{
+										pos:        position{line: 510, col: 20, offset: 10121},
+										val:        "[_0-9\\pL]",
+										chars:      []rune{'_'},
+										ranges:     []rune{'0', '9'},
+										classes:    []*unicode.RangeTable{rangeTable("L")},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 129, col: 5, offset: 2828},
+						name: "ObjectExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 130, col: 5, offset: 2849},
+						name: "ArrowFunctionExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 131, col: 5, offset: 2877},
+						name: "Parens",
+					},
+				},
+			},
+		},
+		{
+			name: "PipeExpressionPipe",
+			pos:  position{line: 133, col: 1, offset: 2885},
+			expr: &actionExpr{
+				pos: position{line: 134, col: 5, offset: 2908},
+				run: (*parser).callonPipeExpressionPipe1,
+				expr: &seqExpr{
+					pos: position{line: 134, col: 5, offset: 2908},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 134, col: 5, offset: 2908},
+							val:        "|>",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 134, col: 13, offset: 2916},
+							label: "call",
+							expr: &ruleRefExpr{
+								pos:  position{line: 134, col: 18, offset: 2921},
+								name: "CallExpression",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Arguments",
+			pos:  position{line: 138, col: 1, offset: 2998},
+			expr: &actionExpr{
+				pos: position{line: 139, col: 5, offset: 3012},
+				run: (*parser).callonArguments1,
+				expr: &seqExpr{
+					pos: position{line: 139, col: 5, offset: 3012},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 139, col: 5, offset: 3012},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 139, col: 12, offset: 3019},
+							label: "args",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 139, col: 17, offset: 3024},
+								expr: &ruleRefExpr{
+									pos:  position{line: 139, col: 18, offset: 3025},
+									name: "ObjectProperties",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 139, col: 40, offset: 3047},
+							val:        ")",
+							ignoreCase: false,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ArrowFunctionExpression",
+			pos:  position{line: 143, col: 1, offset: 3083},
+			expr: &actionExpr{
+				pos: position{line: 144, col: 5, offset: 3111},
+				run: (*parser).callonArrowFunctionExpression1,
+				expr: &seqExpr{
+					pos: position{line: 144, col: 5, offset: 3111},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 144, col: 5, offset: 3111},
+							val:        "(",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 144, col: 12, offset: 3118},
+							label: "params",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 144, col: 19, offset: 3125},
+								expr: &ruleRefExpr{
+									pos:  position{line: 144, col: 19, offset: 3125},
+									name: "ArrowFunctionParams",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 144, col: 43, offset: 3149},
+							val:        ")",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 144, col: 50, offset: 3156},
+							val:        "=>",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 144, col: 58, offset: 3164},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 144, col: 63, offset: 3169},
+								name: "ArrowFunctionBody",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ArrowFunctionParams",
+			pos:  position{line: 148, col: 1, offset: 3256},
+			expr: &actionExpr{
+				pos: position{line: 149, col: 5, offset: 3280},
+				run: (*parser).callonArrowFunctionParams1,
+				expr: &seqExpr{
+					pos: position{line: 149, col: 5, offset: 3280},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 149, col: 5, offset: 3280},
+							label: "first",
+							expr: &ruleRefExpr{
+								pos:  position{line: 149, col: 11, offset: 3286},
+								name: "ArrowFunctionParam",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 149, col: 33, offset: 3308},
+							label: "rest",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 149, col: 38, offset: 3313},
+								expr: &ruleRefExpr{
+									pos:  position{line: 149, col: 38, offset: 3313},
+									name: "ArrowFunctionParamsRest",
+								},
+							},
+						},
+						&zeroOrOneExpr{
+							pos: position{line: 149, col: 63, offset: 3338},
+							expr: &litMatcher{
+								pos:        position{line: 149, col: 63, offset: 3338},
+								val:        ",",
+								ignoreCase: false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ArrowFunctionParamsRest",
+			pos:  position{line: 153, col: 1, offset: 3423},
+			expr: &actionExpr{
+				pos: position{line: 154, col: 5, offset: 3451},
+				run: (*parser).callonArrowFunctionParamsRest1,
+				expr: &seqExpr{
+					pos: position{line: 154, col: 5, offset: 3451},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 154, col: 5, offset: 3451},
+							val:        ",",
+							ignoreCase: false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 154, col: 13, offset: 3459},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 154, col: 17, offset: 3463},
+								name: "ArrowFunctionParam",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 560, col: 5, offset: 10748},
+							expr: &choiceExpr{
+								pos: position{line: 560, col: 7, offset: 10750},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 566, col: 5, offset: 10811},
+										val:        "[ \\t\\r\\n]",
+										chars:      []rune{' ', '\t', '\r', '\n'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&seqExpr{
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ArrowFunctionParam",
+			pos:  position{line: 158, col: 1, offset: 3516},
+			expr: &choiceExpr{
+				pos: position{line: 159, col: 5, offset: 3539},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 159, col: 5, offset: 3539},
+						run: (*parser).callonArrowFunctionParam2,
+						expr: &seqExpr{
+							pos: position{line: 159, col: 5, offset: 3539},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 159, col: 5, offset: 3539},
+									label: "key",
+									expr: &actionExpr{
+										pos: position{line: 510, col: 5, offset: 10106},
+										run: (*parser).callonArrowFunctionParam5,
+										expr: &seqExpr{
+											pos: position{line: 510, col: 5, offset: 10106},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 510, col: 5, offset: 10106},
+													expr: &choiceExpr{
+														pos: position{line: 516, col: 5, offset: 10193},
+														alternatives: []interface{}{
+															&seqExpr{
+																pos: position{line: 528, col: 5, offset: 10377},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 528, col: 5, offset: 10377},
+																		val:        "package",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 528, col: 15, offset: 10387},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 528, col: 16, offset: 10388},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 531, col: 5, offset: 10417},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 531, col: 5, offset: 10417},
+																		val:        "import",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 531, col: 14, offset: 10426},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 531, col: 15, offset: 10427},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 534, col: 5, offset: 10456},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 534, col: 5, offset: 10456},
+																		val:        "option",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 534, col: 14, offset: 10465},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 534, col: 15, offset: 10466},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 537, col: 5, offset: 10495},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 537, col: 5, offset: 10495},
+																		val:        "return",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 537, col: 14, offset: 10504},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 537, col: 15, offset: 10505},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 540, col: 5, offset: 10530},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 540, col: 5, offset: 10530},
+																		val:        "if",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 540, col: 10, offset: 10535},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 540, col: 11, offset: 10536},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 543, col: 5, offset: 10563},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 543, col: 5, offset: 10563},
+																		val:        "then",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 543, col: 12, offset: 10570},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 543, col: 13, offset: 10571},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 546, col: 5, offset: 10598},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 546, col: 5, offset: 10598},
+																		val:        "else",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 546, col: 12, offset: 10605},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 546, col: 13, offset: 10606},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 549, col: 5, offset: 10631},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 549, col: 5, offset: 10631},
+																		val:        "in",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 549, col: 10, offset: 10636},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 549, col: 11, offset: 10637},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 552, col: 5, offset: 10665},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 552, col: 5, offset: 10665},
+																		val:        "empty",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 552, col: 13, offset: 10673},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 552, col: 14, offset: 10674},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 555, col: 5, offset: 10703},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 555, col: 5, offset: 10703},
+																		val:        "exists",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 555, col: 14, offset: 10712},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 555, col: 15, offset: 10713},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												&charClassMatcher{
+													pos:        position{line: 510, col: 14, offset: 10115},
+													val:        "[_\\pL]",
+													chars:      []rune{'_'},
+													classes:    []*unicode.RangeTable{rangeTable("L")},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 510, col: 20, offset: 10121},
+													expr: &charClassMatcher{
+														pos:        position{line: 510, col: 20, offset: 10121},
+														val:        "[_0-9\\pL]",
+														chars:      []rune{'_'},
+														ranges:     []rune{'0', '9'},
+														classes:    []*unicode.RangeTable{rangeTable("L")},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 159, col: 23, offset: 3557},
+									val:        "=",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 159, col: 30, offset: 3564},
+									label: "value",
+									expr: &ruleRefExpr{
+										pos:  position{line: 159, col: 36, offset: 3570},
+										name: "Primary",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 162, col: 5, offset: 3643},
+						run: (*parser).callonArrowFunctionParam79,
+						expr: &seqExpr{
+							pos: position{line: 162, col: 5, offset: 3643},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 162, col: 5, offset: 3643},
+									label: "key",
+									expr: &actionExpr{
+										pos: position{line: 510, col: 5, offset: 10106},
+										run: (*parser).callonArrowFunctionParam82,
+										expr: &seqExpr{
+											pos: position{line: 510, col: 5, offset: 10106},
+											exprs: []interface{}{
+												&notExpr{
+													pos: position{line: 510, col: 5, offset: 10106},
+													expr: &choiceExpr{
+														pos: position{line: 516, col: 5, offset: 10193},
+														alternatives: []interface{}{
+															&seqExpr{
+																pos: position{line: 528, col: 5, offset: 10377},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 528, col: 5, offset: 10377},
+																		val:        "package",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 528, col: 15, offset: 10387},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 528, col: 16, offset: 10388},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 531, col: 5, offset: 10417},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 531, col: 5, offset: 10417},
+																		val:        "import",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 531, col: 14, offset: 10426},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 531, col: 15, offset: 10427},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 534, col: 5, offset: 10456},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 534, col: 5, offset: 10456},
+																		val:        "option",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 534, col: 14, offset: 10465},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 534, col: 15, offset: 10466},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 537, col: 5, offset: 10495},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 537, col: 5, offset: 10495},
+																		val:        "return",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 537, col: 14, offset: 10504},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 537, col: 15, offset: 10505},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 540, col: 5, offset: 10530},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 540, col: 5, offset: 10530},
+																		val:        "if",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 540, col: 10, offset: 10535},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 540, col: 11, offset: 10536},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 543, col: 5, offset: 10563},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 543, col: 5, offset: 10563},
+																		val:        "then",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 543, col: 12, offset: 10570},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 543, col: 13, offset: 10571},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 546, col: 5, offset: 10598},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 546, col: 5, offset: 10598},
+																		val:        "else",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 546, col: 12, offset: 10605},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 546, col: 13, offset: 10606},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 549, col: 5, offset: 10631},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 549, col: 5, offset: 10631},
+																		val:        "in",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 549, col: 10, offset: 10636},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 549, col: 11, offset: 10637},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 552, col: 5, offset: 10665},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 552, col: 5, offset: 10665},
+																		val:        "empty",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 552, col: 13, offset: 10673},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 552, col: 14, offset: 10674},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+															&seqExpr{
+																pos: position{line: 555, col: 5, offset: 10703},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 555, col: 5, offset: 10703},
+																		val:        "exists",
+																		ignoreCase: false,
+																	},
+																	&notExpr{
+																		pos: position{line: 555, col: 14, offset: 10712},
+																		expr: &charClassMatcher{
+																			pos:        position{line: 555, col: 15, offset: 10713},
+																			val:        "[_0-9\\pL]",
+																			chars:      []rune{'_'},
+																			ranges:     []rune{'0', '9'},
+																			classes:    []*unicode.RangeTable{rangeTable("L")},
+																			ignoreCase: false,
+																			inverted:   false,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												&charClassMatcher{
+													pos:        position{line: 510, col: 14, offset: 10115},
+													val:        "[_\\pL]",
+													chars:      []rune{'_'},
+													classes:    []*unicode.RangeTable{rangeTable("L")},
 													ignoreCase: false,
-													inverted:   true,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 510, col: 20, offset: 10121},
+													expr: &charClassMatcher{
+														pos:        position{line: 510, col: 20, offset: 10121},
+														val:        "[_0-9\\pL]",
+														chars:      []rune{'_'},
+														ranges:     []rune{'0', '9'},
+														classes:    []*unicode.RangeTable{rangeTable("L")},
+														ignoreCase: false,
+														inverted:   false,
+													},
 												},
-											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
 											},
 										},
 									},
 								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 117, col: 12, offset: 2409},
-							label: "args",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 117, col: 17, offset: 2414},
-								expr: &ruleRefExpr{
-									pos:  position{line: 117, col: 18, offset: 2415},
-									name: "ObjectProperties",
-								},
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
+												inverted:   false,
 											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
 												},
 											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
 										},
 									},
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 117, col: 40, offset: 2437},
-							val:        ")",
-							ignoreCase: false,
+					},
+				},
+			},
+		},
+		{
+			name: "ArrowFunctionBody",
+			pos:  position{line: 167, col: 1, offset: 3719},
+			expr: &choiceExpr{
+				pos: position{line: 168, col: 5, offset: 3741},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 168, col: 5, offset: 3741},
+						run: (*parser).callonArrowFunctionBody2,
+						expr: &labeledExpr{
+							pos:   position{line: 168, col: 5, offset: 3741},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 168, col: 10, offset: 3746},
+								name: "Expr",
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 171, col: 5, offset: 3786},
+						run: (*parser).callonArrowFunctionBody5,
+						expr: &labeledExpr{
+							pos:   position{line: 171, col: 5, offset: 3786},
+							label: "body",
+							expr: &ruleRefExpr{
+								pos:  position{line: 171, col: 10, offset: 3791},
+								name: "BlockStatement",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ArrowFunctionExpression",
-			pos:  position{line: 121, col: 1, offset: 2473},
+			name: "ObjectExpression",
+			pos:  position{line: 175, col: 1, offset: 3834},
 			expr: &actionExpr{
-				pos: position{line: 122, col: 5, offset: 2501},
-				run: (*parser).callonArrowFunctionExpression1,
+				pos: position{line: 176, col: 5, offset: 3855},
+				run: (*parser).callonObjectExpression1,
 				expr: &seqExpr{
-					pos: position{line: 122, col: 5, offset: 2501},
+					pos: position{line: 176, col: 5, offset: 3855},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 122, col: 5, offset: 2501},
-							val:        "(",
+							pos:        position{line: 176, col: 5, offset: 3855},
+							val:        "{",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2628,7 +6505,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2638,85 +6515,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 12, offset: 2508},
-							label: "params",
+							pos:   position{line: 176, col: 12, offset: 3862},
+							label: "object",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 122, col: 19, offset: 2515},
+								pos: position{line: 176, col: 19, offset: 3869},
 								expr: &ruleRefExpr{
-									pos:  position{line: 122, col: 19, offset: 2515},
-									name: "ArrowFunctionParams",
-								},
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
-										},
-									},
+									pos:  position{line: 176, col: 20, offset: 3870},
+									name: "ObjectProperties",
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 122, col: 43, offset: 2539},
-							val:        ")",
-							ignoreCase: false,
-						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2724,7 +6556,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2734,143 +6566,55 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 122, col: 50, offset: 2546},
-							val:        "=>",
+							pos:        position{line: 176, col: 42, offset: 3892},
+							val:        "}",
 							ignoreCase: false,
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
-										},
-									},
-								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 122, col: 58, offset: 2554},
-							label: "body",
-							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 63, offset: 2559},
-								name: "ArrowFunctionBody",
-							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
-							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
-								alternatives: []interface{}{
-									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
-										val:        "[ \\t\\r\\n]",
-										chars:      []rune{' ', '\t', '\r', '\n'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
-										exprs: []interface{}{
-											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
-												ignoreCase: false,
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
-										},
-									},
-								},
-							},
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "ArrowFunctionParams",
-			pos:  position{line: 126, col: 1, offset: 2646},
+			name: "ObjectProperties",
+			pos:  position{line: 180, col: 1, offset: 3926},
 			expr: &actionExpr{
-				pos: position{line: 127, col: 5, offset: 2670},
-				run: (*parser).callonArrowFunctionParams1,
+				pos: position{line: 181, col: 5, offset: 3947},
+				run: (*parser).callonObjectProperties1,
 				expr: &seqExpr{
-					pos: position{line: 127, col: 5, offset: 2670},
+					pos: position{line: 181, col: 5, offset: 3947},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 127, col: 5, offset: 2670},
+							pos:   position{line: 181, col: 5, offset: 3947},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 127, col: 11, offset: 2676},
-								name: "ArrowFunctionParam",
+								pos:  position{line: 181, col: 11, offset: 3953},
+								name: "Property",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2878,7 +6622,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2888,66 +6632,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 127, col: 33, offset: 2698},
+							pos:   position{line: 181, col: 23, offset: 3965},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 127, col: 38, offset: 2703},
+								pos: position{line: 181, col: 28, offset: 3970},
 								expr: &ruleRefExpr{
-									pos:  position{line: 127, col: 38, offset: 2703},
-									name: "ArrowFunctionParamsRest",
+									pos:  position{line: 181, col: 28, offset: 3970},
+									name: "PropertiesRest",
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 127, col: 63, offset: 2728},
-							expr: &litMatcher{
-								pos:        position{line: 127, col: 63, offset: 2728},
-								val:        ",",
-								ignoreCase: false,
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ArrowFunctionParamsRest",
-			pos:  position{line: 131, col: 1, offset: 2813},
-			expr: &actionExpr{
-				pos: position{line: 132, col: 5, offset: 2841},
-				run: (*parser).callonArrowFunctionParamsRest1,
-				expr: &seqExpr{
-					pos: position{line: 132, col: 5, offset: 2841},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 132, col: 5, offset: 2841},
-							val:        ",",
-							ignoreCase: false,
-						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -2955,7 +6673,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -2964,38 +6682,56 @@ var g = &grammar{
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 132, col: 13, offset: 2849},
-							label: "arg",
-							expr: &ruleRefExpr{
-								pos:  position{line: 132, col: 17, offset: 2853},
-								name: "ArrowFunctionParam",
+						&zeroOrOneExpr{
+							pos: position{line: 181, col: 47, offset: 3989},
+							expr: &litMatcher{
+								pos:        position{line: 181, col: 47, offset: 3989},
+								val:        ",",
+								ignoreCase: false,
 							},
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "PropertiesRest",
+			pos:  position{line: 185, col: 1, offset: 4055},
+			expr: &actionExpr{
+				pos: position{line: 186, col: 5, offset: 4074},
+				run: (*parser).callonPropertiesRest1,
+				expr: &seqExpr{
+					pos: position{line: 186, col: 5, offset: 4074},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 186, col: 5, offset: 4074},
+							val:        ",",
+							ignoreCase: false,
+						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3003,7 +6739,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3012,347 +6748,312 @@ var g = &grammar{
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 186, col: 13, offset: 4082},
+							label: "arg",
+							expr: &ruleRefExpr{
+								pos:  position{line: 186, col: 17, offset: 4086},
+								name: "Property",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ArrowFunctionParam",
-			pos:  position{line: 136, col: 1, offset: 2906},
-			expr: &choiceExpr{
-				pos: position{line: 137, col: 5, offset: 2929},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 137, col: 5, offset: 2929},
-						run: (*parser).callonArrowFunctionParam2,
-						expr: &seqExpr{
-							pos: position{line: 137, col: 5, offset: 2929},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 137, col: 5, offset: 2929},
-									label: "key",
-									expr: &actionExpr{
-										pos: position{line: 460, col: 5, offset: 8638},
-										run: (*parser).callonArrowFunctionParam5,
-										expr: &seqExpr{
-											pos: position{line: 460, col: 5, offset: 8638},
-											exprs: []interface{}{
-												&charClassMatcher{
-													pos:        position{line: 460, col: 5, offset: 8638},
-													val:        "[_\\pL]",
-													chars:      []rune{'_'},
-													classes:    []*unicode.RangeTable{rangeTable("L")},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 460, col: 11, offset: 8644},
-													expr: &charClassMatcher{
-														pos:        position{line: 460, col: 11, offset: 8644},
-														val:        "[_0-9\\pL]",
-														chars:      []rune{'_'},
-														ranges:     []rune{'0', '9'},
-														classes:    []*unicode.RangeTable{rangeTable("L")},
-														ignoreCase: false,
-														inverted:   false,
+			name: "Property",
+			pos:  position{line: 190, col: 1, offset: 4126},
+			expr: &actionExpr{
+				pos: position{line: 191, col: 5, offset: 4139},
+				run: (*parser).callonProperty1,
+				expr: &seqExpr{
+					pos: position{line: 191, col: 5, offset: 4139},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 191, col: 5, offset: 4139},
+							label: "key",
+							expr: &actionExpr{
+								pos: position{line: 510, col: 5, offset: 10106},
+								run: (*parser).callonProperty4,
+								expr: &seqExpr{
+									pos: position{line: 510, col: 5, offset: 10106},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 510, col: 5, offset: 10106},
+											expr: &choiceExpr{
+												pos: position{line: 516, col: 5, offset: 10193},
+												alternatives: []interface{}{
+													&seqExpr{
+														pos: position{line: 528, col: 5, offset: 10377},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 528, col: 5, offset: 10377},
+																val:        "package",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 528, col: 15, offset: 10387},
+																expr: &charClassMatcher{
+																	pos:        position{line: 528, col: 16, offset: 10388},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-												},
-											},
-										},
-									},
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 531, col: 5, offset: 10417},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 531, col: 5, offset: 10417},
+																val:        "import",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 531, col: 14, offset: 10426},
+																expr: &charClassMatcher{
+																	pos:        position{line: 531, col: 15, offset: 10427},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
+													&seqExpr{
+														pos: position{line: 534, col: 5, offset: 10456},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 534, col: 5, offset: 10456},
+																val:        "option",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 534, col: 14, offset: 10465},
+																expr: &charClassMatcher{
+																	pos:        position{line: 534, col: 15, offset: 10466},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 537, col: 5, offset: 10495},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 537, col: 5, offset: 10495},
+																val:        "return",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 537, col: 14, offset: 10504},
+																expr: &charClassMatcher{
+																	pos:        position{line: 537, col: 15, offset: 10505},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-												},
-											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 137, col: 23, offset: 2947},
-									val:        "=",
-									ignoreCase: false,
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 540, col: 5, offset: 10530},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 540, col: 5, offset: 10530},
+																val:        "if",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 540, col: 10, offset: 10535},
+																expr: &charClassMatcher{
+																	pos:        position{line: 540, col: 11, offset: 10536},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
+													&seqExpr{
+														pos: position{line: 543, col: 5, offset: 10563},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 543, col: 5, offset: 10563},
+																val:        "then",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 543, col: 12, offset: 10570},
+																expr: &charClassMatcher{
+																	pos:        position{line: 543, col: 13, offset: 10571},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 546, col: 5, offset: 10598},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 546, col: 5, offset: 10598},
+																val:        "else",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 546, col: 12, offset: 10605},
+																expr: &charClassMatcher{
+																	pos:        position{line: 546, col: 13, offset: 10606},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-												},
-											},
-										},
-									},
-								},
-								&labeledExpr{
-									pos:   position{line: 137, col: 30, offset: 2954},
-									label: "value",
-									expr: &ruleRefExpr{
-										pos:  position{line: 137, col: 36, offset: 2960},
-										name: "Primary",
-									},
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
-												ignoreCase: false,
-												inverted:   false,
-											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
+													&seqExpr{
+														pos: position{line: 549, col: 5, offset: 10631},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 549, col: 5, offset: 10631},
+																val:        "in",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 549, col: 10, offset: 10636},
+																expr: &charClassMatcher{
+																	pos:        position{line: 549, col: 11, offset: 10637},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
+													&seqExpr{
+														pos: position{line: 552, col: 5, offset: 10665},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 552, col: 5, offset: 10665},
+																val:        "empty",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 552, col: 13, offset: 10673},
+																expr: &charClassMatcher{
+																	pos:        position{line: 552, col: 14, offset: 10674},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
+													},
+													&seqExpr{
+														pos: position{line: 555, col: 5, offset: 10703},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 555, col: 5, offset: 10703},
+																val:        "exists",
+																ignoreCase: false,
+															},
+															&notExpr{
+																pos: position{line: 555, col: 14, offset: 10712},
+																expr: &charClassMatcher{
+																	pos:        position{line: 555, col: 15, offset: 10713},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
 														},
 													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
-													},
 												},
 											},
 										},
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 140, col: 5, offset: 3033},
-						run: (*parser).callonArrowFunctionParam37,
-						expr: &seqExpr{
-							pos: position{line: 140, col: 5, offset: 3033},
-							exprs: []interface{}{
-								&labeledExpr{
-									pos:   position{line: 140, col: 5, offset: 3033},
-									label: "key",
-									expr: &actionExpr{
-										pos: position{line: 460, col: 5, offset: 8638},
-										run: (*parser).callonArrowFunctionParam40,
-										expr: &seqExpr{
-											pos: position{line: 460, col: 5, offset: 8638},
-											exprs: []interface{}{
-												&charClassMatcher{
-													pos:        position{line: 460, col: 5, offset: 8638},
-													val:        "[_\\pL]",
-													chars:      []rune{'_'},
-													classes:    []*unicode.RangeTable{rangeTable("L")},
-													ignoreCase: false,
-													inverted:   false,
-												},
-												&zeroOrMoreExpr{
-													pos: position{line: 460, col: 11, offset: 8644},
-													expr: &charClassMatcher{
-														pos:        position{line: 460, col: 11, offset: 8644},
-														val:        "[_0-9\\pL]",
-														chars:      []rune{'_'},
-														ranges:     []rune{'0', '9'},
-														classes:    []*unicode.RangeTable{rangeTable("L")},
-														ignoreCase: false,
-														inverted:   false,
-													},
-												},
-											},
+										&charClassMatcher{
+											pos:        position{line: 510, col: 14, offset: 10115},
+											val:        "[_\\pL]",
+											chars:      []rune{'_'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
 										},
-									},
-								},
-								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
-									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
-										alternatives: []interface{}{
-											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
-												val:        "[ \\t\\r\\n]",
-												chars:      []rune{' ', '\t', '\r', '\n'},
+										&zeroOrMoreExpr{
+											pos: position{line: 510, col: 20, offset: 10121},
+											expr: &charClassMatcher{
+												pos:        position{line: 510, col: 20, offset: 10121},
+												val:        "[_0-9\\pL]",
+												chars:      []rune{'_'},
+												ranges:     []rune{'0', '9'},
+												classes:    []*unicode.RangeTable{rangeTable("L")},
 												ignoreCase: false,
 												inverted:   false,
 											},
-											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
-												exprs: []interface{}{
-													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
-														val:        "//",
-														ignoreCase: false,
-													},
-													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
-														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
-															val:        "[^\\r\\n]",
-															chars:      []rune{'\r', '\n'},
-															ignoreCase: false,
-															inverted:   true,
-														},
-													},
-													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
-														val:        "\n",
-														ignoreCase: false,
-													},
-												},
-											},
 										},
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-		},
-		{
-			name: "ArrowFunctionBody",
-			pos:  position{line: 145, col: 1, offset: 3109},
-			expr: &choiceExpr{
-				pos: position{line: 146, col: 5, offset: 3131},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 146, col: 5, offset: 3131},
-						run: (*parser).callonArrowFunctionBody2,
-						expr: &labeledExpr{
-							pos:   position{line: 146, col: 5, offset: 3131},
-							label: "body",
-							expr: &ruleRefExpr{
-								pos:  position{line: 146, col: 10, offset: 3136},
-								name: "Expr",
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 149, col: 5, offset: 3176},
-						run: (*parser).callonArrowFunctionBody5,
-						expr: &labeledExpr{
-							pos:   position{line: 149, col: 5, offset: 3176},
-							label: "body",
-							expr: &ruleRefExpr{
-								pos:  position{line: 149, col: 10, offset: 3181},
-								name: "BlockStatement",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "ObjectExpression",
-			pos:  position{line: 153, col: 1, offset: 3224},
-			expr: &actionExpr{
-				pos: position{line: 154, col: 5, offset: 3245},
-				run: (*parser).callonObjectExpression1,
-				expr: &seqExpr{
-					pos: position{line: 154, col: 5, offset: 3245},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 154, col: 5, offset: 3245},
-							val:        "{",
-							ignoreCase: false,
-						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3360,7 +7061,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3369,41 +7070,35 @@ var g = &grammar{
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 154, col: 12, offset: 3252},
-							label: "object",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 154, col: 19, offset: 3259},
-								expr: &ruleRefExpr{
-									pos:  position{line: 154, col: 20, offset: 3260},
-									name: "ObjectProperties",
-								},
-							},
+						&litMatcher{
+							pos:        position{line: 191, col: 24, offset: 4158},
+							val:        ":",
+							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3411,7 +7106,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3420,56 +7115,85 @@ var g = &grammar{
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 154, col: 42, offset: 3282},
-							val:        "}",
-							ignoreCase: false,
+						&labeledExpr{
+							pos:   position{line: 191, col: 31, offset: 4165},
+							label: "value",
+							expr: &ruleRefExpr{
+								pos:  position{line: 191, col: 37, offset: 4171},
+								name: "Expr",
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ObjectProperties",
-			pos:  position{line: 158, col: 1, offset: 3316},
+			name: "Expr",
+			pos:  position{line: 202, col: 1, offset: 4421},
+			expr: &choiceExpr{
+				pos: position{line: 203, col: 5, offset: 4430},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 203, col: 5, offset: 4430},
+						name: "ConditionalExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 204, col: 5, offset: 4456},
+						name: "LogicalExpression",
+					},
+				},
+			},
+		},
+		{
+			name: "ConditionalExpression",
+			pos:  position{line: 206, col: 1, offset: 4475},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 5, offset: 3337},
-				run: (*parser).callonObjectProperties1,
+				pos: position{line: 207, col: 5, offset: 4501},
+				run: (*parser).callonConditionalExpression1,
 				expr: &seqExpr{
-					pos: position{line: 159, col: 5, offset: 3337},
+					pos: position{line: 207, col: 5, offset: 4501},
 					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 159, col: 5, offset: 3337},
-							label: "first",
-							expr: &ruleRefExpr{
-								pos:  position{line: 159, col: 11, offset: 3343},
-								name: "Property",
+						&litMatcher{
+							pos:        position{line: 540, col: 5, offset: 10530},
+							val:        "if",
+							ignoreCase: false,
+						},
+						&notExpr{
+							pos: position{line: 540, col: 10, offset: 10535},
+							expr: &charClassMatcher{
+								pos:        position{line: 540, col: 11, offset: 10536},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3477,7 +7201,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3487,40 +7211,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 159, col: 23, offset: 3355},
-							label: "rest",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 159, col: 28, offset: 3360},
-								expr: &ruleRefExpr{
-									pos:  position{line: 159, col: 28, offset: 3360},
-									name: "PropertiesRest",
-								},
+							pos:   position{line: 207, col: 18, offset: 4514},
+							label: "test",
+							expr: &ruleRefExpr{
+								pos:  position{line: 207, col: 23, offset: 4519},
+								name: "Expr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3528,7 +7249,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3537,56 +7258,47 @@ var g = &grammar{
 								},
 							},
 						},
-						&zeroOrOneExpr{
-							pos: position{line: 159, col: 47, offset: 3379},
-							expr: &litMatcher{
-								pos:        position{line: 159, col: 47, offset: 3379},
-								val:        ",",
-								ignoreCase: false,
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "PropertiesRest",
-			pos:  position{line: 163, col: 1, offset: 3445},
-			expr: &actionExpr{
-				pos: position{line: 164, col: 5, offset: 3464},
-				run: (*parser).callonPropertiesRest1,
-				expr: &seqExpr{
-					pos: position{line: 164, col: 5, offset: 3464},
-					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 164, col: 5, offset: 3464},
-							val:        ",",
+							pos:        position{line: 543, col: 5, offset: 10563},
+							val:        "then",
 							ignoreCase: false,
 						},
+						&notExpr{
+							pos: position{line: 543, col: 12, offset: 10570},
+							expr: &charClassMatcher{
+								pos:        position{line: 543, col: 13, offset: 10571},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3594,7 +7306,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3604,83 +7316,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 164, col: 13, offset: 3472},
-							label: "arg",
+							pos:   position{line: 207, col: 46, offset: 4542},
+							label: "consequent",
 							expr: &ruleRefExpr{
-								pos:  position{line: 164, col: 17, offset: 3476},
-								name: "Property",
-							},
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "Property",
-			pos:  position{line: 168, col: 1, offset: 3516},
-			expr: &actionExpr{
-				pos: position{line: 169, col: 5, offset: 3529},
-				run: (*parser).callonProperty1,
-				expr: &seqExpr{
-					pos: position{line: 169, col: 5, offset: 3529},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 169, col: 5, offset: 3529},
-							label: "key",
-							expr: &actionExpr{
-								pos: position{line: 460, col: 5, offset: 8638},
-								run: (*parser).callonProperty4,
-								expr: &seqExpr{
-									pos: position{line: 460, col: 5, offset: 8638},
-									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 460, col: 5, offset: 8638},
-											val:        "[_\\pL]",
-											chars:      []rune{'_'},
-											classes:    []*unicode.RangeTable{rangeTable("L")},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 460, col: 11, offset: 8644},
-											expr: &charClassMatcher{
-												pos:        position{line: 460, col: 11, offset: 8644},
-												val:        "[_0-9\\pL]",
-												chars:      []rune{'_'},
-												ranges:     []rune{'0', '9'},
-												classes:    []*unicode.RangeTable{rangeTable("L")},
-												ignoreCase: false,
-												inverted:   false,
-											},
-										},
-									},
-								},
+								pos:  position{line: 207, col: 57, offset: 4553},
+								name: "Expr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3688,7 +7354,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3698,34 +7364,46 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 169, col: 24, offset: 3548},
-							val:        ":",
+							pos:        position{line: 546, col: 5, offset: 10598},
+							val:        "else",
 							ignoreCase: false,
 						},
+						&notExpr{
+							pos: position{line: 546, col: 12, offset: 10605},
+							expr: &charClassMatcher{
+								pos:        position{line: 546, col: 13, offset: 10606},
+								val:        "[_0-9\\pL]",
+								chars:      []rune{'_'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -3733,7 +7411,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -3743,10 +7421,10 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 169, col: 31, offset: 3555},
-							label: "value",
+							pos:   position{line: 207, col: 80, offset: 4576},
+							label: "alternate",
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 37, offset: 3561},
+								pos:  position{line: 207, col: 90, offset: 4586},
 								name: "Expr",
 							},
 						},
@@ -3754,63 +7432,55 @@ var g = &grammar{
 				},
 			},
 		},
-		{
-			name: "Expr",
-			pos:  position{line: 180, col: 1, offset: 3811},
-			expr: &ruleRefExpr{
-				pos:  position{line: 181, col: 5, offset: 3820},
-				name: "LogicalExpression",
-			},
-		},
 		{
 			name: "LogicalExpression",
-			pos:  position{line: 188, col: 1, offset: 3921},
+			pos:  position{line: 216, col: 1, offset: 4761},
 			expr: &actionExpr{
-				pos: position{line: 189, col: 5, offset: 3943},
+				pos: position{line: 217, col: 5, offset: 4783},
 				run: (*parser).callonLogicalExpression1,
 				expr: &seqExpr{
-					pos: position{line: 189, col: 5, offset: 3943},
+					pos: position{line: 217, col: 5, offset: 4783},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 189, col: 5, offset: 3943},
+							pos:   position{line: 217, col: 5, offset: 4783},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 189, col: 10, offset: 3948},
+								pos:  position{line: 217, col: 10, offset: 4788},
 								name: "Equality",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 189, col: 19, offset: 3957},
+							pos:   position{line: 217, col: 19, offset: 4797},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 189, col: 24, offset: 3962},
+								pos: position{line: 217, col: 24, offset: 4802},
 								expr: &seqExpr{
-									pos: position{line: 189, col: 26, offset: 3964},
+									pos: position{line: 217, col: 26, offset: 4804},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -3818,7 +7488,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -3828,18 +7498,18 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 184, col: 5, offset: 3860},
+											pos: position{line: 212, col: 5, offset: 4700},
 											run: (*parser).callonLogicalExpression16,
 											expr: &choiceExpr{
-												pos: position{line: 184, col: 6, offset: 3861},
+												pos: position{line: 212, col: 6, offset: 4701},
 												alternatives: []interface{}{
 													&litMatcher{
-														pos:        position{line: 184, col: 6, offset: 3861},
+														pos:        position{line: 212, col: 6, offset: 4701},
 														val:        "or",
 														ignoreCase: true,
 													},
 													&litMatcher{
-														pos:        position{line: 184, col: 14, offset: 3869},
+														pos:        position{line: 212, col: 14, offset: 4709},
 														val:        "and",
 														ignoreCase: true,
 													},
@@ -3847,29 +7517,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -3877,7 +7547,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -3887,7 +7557,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 189, col: 51, offset: 3989},
+											pos:  position{line: 217, col: 51, offset: 4829},
 											name: "Equality",
 										},
 									},
@@ -3900,53 +7570,53 @@ var g = &grammar{
 		},
 		{
 			name: "Equality",
-			pos:  position{line: 198, col: 1, offset: 4157},
+			pos:  position{line: 226, col: 1, offset: 4997},
 			expr: &actionExpr{
-				pos: position{line: 199, col: 5, offset: 4170},
+				pos: position{line: 227, col: 5, offset: 5010},
 				run: (*parser).callonEquality1,
 				expr: &seqExpr{
-					pos: position{line: 199, col: 5, offset: 4170},
+					pos: position{line: 227, col: 5, offset: 5010},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 199, col: 5, offset: 4170},
+							pos:   position{line: 227, col: 5, offset: 5010},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 199, col: 10, offset: 4175},
+								pos:  position{line: 227, col: 10, offset: 5015},
 								name: "Relational",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 199, col: 21, offset: 4186},
+							pos:   position{line: 227, col: 21, offset: 5026},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 199, col: 26, offset: 4191},
+								pos: position{line: 227, col: 26, offset: 5031},
 								expr: &seqExpr{
-									pos: position{line: 199, col: 28, offset: 4193},
+									pos: position{line: 227, col: 28, offset: 5033},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -3954,7 +7624,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -3964,28 +7634,28 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 194, col: 5, offset: 4090},
+											pos: position{line: 222, col: 5, offset: 4930},
 											run: (*parser).callonEquality16,
 											expr: &choiceExpr{
-												pos: position{line: 194, col: 6, offset: 4091},
+												pos: position{line: 222, col: 6, offset: 4931},
 												alternatives: []interface{}{
 													&litMatcher{
-														pos:        position{line: 194, col: 6, offset: 4091},
+														pos:        position{line: 222, col: 6, offset: 4931},
 														val:        "==",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 194, col: 13, offset: 4098},
+														pos:        position{line: 222, col: 13, offset: 4938},
 														val:        "!=",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 194, col: 20, offset: 4105},
+														pos:        position{line: 222, col: 20, offset: 4945},
 														val:        "=~",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 194, col: 27, offset: 4112},
+														pos:        position{line: 222, col: 27, offset: 4952},
 														val:        "!~",
 														ignoreCase: false,
 													},
@@ -3993,29 +7663,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4023,7 +7693,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4033,7 +7703,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 199, col: 52, offset: 4217},
+											pos:  position{line: 227, col: 52, offset: 5057},
 											name: "Relational",
 										},
 									},
@@ -4046,53 +7716,53 @@ var g = &grammar{
 		},
 		{
 			name: "Relational",
-			pos:  position{line: 216, col: 1, offset: 4490},
+			pos:  position{line: 242, col: 1, offset: 5303},
 			expr: &actionExpr{
-				pos: position{line: 217, col: 5, offset: 4505},
+				pos: position{line: 243, col: 5, offset: 5318},
 				run: (*parser).callonRelational1,
 				expr: &seqExpr{
-					pos: position{line: 217, col: 5, offset: 4505},
+					pos: position{line: 243, col: 5, offset: 5318},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 217, col: 5, offset: 4505},
+							pos:   position{line: 243, col: 5, offset: 5318},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 217, col: 10, offset: 4510},
+								pos:  position{line: 243, col: 10, offset: 5323},
 								name: "Additive",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 217, col: 19, offset: 4519},
+							pos:   position{line: 243, col: 19, offset: 5332},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 217, col: 24, offset: 4524},
+								pos: position{line: 243, col: 24, offset: 5337},
 								expr: &seqExpr{
-									pos: position{line: 217, col: 26, offset: 4526},
+									pos: position{line: 243, col: 26, offset: 5339},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4100,7 +7770,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4110,78 +7780,85 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 204, col: 5, offset: 4321},
+											pos: position{line: 232, col: 5, offset: 5161},
 											run: (*parser).callonRelational16,
 											expr: &choiceExpr{
-												pos: position{line: 204, col: 9, offset: 4325},
+												pos: position{line: 232, col: 9, offset: 5165},
 												alternatives: []interface{}{
 													&litMatcher{
-														pos:        position{line: 204, col: 9, offset: 4325},
+														pos:        position{line: 232, col: 9, offset: 5165},
 														val:        "<=",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 205, col: 9, offset: 4338},
+														pos:        position{line: 233, col: 9, offset: 5178},
 														val:        "<",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 206, col: 9, offset: 4350},
+														pos:        position{line: 234, col: 9, offset: 5190},
 														val:        ">=",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 207, col: 9, offset: 4363},
+														pos:        position{line: 235, col: 9, offset: 5203},
 														val:        ">",
 														ignoreCase: false,
 													},
 													&litMatcher{
-														pos:        position{line: 208, col: 9, offset: 4375},
+														pos:        position{line: 236, col: 9, offset: 5215},
 														val:        "startswith",
 														ignoreCase: true,
 													},
-													&litMatcher{
-														pos:        position{line: 209, col: 9, offset: 4397},
-														val:        "in",
-														ignoreCase: true,
-													},
-													&litMatcher{
-														pos:        position{line: 210, col: 9, offset: 4411},
-														val:        "not empty",
-														ignoreCase: true,
-													},
-													&litMatcher{
-														pos:        position{line: 211, col: 9, offset: 4432},
-														val:        "empty",
-														ignoreCase: true,
+													&seqExpr{
+														pos: position{line: 237, col: 9, offset: 5237},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 237, col: 9, offset: 5237},
+																val:        "in",
+																ignoreCase: true,
+															},
+															&notExpr{
+																pos: position{line: 237, col: 15, offset: 5243},
+																expr: &charClassMatcher{
+																	pos:        position{line: 237, col: 16, offset: 5244},
+																	val:        "[_0-9\\pL]",
+																	chars:      []rune{'_'},
+																	ranges:     []rune{'0', '9'},
+																	classes:    []*unicode.RangeTable{rangeTable("L")},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+															},
+														},
 													},
 												},
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4189,7 +7866,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4199,7 +7876,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 217, col: 52, offset: 4552},
+											pos:  position{line: 243, col: 52, offset: 5365},
 											name: "Additive",
 										},
 									},
@@ -4212,53 +7889,53 @@ var g = &grammar{
 		},
 		{
 			name: "Additive",
-			pos:  position{line: 226, col: 1, offset: 4706},
+			pos:  position{line: 252, col: 1, offset: 5519},
 			expr: &actionExpr{
-				pos: position{line: 227, col: 5, offset: 4719},
+				pos: position{line: 253, col: 5, offset: 5532},
 				run: (*parser).callonAdditive1,
 				expr: &seqExpr{
-					pos: position{line: 227, col: 5, offset: 4719},
+					pos: position{line: 253, col: 5, offset: 5532},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 227, col: 5, offset: 4719},
+							pos:   position{line: 253, col: 5, offset: 5532},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 227, col: 10, offset: 4724},
+								pos:  position{line: 253, col: 10, offset: 5537},
 								name: "Multiplicative",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 227, col: 25, offset: 4739},
+							pos:   position{line: 253, col: 25, offset: 5552},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 227, col: 30, offset: 4744},
+								pos: position{line: 253, col: 30, offset: 5557},
 								expr: &seqExpr{
-									pos: position{line: 227, col: 32, offset: 4746},
+									pos: position{line: 253, col: 32, offset: 5559},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4266,7 +7943,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4276,10 +7953,10 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 222, col: 5, offset: 4651},
+											pos: position{line: 248, col: 5, offset: 5464},
 											run: (*parser).callonAdditive16,
 											expr: &charClassMatcher{
-												pos:        position{line: 222, col: 6, offset: 4652},
+												pos:        position{line: 248, col: 6, offset: 5465},
 												val:        "[+-]",
 												chars:      []rune{'+', '-'},
 												ignoreCase: false,
@@ -4287,29 +7964,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4317,7 +7994,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4327,7 +8004,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 227, col: 55, offset: 4769},
+											pos:  position{line: 253, col: 55, offset: 5582},
 											name: "Multiplicative",
 										},
 									},
@@ -4340,53 +8017,53 @@ var g = &grammar{
 		},
 		{
 			name: "Multiplicative",
-			pos:  position{line: 236, col: 1, offset: 4931},
+			pos:  position{line: 262, col: 1, offset: 5744},
 			expr: &actionExpr{
-				pos: position{line: 237, col: 5, offset: 4950},
+				pos: position{line: 263, col: 5, offset: 5763},
 				run: (*parser).callonMultiplicative1,
 				expr: &seqExpr{
-					pos: position{line: 237, col: 5, offset: 4950},
+					pos: position{line: 263, col: 5, offset: 5763},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 237, col: 5, offset: 4950},
+							pos:   position{line: 263, col: 5, offset: 5763},
 							label: "head",
 							expr: &ruleRefExpr{
-								pos:  position{line: 237, col: 10, offset: 4955},
+								pos:  position{line: 263, col: 10, offset: 5768},
 								name: "UnaryExpression",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 237, col: 26, offset: 4971},
+							pos:   position{line: 263, col: 26, offset: 5784},
 							label: "tail",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 237, col: 31, offset: 4976},
+								pos: position{line: 263, col: 31, offset: 5789},
 								expr: &seqExpr{
-									pos: position{line: 237, col: 33, offset: 4978},
+									pos: position{line: 263, col: 33, offset: 5791},
 									exprs: []interface{}{
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4394,7 +8071,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4404,10 +8081,10 @@ var g = &grammar{
 											},
 										},
 										&actionExpr{
-											pos: position{line: 232, col: 5, offset: 4880},
+											pos: position{line: 258, col: 5, offset: 5693},
 											run: (*parser).callonMultiplicative16,
 											expr: &charClassMatcher{
-												pos:        position{line: 232, col: 6, offset: 4881},
+												pos:        position{line: 258, col: 6, offset: 5694},
 												val:        "[*/]",
 												chars:      []rune{'*', '/'},
 												ignoreCase: false,
@@ -4415,29 +8092,29 @@ var g = &grammar{
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 468, col: 5, offset: 8728},
+											pos: position{line: 560, col: 5, offset: 10748},
 											expr: &choiceExpr{
-												pos: position{line: 468, col: 7, offset: 8730},
+												pos: position{line: 560, col: 7, offset: 10750},
 												alternatives: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 474, col: 5, offset: 8791},
+														pos:        position{line: 566, col: 5, offset: 10811},
 														val:        "[ \\t\\r\\n]",
 														chars:      []rune{' ', '\t', '\r', '\n'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&seqExpr{
-														pos: position{line: 471, col: 5, offset: 8765},
+														pos: position{line: 563, col: 5, offset: 10785},
 														exprs: []interface{}{
 															&litMatcher{
-																pos:        position{line: 471, col: 5, offset: 8765},
+																pos:        position{line: 563, col: 5, offset: 10785},
 																val:        "//",
 																ignoreCase: false,
 															},
 															&zeroOrMoreExpr{
-																pos: position{line: 471, col: 10, offset: 8770},
+																pos: position{line: 563, col: 10, offset: 10790},
 																expr: &charClassMatcher{
-																	pos:        position{line: 471, col: 10, offset: 8770},
+																	pos:        position{line: 563, col: 10, offset: 10790},
 																	val:        "[^\\r\\n]",
 																	chars:      []rune{'\r', '\n'},
 																	ignoreCase: false,
@@ -4445,7 +8122,7 @@ var g = &grammar{
 																},
 															},
 															&litMatcher{
-																pos:        position{line: 480, col: 5, offset: 8837},
+																pos:        position{line: 572, col: 5, offset: 10857},
 																val:        "\n",
 																ignoreCase: false,
 															},
@@ -4455,7 +8132,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 237, col: 62, offset: 5007},
+											pos:  position{line: 263, col: 62, offset: 5820},
 											name: "UnaryExpression",
 										},
 									},
@@ -4468,40 +8145,40 @@ var g = &grammar{
 		},
 		{
 			name: "UnaryExpression",
-			pos:  position{line: 246, col: 1, offset: 5163},
+			pos:  position{line: 272, col: 1, offset: 6032},
 			expr: &choiceExpr{
-				pos: position{line: 247, col: 5, offset: 5183},
+				pos: position{line: 273, col: 5, offset: 6052},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 247, col: 5, offset: 5183},
+						pos: position{line: 273, col: 5, offset: 6052},
 						run: (*parser).callonUnaryExpression2,
 						expr: &seqExpr{
-							pos: position{line: 247, col: 5, offset: 5183},
+							pos: position{line: 273, col: 5, offset: 6052},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4509,7 +8186,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4519,52 +8196,133 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 247, col: 8, offset: 5186},
+									pos:   position{line: 273, col: 8, offset: 6055},
 									label: "op",
 									expr: &actionExpr{
-										pos: position{line: 242, col: 5, offset: 5110},
+										pos: position{line: 268, col: 5, offset: 5923},
 										run: (*parser).callonUnaryExpression13,
 										expr: &choiceExpr{
-											pos: position{line: 242, col: 6, offset: 5111},
+											pos: position{line: 268, col: 6, offset: 5924},
 											alternatives: []interface{}{
 												&litMatcher{
-													pos:        position{line: 242, col: 6, offset: 5111},
+													pos:        position{line: 268, col: 6, offset: 5924},
 													val:        "-",
 													ignoreCase: false,
 												},
+												&seqExpr{
+													pos: position{line: 268, col: 12, offset: 5930},
+													exprs: []interface{}{
+														&litMatcher{
+															pos:        position{line: 268, col: 12, offset: 5930},
+															val:        "not",
+															ignoreCase: false,
+														},
+														&oneOrMoreExpr{
+															pos: position{line: 268, col: 18, offset: 5936},
+															expr: &charClassMatcher{
+																pos:        position{line: 566, col: 5, offset: 10811},
+																val:        "[ \\t\\r\\n]",
+																chars:      []rune{' ', '\t', '\r', '\n'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+														&litMatcher{
+															pos:        position{line: 552, col: 5, offset: 10665},
+															val:        "empty",
+															ignoreCase: false,
+														},
+														&notExpr{
+															pos: position{line: 552, col: 13, offset: 10673},
+															expr: &charClassMatcher{
+																pos:        position{line: 552, col: 14, offset: 10674},
+																val:        "[_0-9\\pL]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'0', '9'},
+																classes:    []*unicode.RangeTable{rangeTable("L")},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
 												&litMatcher{
-													pos:        position{line: 242, col: 12, offset: 5117},
+													pos:        position{line: 268, col: 37, offset: 5955},
 													val:        "not",
 													ignoreCase: false,
 												},
+												&seqExpr{
+													pos: position{line: 552, col: 5, offset: 10665},
+													exprs: []interface{}{
+														&litMatcher{
+															pos:        position{line: 552, col: 5, offset: 10665},
+															val:        "empty",
+															ignoreCase: false,
+														},
+														&notExpr{
+															pos: position{line: 552, col: 13, offset: 10673},
+															expr: &charClassMatcher{
+																pos:        position{line: 552, col: 14, offset: 10674},
+																val:        "[_0-9\\pL]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'0', '9'},
+																classes:    []*unicode.RangeTable{rangeTable("L")},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
+												&seqExpr{
+													pos: position{line: 555, col: 5, offset: 10703},
+													exprs: []interface{}{
+														&litMatcher{
+															pos:        position{line: 555, col: 5, offset: 10703},
+															val:        "exists",
+															ignoreCase: false,
+														},
+														&notExpr{
+															pos: position{line: 555, col: 14, offset: 10712},
+															expr: &charClassMatcher{
+																pos:        position{line: 555, col: 15, offset: 10713},
+																val:        "[_0-9\\pL]",
+																chars:      []rune{'_'},
+																ranges:     []rune{'0', '9'},
+																classes:    []*unicode.RangeTable{rangeTable("L")},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+												},
 											},
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4572,7 +8330,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4582,37 +8340,37 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 247, col: 28, offset: 5206},
+									pos:   position{line: 273, col: 28, offset: 6075},
 									label: "argument",
 									expr: &ruleRefExpr{
-										pos:  position{line: 247, col: 37, offset: 5215},
-										name: "Primary",
+										pos:  position{line: 273, col: 37, offset: 6084},
+										name: "UnaryExpression",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4620,7 +8378,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4633,7 +8391,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 250, col: 5, offset: 5296},
+						pos:  position{line: 276, col: 5, offset: 6173},
 						name: "Primary",
 					},
 				},
@@ -4641,86 +8399,122 @@ var g = &grammar{
 		},
 		{
 			name: "Primary",
-			pos:  position{line: 252, col: 1, offset: 5305},
+			pos:  position{line: 278, col: 1, offset: 6182},
 			expr: &choiceExpr{
-				pos: position{line: 253, col: 5, offset: 5317},
+				pos: position{line: 279, col: 5, offset: 6194},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 253, col: 5, offset: 5317},
+						pos:  position{line: 279, col: 5, offset: 6194},
 						name: "PipeExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 254, col: 5, offset: 5336},
+						pos:  position{line: 280, col: 5, offset: 6213},
 						name: "Array",
 					},
 					&actionExpr{
-						pos: position{line: 378, col: 5, offset: 7123},
+						pos: position{line: 408, col: 5, offset: 8109},
 						run: (*parser).callonPrimary4,
 						expr: &seqExpr{
-							pos: position{line: 378, col: 7, offset: 7125},
+							pos: position{line: 408, col: 7, offset: 8111},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 378, col: 7, offset: 7125},
+									pos:        position{line: 408, col: 7, offset: 8111},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 378, col: 11, offset: 7129},
+									pos: position{line: 408, col: 11, offset: 8115},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 416, col: 5, offset: 8297},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
+												pos: position{line: 416, col: 5, offset: 8297},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
-														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
-															val:        "[\"\\\\\\n]",
-															chars:      []rune{'"', '\\', '\n'},
-															ignoreCase: false,
-															inverted:   false,
+														pos: position{line: 416, col: 5, offset: 8297},
+														expr: &choiceExpr{
+															pos: position{line: 416, col: 8, offset: 8300},
+															alternatives: []interface{}{
+																&charClassMatcher{
+																	pos:        position{line: 416, col: 8, offset: 8300},
+																	val:        "[\"\\\\\\n]",
+																	chars:      []rune{'"', '\\', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&litMatcher{
+																	pos:        position{line: 416, col: 27, offset: 8319},
+																	val:        "${",
+																	ignoreCase: false,
+																},
+															},
 														},
 													},
 													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
+														line: 558, col: 5, offset: 10739,
 													},
 												},
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 417, col: 5, offset: 8341},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
+														pos:        position{line: 417, col: 5, offset: 8341},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
+														pos: position{line: 420, col: 5, offset: 8389},
 														alternatives: []interface{}{
-															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
-																val:        "\"",
+															&charClassMatcher{
+																pos:        position{line: 420, col: 7, offset: 8391},
+																val:        "[\"\\\\$nrt]",
+																chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
 																ignoreCase: false,
+																inverted:   false,
+															},
+															&seqExpr{
+																pos: position{line: 420, col: 44, offset: 8428},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 420, col: 44, offset: 8428},
+																		val:        "x",
+																		ignoreCase: false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
 															},
 															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
-																run: (*parser).callonPrimary17,
+																pos: position{line: 421, col: 5, offset: 8456},
+																run: (*parser).callonPrimary23,
 																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
+																	pos: position{line: 421, col: 7, offset: 8458},
 																	alternatives: []interface{}{
 																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
+																			line: 558, col: 5, offset: 10739,
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 572, col: 5, offset: 10857},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
 																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
+																			pos: position{line: 575, col: 5, offset: 10871},
 																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
+																				line: 575, col: 6, offset: 10872,
 																			},
 																		},
 																	},
@@ -4734,7 +8528,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 378, col: 29, offset: 7147},
+									pos:        position{line: 408, col: 29, offset: 8133},
 									val:        "\"",
 									ignoreCase: false,
 								},
@@ -4742,73 +8536,109 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 381, col: 5, offset: 7207},
-						run: (*parser).callonPrimary24,
+						pos: position{line: 411, col: 5, offset: 8193},
+						run: (*parser).callonPrimary30,
 						expr: &seqExpr{
-							pos: position{line: 381, col: 7, offset: 7209},
+							pos: position{line: 411, col: 7, offset: 8195},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 381, col: 7, offset: 7209},
+									pos:        position{line: 411, col: 7, offset: 8195},
 									val:        "\"",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 381, col: 11, offset: 7213},
+									pos: position{line: 411, col: 11, offset: 8199},
 									expr: &choiceExpr{
-										pos: position{line: 386, col: 5, offset: 7338},
+										pos: position{line: 416, col: 5, offset: 8297},
 										alternatives: []interface{}{
 											&seqExpr{
-												pos: position{line: 386, col: 5, offset: 7338},
+												pos: position{line: 416, col: 5, offset: 8297},
 												exprs: []interface{}{
 													&notExpr{
-														pos: position{line: 386, col: 5, offset: 7338},
-														expr: &charClassMatcher{
-															pos:        position{line: 386, col: 8, offset: 7341},
-															val:        "[\"\\\\\\n]",
-															chars:      []rune{'"', '\\', '\n'},
-															ignoreCase: false,
-															inverted:   false,
+														pos: position{line: 416, col: 5, offset: 8297},
+														expr: &choiceExpr{
+															pos: position{line: 416, col: 8, offset: 8300},
+															alternatives: []interface{}{
+																&charClassMatcher{
+																	pos:        position{line: 416, col: 8, offset: 8300},
+																	val:        "[\"\\\\\\n]",
+																	chars:      []rune{'"', '\\', '\n'},
+																	ignoreCase: false,
+																	inverted:   false,
+																},
+																&litMatcher{
+																	pos:        position{line: 416, col: 27, offset: 8319},
+																	val:        "${",
+																	ignoreCase: false,
+																},
+															},
 														},
 													},
 													&anyMatcher{
-														line: 466, col: 5, offset: 8719,
+														line: 558, col: 5, offset: 10739,
 													},
 												},
 											},
 											&seqExpr{
-												pos: position{line: 387, col: 5, offset: 7375},
+												pos: position{line: 417, col: 5, offset: 8341},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 387, col: 5, offset: 7375},
+														pos:        position{line: 417, col: 5, offset: 8341},
 														val:        "\\",
 														ignoreCase: false,
 													},
 													&choiceExpr{
-														pos: position{line: 390, col: 5, offset: 7423},
+														pos: position{line: 420, col: 5, offset: 8389},
 														alternatives: []interface{}{
-															&litMatcher{
-																pos:        position{line: 390, col: 5, offset: 7423},
-																val:        "\"",
+															&charClassMatcher{
+																pos:        position{line: 420, col: 7, offset: 8391},
+																val:        "[\"\\\\$nrt]",
+																chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
 																ignoreCase: false,
+																inverted:   false,
+															},
+															&seqExpr{
+																pos: position{line: 420, col: 44, offset: 8428},
+																exprs: []interface{}{
+																	&litMatcher{
+																		pos:        position{line: 420, col: 44, offset: 8428},
+																		val:        "x",
+																		ignoreCase: false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																	&charClassMatcher{
+																		pos:        position{line: 426, col: 5, offset: 8562},
+																		val:        "[0-9a-fA-F]",
+																		ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																		ignoreCase: false,
+																		inverted:   false,
+																	},
+																},
 															},
 															&actionExpr{
-																pos: position{line: 391, col: 5, offset: 7431},
-																run: (*parser).callonPrimary37,
+																pos: position{line: 421, col: 5, offset: 8456},
+																run: (*parser).callonPrimary49,
 																expr: &choiceExpr{
-																	pos: position{line: 391, col: 7, offset: 7433},
+																	pos: position{line: 421, col: 7, offset: 8458},
 																	alternatives: []interface{}{
 																		&anyMatcher{
-																			line: 466, col: 5, offset: 8719,
+																			line: 558, col: 5, offset: 10739,
 																		},
 																		&litMatcher{
-																			pos:        position{line: 480, col: 5, offset: 8837},
+																			pos:        position{line: 572, col: 5, offset: 10857},
 																			val:        "\n",
 																			ignoreCase: false,
 																		},
 																		&notExpr{
-																			pos: position{line: 483, col: 5, offset: 8851},
+																			pos: position{line: 575, col: 5, offset: 10871},
 																			expr: &anyMatcher{
-																				line: 483, col: 6, offset: 8852,
+																				line: 575, col: 6, offset: 10872,
 																			},
 																		},
 																	},
@@ -4822,17 +8652,17 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 381, col: 31, offset: 7233},
+									pos: position{line: 411, col: 31, offset: 8219},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 480, col: 5, offset: 8837},
+											pos:        position{line: 572, col: 5, offset: 10857},
 											val:        "\n",
 											ignoreCase: false,
 										},
 										&notExpr{
-											pos: position{line: 483, col: 5, offset: 8851},
+											pos: position{line: 575, col: 5, offset: 10871},
 											expr: &anyMatcher{
-												line: 483, col: 6, offset: 8852,
+												line: 575, col: 6, offset: 10872,
 											},
 										},
 									},
@@ -4841,35 +8671,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 426, col: 5, offset: 8041},
-						run: (*parser).callonPrimary47,
+						pos: position{line: 475, col: 5, offset: 9481},
+						run: (*parser).callonPrimary59,
 						expr: &seqExpr{
-							pos: position{line: 426, col: 5, offset: 8041},
+							pos: position{line: 475, col: 5, offset: 9481},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4877,7 +8707,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4887,34 +8717,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 426, col: 8, offset: 8044},
+									pos:        position{line: 475, col: 8, offset: 9484},
 									val:        "true",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4922,7 +8752,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4935,35 +8765,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 429, col: 5, offset: 8115},
-						run: (*parser).callonPrimary66,
+						pos: position{line: 478, col: 5, offset: 9555},
+						run: (*parser).callonPrimary78,
 						expr: &seqExpr{
-							pos: position{line: 429, col: 5, offset: 8115},
+							pos: position{line: 478, col: 5, offset: 9555},
 							exprs: []interface{}{
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -4971,7 +8801,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -4981,34 +8811,34 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 429, col: 8, offset: 8118},
+									pos:        position{line: 478, col: 8, offset: 9558},
 									val:        "false",
 									ignoreCase: false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 468, col: 5, offset: 8728},
+									pos: position{line: 560, col: 5, offset: 10748},
 									expr: &choiceExpr{
-										pos: position{line: 468, col: 7, offset: 8730},
+										pos: position{line: 560, col: 7, offset: 10750},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 474, col: 5, offset: 8791},
+												pos:        position{line: 566, col: 5, offset: 10811},
 												val:        "[ \\t\\r\\n]",
 												chars:      []rune{' ', '\t', '\r', '\n'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&seqExpr{
-												pos: position{line: 471, col: 5, offset: 8765},
+												pos: position{line: 563, col: 5, offset: 10785},
 												exprs: []interface{}{
 													&litMatcher{
-														pos:        position{line: 471, col: 5, offset: 8765},
+														pos:        position{line: 563, col: 5, offset: 10785},
 														val:        "//",
 														ignoreCase: false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 471, col: 10, offset: 8770},
+														pos: position{line: 563, col: 10, offset: 10790},
 														expr: &charClassMatcher{
-															pos:        position{line: 471, col: 10, offset: 8770},
+															pos:        position{line: 563, col: 10, offset: 10790},
 															val:        "[^\\r\\n]",
 															chars:      []rune{'\r', '\n'},
 															ignoreCase: false,
@@ -5016,7 +8846,7 @@ var g = &grammar{
 														},
 													},
 													&litMatcher{
-														pos:        position{line: 480, col: 5, offset: 8837},
+														pos:        position{line: 572, col: 5, offset: 10857},
 														val:        "\n",
 														ignoreCase: false,
 													},
@@ -5029,40 +8859,40 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 397, col: 5, offset: 7543},
-						run: (*parser).callonPrimary85,
+						pos: position{line: 446, col: 5, offset: 8983},
+						run: (*parser).callonPrimary97,
 						expr: &seqExpr{
-							pos: position{line: 397, col: 5, offset: 7543},
+							pos: position{line: 446, col: 5, offset: 8983},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 397, col: 5, offset: 7543},
+									pos:        position{line: 446, col: 5, offset: 8983},
 									val:        "/",
 									ignoreCase: false,
 								},
 								&labeledExpr{
-									pos:   position{line: 397, col: 9, offset: 7547},
+									pos:   position{line: 446, col: 9, offset: 8987},
 									label: "pattern",
 									expr: &actionExpr{
-										pos: position{line: 402, col: 5, offset: 7624},
-										run: (*parser).callonPrimary89,
+										pos: position{line: 451, col: 5, offset: 9064},
+										run: (*parser).callonPrimary101,
 										expr: &labeledExpr{
-											pos:   position{line: 402, col: 5, offset: 7624},
+											pos:   position{line: 451, col: 5, offset: 9064},
 											label: "chars",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 402, col: 11, offset: 7630},
+												pos: position{line: 451, col: 11, offset: 9070},
 												expr: &choiceExpr{
-													pos: position{line: 407, col: 5, offset: 7714},
+													pos: position{line: 456, col: 5, offset: 9154},
 													alternatives: []interface{}{
 														&actionExpr{
-															pos: position{line: 407, col: 5, offset: 7714},
-															run: (*parser).callonPrimary93,
+															pos: position{line: 456, col: 5, offset: 9154},
+															run: (*parser).callonPrimary105,
 															expr: &seqExpr{
-																pos: position{line: 407, col: 5, offset: 7714},
+																pos: position{line: 456, col: 5, offset: 9154},
 																exprs: []interface{}{
 																	&notExpr{
-																		pos: position{line: 407, col: 5, offset: 7714},
+																		pos: position{line: 456, col: 5, offset: 9154},
 																		expr: &charClassMatcher{
-																			pos:        position{line: 407, col: 6, offset: 7715},
+																			pos:        position{line: 456, col: 6, offset: 9155},
 																			val:        "[\\\\/]",
 																			chars:      []rune{'\\', '/'},
 																			ignoreCase: false,
@@ -5070,18 +8900,18 @@ var g = &grammar{
 																		},
 																	},
 																	&labeledExpr{
-																		pos:   position{line: 407, col: 12, offset: 7721},
+																		pos:   position{line: 456, col: 12, offset: 9161},
 																		label: "re",
 																		expr: &actionExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
-																			run: (*parser).callonPrimary98,
+																			pos: position{line: 470, col: 5, offset: 9401},
+																			run: (*parser).callonPrimary110,
 																			expr: &seqExpr{
-																				pos: position{line: 421, col: 5, offset: 7961},
+																				pos: position{line: 470, col: 5, offset: 9401},
 																				exprs: []interface{}{
 																					&notExpr{
-																						pos: position{line: 421, col: 5, offset: 7961},
+																						pos: position{line: 470, col: 5, offset: 9401},
 																						expr: &charClassMatcher{
-																							pos:        position{line: 477, col: 5, offset: 8821},
+																							pos:        position{line: 569, col: 5, offset: 10841},
 																							val:        "[\\n\\r]",
 																							chars:      []rune{'\n', '\r'},
 																							ignoreCase: false,
@@ -5089,7 +8919,7 @@ var g = &grammar{
 																						},
 																					},
 																					&anyMatcher{
-																						line: 466, col: 5, offset: 8719,
+																						line: 558, col: 5, offset: 10739,
 																					},
 																				},
 																			},
@@ -5099,35 +8929,35 @@ var g = &grammar{
 															},
 														},
 														&actionExpr{
-															pos: position{line: 413, col: 5, offset: 7830},
-															run: (*parser).callonPrimary103,
+															pos: position{line: 462, col: 5, offset: 9270},
+															run: (*parser).callonPrimary115,
 															expr: &litMatcher{
-																pos:        position{line: 413, col: 5, offset: 7830},
+																pos:        position{line: 462, col: 5, offset: 9270},
 																val:        "\\/",
 																ignoreCase: false,
 															},
 														},
 														&actionExpr{
-															pos: position{line: 416, col: 5, offset: 7878},
-															run: (*parser).callonPrimary105,
+															pos: position{line: 465, col: 5, offset: 9318},
+															run: (*parser).callonPrimary117,
 															expr: &seqExpr{
-																pos: position{line: 416, col: 5, offset: 7878},
+																pos: position{line: 465, col: 5, offset: 9318},
 																exprs: []interface{}{
 																	&litMatcher{
-																		pos:        position{line: 416, col: 5, offset: 7878},
+																		pos:        position{line: 465, col: 5, offset: 9318},
 																		val:        "\\",
 																		ignoreCase: false,
 																	},
 																	&actionExpr{
-																		pos: position{line: 421, col: 5, offset: 7961},
-																		run: (*parser).callonPrimary108,
+																		pos: position{line: 470, col: 5, offset: 9401},
+																		run: (*parser).callonPrimary120,
 																		expr: &seqExpr{
-																			pos: position{line: 421, col: 5, offset: 7961},
+																			pos: position{line: 470, col: 5, offset: 9401},
 																			exprs: []interface{}{
 																				&notExpr{
-																					pos: position{line: 421, col: 5, offset: 7961},
+																					pos: position{line: 470, col: 5, offset: 9401},
 																					expr: &charClassMatcher{
-																						pos:        position{line: 477, col: 5, offset: 8821},
+																						pos:        position{line: 569, col: 5, offset: 10841},
 																						val:        "[\\n\\r]",
 																						chars:      []rune{'\n', '\r'},
 																						ignoreCase: false,
@@ -5135,7 +8965,7 @@ var g = &grammar{
 																					},
 																				},
 																				&anyMatcher{
-																					line: 466, col: 5, offset: 8719,
+																					line: 558, col: 5, offset: 10739,
 																				},
 																			},
 																		},
@@ -5150,7 +8980,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 397, col: 28, offset: 7566},
+									pos:        position{line: 446, col: 28, offset: 9006},
 									val:        "/",
 									ignoreCase: false,
 								},
@@ -5158,44 +8988,44 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 453, col: 5, offset: 8452},
-						run: (*parser).callonPrimary114,
+						pos: position{line: 502, col: 5, offset: 9892},
+						run: (*parser).callonPrimary126,
 						expr: &litMatcher{
-							pos:        position{line: 453, col: 5, offset: 8452},
+							pos:        position{line: 502, col: 5, offset: 9892},
 							val:        "<-",
 							ignoreCase: false,
 						},
 					},
 					&actionExpr{
-						pos: position{line: 373, col: 5, offset: 7036},
-						run: (*parser).callonPrimary116,
+						pos: position{line: 403, col: 5, offset: 8022},
+						run: (*parser).callonPrimary128,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 373, col: 5, offset: 7036},
+							pos: position{line: 403, col: 5, offset: 8022},
 							expr: &seqExpr{
-								pos: position{line: 370, col: 5, offset: 6993},
+								pos: position{line: 400, col: 5, offset: 7979},
 								exprs: []interface{}{
 									&choiceExpr{
-										pos: position{line: 439, col: 6, offset: 8288},
+										pos: position{line: 488, col: 6, offset: 9728},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 439, col: 6, offset: 8288},
+												pos:        position{line: 488, col: 6, offset: 9728},
 												val:        "0",
 												ignoreCase: false,
 											},
 											&seqExpr{
-												pos: position{line: 439, col: 12, offset: 8294},
+												pos: position{line: 488, col: 12, offset: 9734},
 												exprs: []interface{}{
 													&charClassMatcher{
-														pos:        position{line: 447, col: 5, offset: 8412},
+														pos:        position{line: 496, col: 5, offset: 9852},
 														val:        "[1-9]",
 														ranges:     []rune{'1', '9'},
 														ignoreCase: false,
 														inverted:   false,
 													},
 													&zeroOrMoreExpr{
-														pos: position{line: 439, col: 25, offset: 8307},
+														pos: position{line: 488, col: 25, offset: 9747},
 														expr: &charClassMatcher{
-															pos:        position{line: 450, col: 5, offset: 8429},
+															pos:        position{line: 499, col: 5, offset: 9869},
 															val:        "[0-9]",
 															ranges:     []rune{'0', '9'},
 															ignoreCase: false,
@@ -5207,35 +9037,35 @@ var g = &grammar{
 										},
 									},
 									&choiceExpr{
-										pos: position{line: 361, col: 9, offset: 6843},
+										pos: position{line: 391, col: 9, offset: 7829},
 										alternatives: []interface{}{
 											&litMatcher{
-												pos:        position{line: 342, col: 5, offset: 6676},
+												pos:        position{line: 372, col: 5, offset: 7662},
 												val:        "ns",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 6, offset: 6704},
+												pos:        position{line: 375, col: 6, offset: 7690},
 												val:        "us",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 13, offset: 6711},
+												pos:        position{line: 375, col: 13, offset: 7697},
 												val:        "µs",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 345, col: 20, offset: 6719},
+												pos:        position{line: 375, col: 20, offset: 7705},
 												val:        "μs",
 												ignoreCase: false,
 											},
 											&litMatcher{
-												pos:        position{line: 348, col: 5, offset: 6748},
+												pos:        position{line: 378, col: 5, offset: 7734},
 												val:        "ms",
 												ignoreCase: false,
 											},
 											&charClassMatcher{
-												pos:        position{line: 351, col: 5, offset: 6770},
+												pos:        position{line: 381, col: 5, offset: 7756},
 												val:        "[smh]",
 												chars:      []rune{'s', 'm', 'h'},
 												ignoreCase: false,
@@ -5248,148 +9078,148 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 337, col: 5, offset: 6588},
-						run: (*parser).callonPrimary132,
+						pos: position{line: 367, col: 5, offset: 7574},
+						run: (*parser).callonPrimary144,
 						expr: &seqExpr{
-							pos: position{line: 337, col: 5, offset: 6588},
+							pos: position{line: 367, col: 5, offset: 7574},
 							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 331, col: 18, offset: 6503},
+									pos:        position{line: 361, col: 18, offset: 7489},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 331, col: 32, offset: 6517},
+									pos:        position{line: 361, col: 32, offset: 7503},
 									val:        "-",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 337, col: 14, offset: 6597},
+									pos:        position{line: 367, col: 14, offset: 7583},
 									val:        "T",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 328, col: 14, offset: 6433},
+									pos:        position{line: 358, col: 14, offset: 7419},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&litMatcher{
-									pos:        position{line: 328, col: 29, offset: 6448},
+									pos:        position{line: 358, col: 29, offset: 7434},
 									val:        ":",
 									ignoreCase: false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&charClassMatcher{
-									pos:        position{line: 450, col: 5, offset: 8429},
+									pos:        position{line: 499, col: 5, offset: 9869},
 									val:        "[0-9]",
 									ranges:     []rune{'0', '9'},
 									ignoreCase: false,
 									inverted:   false,
 								},
 								&zeroOrOneExpr{
-									pos: position{line: 328, col: 44, offset: 6463},
+									pos: position{line: 358, col: 44, offset: 7449},
 									expr: &seqExpr{
-										pos: position{line: 319, col: 5, offset: 6303},
+										pos: position{line: 349, col: 5, offset: 7289},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 319, col: 5, offset: 6303},
+												pos:        position{line: 349, col: 5, offset: 7289},
 												val:        ".",
 												ignoreCase: false,
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 319, col: 9, offset: 6307},
+												pos: position{line: 349, col: 9, offset: 7293},
 												expr: &charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 499, col: 5, offset: 9869},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
@@ -5400,51 +9230,51 @@ var g = &grammar{
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 325, col: 6, offset: 6386},
+									pos: position{line: 355, col: 6, offset: 7372},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 325, col: 6, offset: 6386},
+											pos:        position{line: 355, col: 6, offset: 7372},
 											val:        "Z",
 											ignoreCase: false,
 										},
 										&seqExpr{
-											pos: position{line: 322, col: 5, offset: 6333},
+											pos: position{line: 352, col: 5, offset: 7319},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 322, col: 6, offset: 6334},
+													pos:        position{line: 352, col: 6, offset: 7320},
 													val:        "[+-]",
 													chars:      []rune{'+', '-'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 499, col: 5, offset: 9869},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 499, col: 5, offset: 9869},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 322, col: 26, offset: 6354},
+													pos:        position{line: 352, col: 26, offset: 7340},
 													val:        ":",
 													ignoreCase: false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 499, col: 5, offset: 9869},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&charClassMatcher{
-													pos:        position{line: 450, col: 5, offset: 8429},
+													pos:        position{line: 499, col: 5, offset: 9869},
 													val:        "[0-9]",
 													ranges:     []rune{'0', '9'},
 													ignoreCase: false,
@@ -5458,113 +9288,345 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 434, col: 5, offset: 8206},
-						run: (*parser).callonPrimary167,
+						pos: position{line: 483, col: 5, offset: 9646},
+						run: (*parser).callonPrimary179,
 						expr: &seqExpr{
-							pos: position{line: 434, col: 5, offset: 8206},
+							pos: position{line: 483, col: 5, offset: 9646},
 							exprs: []interface{}{
 								&choiceExpr{
-									pos: position{line: 439, col: 6, offset: 8288},
+									pos: position{line: 488, col: 6, offset: 9728},
 									alternatives: []interface{}{
 										&litMatcher{
-											pos:        position{line: 439, col: 6, offset: 8288},
+											pos:        position{line: 488, col: 6, offset: 9728},
 											val:        "0",
 											ignoreCase: false,
 										},
 										&seqExpr{
-											pos: position{line: 439, col: 12, offset: 8294},
+											pos: position{line: 488, col: 12, offset: 9734},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 447, col: 5, offset: 8412},
+													pos:        position{line: 496, col: 5, offset: 9852},
 													val:        "[1-9]",
 													ranges:     []rune{'1', '9'},
 													ignoreCase: false,
 													inverted:   false,
 												},
-												&zeroOrMoreExpr{
-													pos: position{line: 439, col: 25, offset: 8307},
-													expr: &charClassMatcher{
-														pos:        position{line: 450, col: 5, offset: 8429},
-														val:        "[0-9]",
-														ranges:     []rune{'0', '9'},
+												&zeroOrMoreExpr{
+													pos: position{line: 488, col: 25, offset: 9747},
+													expr: &charClassMatcher{
+														pos:        position{line: 499, col: 5, offset: 9869},
+														val:        "[0-9]",
+														ranges:     []rune{'0', '9'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 483, col: 13, offset: 9654},
+									val:        ".",
+									ignoreCase: false,
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 483, col: 17, offset: 9658},
+									expr: &charClassMatcher{
+										pos:        position{line: 499, col: 5, offset: 9869},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 491, col: 5, offset: 9775},
+						run: (*parser).callonPrimary190,
+						expr: &choiceExpr{
+							pos: position{line: 488, col: 6, offset: 9728},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 488, col: 6, offset: 9728},
+									val:        "0",
+									ignoreCase: false,
+								},
+								&seqExpr{
+									pos: position{line: 488, col: 12, offset: 9734},
+									exprs: []interface{}{
+										&charClassMatcher{
+											pos:        position{line: 496, col: 5, offset: 9852},
+											val:        "[1-9]",
+											ranges:     []rune{'1', '9'},
+											ignoreCase: false,
+											inverted:   false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 488, col: 25, offset: 9747},
+											expr: &charClassMatcher{
+												pos:        position{line: 499, col: 5, offset: 9869},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 282, col: 5, offset: 6235},
+						name: "StringExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 283, col: 5, offset: 6256},
+						name: "CallExpression",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 284, col: 5, offset: 6275},
+						name: "MemberExpressions",
+					},
+					&actionExpr{
+						pos: position{line: 510, col: 5, offset: 10106},
+						run: (*parser).callonPrimary200,
+						expr: &seqExpr{
+							pos: position{line: 510, col: 5, offset: 10106},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 510, col: 5, offset: 10106},
+									expr: &choiceExpr{
+										pos: position{line: 516, col: 5, offset: 10193},
+										alternatives: []interface{}{
+											&seqExpr{
+												pos: position{line: 528, col: 5, offset: 10377},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 528, col: 5, offset: 10377},
+														val:        "package",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 528, col: 15, offset: 10387},
+														expr: &charClassMatcher{
+															pos:        position{line: 528, col: 16, offset: 10388},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 531, col: 5, offset: 10417},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 531, col: 5, offset: 10417},
+														val:        "import",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 531, col: 14, offset: 10426},
+														expr: &charClassMatcher{
+															pos:        position{line: 531, col: 15, offset: 10427},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 534, col: 5, offset: 10456},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 534, col: 5, offset: 10456},
+														val:        "option",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 534, col: 14, offset: 10465},
+														expr: &charClassMatcher{
+															pos:        position{line: 534, col: 15, offset: 10466},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 537, col: 5, offset: 10495},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 537, col: 5, offset: 10495},
+														val:        "return",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 537, col: 14, offset: 10504},
+														expr: &charClassMatcher{
+															pos:        position{line: 537, col: 15, offset: 10505},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 540, col: 5, offset: 10530},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 540, col: 5, offset: 10530},
+														val:        "if",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 540, col: 10, offset: 10535},
+														expr: &charClassMatcher{
+															pos:        position{line: 540, col: 11, offset: 10536},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 543, col: 5, offset: 10563},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 543, col: 5, offset: 10563},
+														val:        "then",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 543, col: 12, offset: 10570},
+														expr: &charClassMatcher{
+															pos:        position{line: 543, col: 13, offset: 10571},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 546, col: 5, offset: 10598},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 546, col: 5, offset: 10598},
+														val:        "else",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 546, col: 12, offset: 10605},
+														expr: &charClassMatcher{
+															pos:        position{line: 546, col: 13, offset: 10606},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 549, col: 5, offset: 10631},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 549, col: 5, offset: 10631},
+														val:        "in",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 549, col: 10, offset: 10636},
+														expr: &charClassMatcher{
+															pos:        position{line: 549, col: 11, offset: 10637},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
+											},
+											&seqExpr{
+												pos: position{line: 552, col: 5, offset: 10665},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 552, col: 5, offset: 10665},
+														val:        "empty",
 														ignoreCase: false,
-														inverted:   false,
+													},
+													&notExpr{
+														pos: position{line: 552, col: 13, offset: 10673},
+														expr: &charClassMatcher{
+															pos:        position{line: 552, col: 14, offset: 10674},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
 													},
 												},
 											},
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 434, col: 13, offset: 8214},
-									val:        ".",
-									ignoreCase: false,
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 434, col: 17, offset: 8218},
-									expr: &charClassMatcher{
-										pos:        position{line: 450, col: 5, offset: 8429},
-										val:        "[0-9]",
-										ranges:     []rune{'0', '9'},
-										ignoreCase: false,
-										inverted:   false,
-									},
-								},
-							},
-						},
-					},
-					&actionExpr{
-						pos: position{line: 442, col: 5, offset: 8335},
-						run: (*parser).callonPrimary178,
-						expr: &choiceExpr{
-							pos: position{line: 439, col: 6, offset: 8288},
-							alternatives: []interface{}{
-								&litMatcher{
-									pos:        position{line: 439, col: 6, offset: 8288},
-									val:        "0",
-									ignoreCase: false,
-								},
-								&seqExpr{
-									pos: position{line: 439, col: 12, offset: 8294},
-									exprs: []interface{}{
-										&charClassMatcher{
-											pos:        position{line: 447, col: 5, offset: 8412},
-											val:        "[1-9]",
-											ranges:     []rune{'1', '9'},
-											ignoreCase: false,
-											inverted:   false,
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 439, col: 25, offset: 8307},
-											expr: &charClassMatcher{
-												pos:        position{line: 450, col: 5, offset: 8429},
-												val:        "[0-9]",
-												ranges:     []rune{'0', '9'},
-												ignoreCase: false,
-												inverted:   false,
+											&seqExpr{
+												pos: position{line: 555, col: 5, offset: 10703},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 555, col: 5, offset: 10703},
+														val:        "exists",
+														ignoreCase: false,
+													},
+													&notExpr{
+														pos: position{line: 555, col: 14, offset: 10712},
+														expr: &charClassMatcher{
+															pos:        position{line: 555, col: 15, offset: 10713},
+															val:        "[_0-9\\pL]",
+															chars:      []rune{'_'},
+															ranges:     []rune{'0', '9'},
+															classes:    []*unicode.RangeTable{rangeTable("L")},
+															ignoreCase: false,
+															inverted:   false,
+														},
+													},
+												},
 											},
 										},
 									},
 								},
-							},
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 256, col: 5, offset: 5358},
-						name: "CallExpression",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 257, col: 5, offset: 5377},
-						name: "MemberExpressions",
-					},
-					&actionExpr{
-						pos: position{line: 460, col: 5, offset: 8638},
-						run: (*parser).callonPrimary187,
-						expr: &seqExpr{
-							pos: position{line: 460, col: 5, offset: 8638},
-							exprs: []interface{}{
 								&charClassMatcher{
-									pos:        position{line: 460, col: 5, offset: 8638},
+									pos:        position{line: 510, col: 14, offset: 10115},
 									val:        "[_\\pL]",
 									chars:      []rune{'_'},
 									classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -5572,9 +9634,9 @@ var g = &grammar{
 									inverted:   false,
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 460, col: 11, offset: 8644},
+									pos: position{line: 510, col: 20, offset: 10121},
 									expr: &charClassMatcher{
-										pos:        position{line: 460, col: 11, offset: 8644},
+										pos:        position{line: 510, col: 20, offset: 10121},
 										val:        "[_0-9\\pL]",
 										chars:      []rune{'_'},
 										ranges:     []rune{'0', '9'},
@@ -5587,15 +9649,15 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 259, col: 5, offset: 5414},
+						pos:  position{line: 286, col: 5, offset: 6312},
 						name: "ObjectExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 260, col: 5, offset: 5435},
+						pos:  position{line: 287, col: 5, offset: 6333},
 						name: "ArrowFunctionExpression",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 261, col: 5, offset: 5463},
+						pos:  position{line: 288, col: 5, offset: 6361},
 						name: "Parens",
 					},
 				},
@@ -5603,42 +9665,42 @@ var g = &grammar{
 		},
 		{
 			name: "Parens",
-			pos:  position{line: 273, col: 1, offset: 5628},
+			pos:  position{line: 300, col: 1, offset: 6526},
 			expr: &actionExpr{
-				pos: position{line: 274, col: 5, offset: 5639},
+				pos: position{line: 301, col: 5, offset: 6537},
 				run: (*parser).callonParens1,
 				expr: &seqExpr{
-					pos: position{line: 274, col: 5, offset: 5639},
+					pos: position{line: 301, col: 5, offset: 6537},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 274, col: 5, offset: 5639},
+							pos:        position{line: 301, col: 5, offset: 6537},
 							val:        "(",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5646,7 +9708,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5656,37 +9718,37 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 274, col: 12, offset: 5646},
+							pos:   position{line: 301, col: 12, offset: 6544},
 							label: "expr",
 							expr: &ruleRefExpr{
-								pos:  position{line: 274, col: 17, offset: 5651},
+								pos:  position{line: 301, col: 17, offset: 6549},
 								name: "Expr",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5694,7 +9756,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5704,7 +9766,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 274, col: 25, offset: 5659},
+							pos:        position{line: 301, col: 25, offset: 6557},
 							val:        ")",
 							ignoreCase: false,
 						},
@@ -5714,42 +9776,42 @@ var g = &grammar{
 		},
 		{
 			name: "Array",
-			pos:  position{line: 278, col: 1, offset: 5695},
+			pos:  position{line: 305, col: 1, offset: 6593},
 			expr: &actionExpr{
-				pos: position{line: 279, col: 5, offset: 5705},
+				pos: position{line: 306, col: 5, offset: 6603},
 				run: (*parser).callonArray1,
 				expr: &seqExpr{
-					pos: position{line: 279, col: 5, offset: 5705},
+					pos: position{line: 306, col: 5, offset: 6603},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 279, col: 5, offset: 5705},
+							pos:        position{line: 306, col: 5, offset: 6603},
 							val:        "[",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5757,7 +9819,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5767,40 +9829,40 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 279, col: 12, offset: 5712},
+							pos:   position{line: 306, col: 12, offset: 6610},
 							label: "elements",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 279, col: 21, offset: 5721},
+								pos: position{line: 306, col: 21, offset: 6619},
 								expr: &ruleRefExpr{
-									pos:  position{line: 279, col: 21, offset: 5721},
+									pos:  position{line: 306, col: 21, offset: 6619},
 									name: "ArrayElements",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5808,7 +9870,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5818,7 +9880,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 279, col: 39, offset: 5739},
+							pos:        position{line: 306, col: 39, offset: 6637},
 							val:        "]",
 							ignoreCase: false,
 						},
@@ -5828,45 +9890,45 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayElements",
-			pos:  position{line: 283, col: 1, offset: 5779},
+			pos:  position{line: 313, col: 1, offset: 6765},
 			expr: &actionExpr{
-				pos: position{line: 284, col: 5, offset: 5797},
+				pos: position{line: 314, col: 5, offset: 6783},
 				run: (*parser).callonArrayElements1,
 				expr: &seqExpr{
-					pos: position{line: 284, col: 5, offset: 5797},
+					pos: position{line: 314, col: 5, offset: 6783},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 284, col: 5, offset: 5797},
+							pos:   position{line: 314, col: 5, offset: 6783},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 284, col: 11, offset: 5803},
+								pos:  position{line: 314, col: 11, offset: 6789},
 								name: "Primary",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
+												pos:        position{line: 563, col: 5, offset: 10785},
 												val:        "//",
 												ignoreCase: false,
 											},
 											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
+												pos: position{line: 563, col: 10, offset: 10790},
 												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
+													pos:        position{line: 563, col: 10, offset: 10790},
 													val:        "[^\\r\\n]",
 													chars:      []rune{'\r', '\n'},
 													ignoreCase: false,
@@ -5874,7 +9936,7 @@ var g = &grammar{
 												},
 											},
 											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
+												pos:        position{line: 572, col: 5, offset: 10857},
 												val:        "\n",
 												ignoreCase: false,
 											},
@@ -5884,12 +9946,12 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 284, col: 22, offset: 5814},
+							pos:   position{line: 314, col: 22, offset: 6800},
 							label: "rest",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 284, col: 27, offset: 5819},
+								pos: position{line: 314, col: 27, offset: 6805},
 								expr: &ruleRefExpr{
-									pos:  position{line: 284, col: 27, offset: 5819},
+									pos:  position{line: 314, col: 27, offset: 6805},
 									name: "ArrayRest",
 								},
 							},
@@ -5900,66 +9962,367 @@ var g = &grammar{
 		},
 		{
 			name: "ArrayRest",
-			pos:  position{line: 288, col: 1, offset: 5891},
+			pos:  position{line: 318, col: 1, offset: 6877},
 			expr: &actionExpr{
-				pos: position{line: 289, col: 5, offset: 5905},
+				pos: position{line: 319, col: 5, offset: 6891},
 				run: (*parser).callonArrayRest1,
 				expr: &seqExpr{
-					pos: position{line: 289, col: 5, offset: 5905},
+					pos: position{line: 319, col: 5, offset: 6891},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 289, col: 5, offset: 5905},
+							pos:        position{line: 319, col: 5, offset: 6891},
 							val:        ",",
 							ignoreCase: false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 468, col: 5, offset: 8728},
+							pos: position{line: 560, col: 5, offset: 10748},
 							expr: &choiceExpr{
-								pos: position{line: 468, col: 7, offset: 8730},
+								pos: position{line: 560, col: 7, offset: 10750},
 								alternatives: []interface{}{
 									&charClassMatcher{
-										pos:        position{line: 474, col: 5, offset: 8791},
+										pos:        position{line: 566, col: 5, offset: 10811},
 										val:        "[ \\t\\r\\n]",
 										chars:      []rune{' ', '\t', '\r', '\n'},
 										ignoreCase: false,
 										inverted:   false,
 									},
 									&seqExpr{
-										pos: position{line: 471, col: 5, offset: 8765},
+										pos: position{line: 563, col: 5, offset: 10785},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 563, col: 5, offset: 10785},
+												val:        "//",
+												ignoreCase: false,
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 563, col: 10, offset: 10790},
+												expr: &charClassMatcher{
+													pos:        position{line: 563, col: 10, offset: 10790},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 572, col: 5, offset: 10857},
+												val:        "\n",
+												ignoreCase: false,
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 319, col: 12, offset: 6898},
+							label: "element",
+							expr: &ruleRefExpr{
+								pos:  position{line: 319, col: 20, offset: 6906},
+								name: "Primary",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringExpression",
+			pos:  position{line: 428, col: 1, offset: 8575},
+			expr: &choiceExpr{
+				pos: position{line: 429, col: 5, offset: 8596},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 429, col: 5, offset: 8596},
+						run: (*parser).callonStringExpression2,
+						expr: &seqExpr{
+							pos: position{line: 429, col: 7, offset: 8598},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 429, col: 7, offset: 8598},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&labeledExpr{
+									pos:   position{line: 429, col: 11, offset: 8602},
+									label: "parts",
+									expr: &zeroOrMoreExpr{
+										pos: position{line: 429, col: 17, offset: 8608},
+										expr: &ruleRefExpr{
+											pos:  position{line: 429, col: 17, offset: 8608},
+											name: "StringExpressionPart",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 429, col: 39, offset: 8630},
+									val:        "\"",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 432, col: 5, offset: 8700},
+						run: (*parser).callonStringExpression9,
+						expr: &seqExpr{
+							pos: position{line: 432, col: 7, offset: 8702},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 432, col: 7, offset: 8702},
+									val:        "\"",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 432, col: 11, offset: 8706},
+									expr: &ruleRefExpr{
+										pos:  position{line: 432, col: 11, offset: 8706},
+										name: "StringExpressionPart",
+									},
+								},
+								&choiceExpr{
+									pos: position{line: 432, col: 35, offset: 8730},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 572, col: 5, offset: 10857},
+											val:        "\n",
+											ignoreCase: false,
+										},
+										&notExpr{
+											pos: position{line: 575, col: 5, offset: 10871},
+											expr: &anyMatcher{
+												line: 575, col: 6, offset: 10872,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "StringExpressionPart",
+			pos:  position{line: 436, col: 1, offset: 8787},
+			expr: &choiceExpr{
+				pos: position{line: 437, col: 5, offset: 8812},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 437, col: 5, offset: 8812},
+						run: (*parser).callonStringExpressionPart2,
+						expr: &seqExpr{
+							pos: position{line: 437, col: 5, offset: 8812},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 437, col: 5, offset: 8812},
+									val:        "${",
+									ignoreCase: false,
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 437, col: 13, offset: 8820},
+									label: "expr",
+									expr: &ruleRefExpr{
+										pos:  position{line: 437, col: 18, offset: 8825},
+										name: "Expr",
+									},
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 560, col: 5, offset: 10748},
+									expr: &choiceExpr{
+										pos: position{line: 560, col: 7, offset: 10750},
+										alternatives: []interface{}{
+											&charClassMatcher{
+												pos:        position{line: 566, col: 5, offset: 10811},
+												val:        "[ \\t\\r\\n]",
+												chars:      []rune{' ', '\t', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+											&seqExpr{
+												pos: position{line: 563, col: 5, offset: 10785},
+												exprs: []interface{}{
+													&litMatcher{
+														pos:        position{line: 563, col: 5, offset: 10785},
+														val:        "//",
+														ignoreCase: false,
+													},
+													&zeroOrMoreExpr{
+														pos: position{line: 563, col: 10, offset: 10790},
+														expr: &charClassMatcher{
+															pos:        position{line: 563, col: 10, offset: 10790},
+															val:        "[^\\r\\n]",
+															chars:      []rune{'\r', '\n'},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+													&litMatcher{
+														pos:        position{line: 572, col: 5, offset: 10857},
+														val:        "\n",
+														ignoreCase: false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 437, col: 26, offset: 8833},
+									val:        "}",
+									ignoreCase: false,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 440, col: 5, offset: 8900},
+						run: (*parser).callonStringExpressionPart24,
+						expr: &oneOrMoreExpr{
+							pos: position{line: 440, col: 5, offset: 8900},
+							expr: &choiceExpr{
+								pos: position{line: 416, col: 5, offset: 8297},
+								alternatives: []interface{}{
+									&seqExpr{
+										pos: position{line: 416, col: 5, offset: 8297},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 416, col: 5, offset: 8297},
+												expr: &choiceExpr{
+													pos: position{line: 416, col: 8, offset: 8300},
+													alternatives: []interface{}{
+														&charClassMatcher{
+															pos:        position{line: 416, col: 8, offset: 8300},
+															val:        "[\"\\\\\\n]",
+															chars:      []rune{'"', '\\', '\n'},
+															ignoreCase: false,
+															inverted:   false,
+														},
+														&litMatcher{
+															pos:        position{line: 416, col: 27, offset: 8319},
+															val:        "${",
+															ignoreCase: false,
+														},
+													},
+												},
+											},
+											&anyMatcher{
+												line: 558, col: 5, offset: 10739,
+											},
+										},
+									},
+									&seqExpr{
+										pos: position{line: 417, col: 5, offset: 8341},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 471, col: 5, offset: 8765},
-												val:        "//",
+												pos:        position{line: 417, col: 5, offset: 8341},
+												val:        "\\",
 												ignoreCase: false,
 											},
-											&zeroOrMoreExpr{
-												pos: position{line: 471, col: 10, offset: 8770},
-												expr: &charClassMatcher{
-													pos:        position{line: 471, col: 10, offset: 8770},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
+											&choiceExpr{
+												pos: position{line: 420, col: 5, offset: 8389},
+												alternatives: []interface{}{
+													&charClassMatcher{
+														pos:        position{line: 420, col: 7, offset: 8391},
+														val:        "[\"\\\\$nrt]",
+														chars:      []rune{'"', '\\', '$', 'n', 'r', 't'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+													&seqExpr{
+														pos: position{line: 420, col: 44, offset: 8428},
+														exprs: []interface{}{
+															&litMatcher{
+																pos:        position{line: 420, col: 44, offset: 8428},
+																val:        "x",
+																ignoreCase: false,
+															},
+															&charClassMatcher{
+																pos:        position{line: 426, col: 5, offset: 8562},
+																val:        "[0-9a-fA-F]",
+																ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+															&charClassMatcher{
+																pos:        position{line: 426, col: 5, offset: 8562},
+																val:        "[0-9a-fA-F]",
+																ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+																ignoreCase: false,
+																inverted:   false,
+															},
+														},
+													},
+													&actionExpr{
+														pos: position{line: 421, col: 5, offset: 8456},
+														run: (*parser).callonStringExpressionPart41,
+														expr: &choiceExpr{
+															pos: position{line: 421, col: 7, offset: 8458},
+															alternatives: []interface{}{
+																&anyMatcher{
+																	line: 558, col: 5, offset: 10739,
+																},
+																&litMatcher{
+																	pos:        position{line: 572, col: 5, offset: 10857},
+																	val:        "\n",
+																	ignoreCase: false,
+																},
+																&notExpr{
+																	pos: position{line: 575, col: 5, offset: 10871},
+																	expr: &anyMatcher{
+																		line: 575, col: 6, offset: 10872,
+																	},
+																},
+															},
+														},
+													},
 												},
 											},
-											&litMatcher{
-												pos:        position{line: 480, col: 5, offset: 8837},
-												val:        "\n",
-												ignoreCase: false,
-											},
 										},
 									},
 								},
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 289, col: 12, offset: 5912},
-							label: "element",
-							expr: &ruleRefExpr{
-								pos:  position{line: 289, col: 20, offset: 5920},
-								name: "Primary",
-							},
-						},
 					},
 				},
 			},
@@ -5978,15 +10341,169 @@ func (p *parser) callonStart1() (interface{}, error) {
 	return p.cur.onStart1(stack["program"])
 }
 
-func (c *current) onProgram1(body interface{}) (interface{}, error) {
-	return program(body, c.text, c.pos)
+func (c *current) onProgram13() (interface{}, error) {
+	return identifier(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram13()
+}
+
+func (c *current) onProgram5(name interface{}) (interface{}, error) {
+	return pkgclause(name, c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram5(stack["name"])
+}
+
+func (c *current) onProgram83() (interface{}, error) {
+	return identifier(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram83() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram83()
+}
+
+func (c *current) onProgram153() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonProgram153() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram153()
+}
+
+func (c *current) onProgram134() (interface{}, error) {
+	return stringLiteral(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram134() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram134()
+}
+
+func (c *current) onProgram179() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonProgram179() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram179()
+}
+
+func (c *current) onProgram160() (interface{}, error) {
+	return unterminatedString()
+
+}
+
+func (p *parser) callonProgram160() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram160()
+}
+
+func (c *current) onProgram73(as, path interface{}) (interface{}, error) {
+	return importdecl(as, path, c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram73() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram73(stack["as"], stack["path"])
+}
+
+func (c *current) onProgram280() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonProgram280() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram280()
+}
+
+func (c *current) onProgram261() (interface{}, error) {
+	return stringLiteral(c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram261() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram261()
+}
+
+func (c *current) onProgram306() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonProgram306() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram306()
+}
+
+func (c *current) onProgram287() (interface{}, error) {
+	return unterminatedString()
+
+}
+
+func (p *parser) callonProgram287() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram287()
+}
+
+func (c *current) onProgram200(as, path interface{}) (interface{}, error) {
+	return importdecl(as, path, c.text, c.pos)
+
+}
+
+func (p *parser) callonProgram200() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram200(stack["as"], stack["path"])
+}
+
+func (c *current) onProgram70(head, tail interface{}) (interface{}, error) {
+	return importdecls(head, tail)
+
+}
+
+func (p *parser) callonProgram70() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onProgram70(stack["head"], stack["tail"])
+}
+
+func (c *current) onProgram1(pkg, imports, body interface{}) (interface{}, error) {
+	return program(pkg, imports, body, c.text, c.pos)
 
 }
 
 func (p *parser) callonProgram1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onProgram1(stack["body"])
+	return p.cur.onProgram1(stack["pkg"], stack["imports"], stack["body"])
 }
 
 func (c *current) onSourceElements1(head, tail interface{}) (interface{}, error) {
@@ -6000,6 +10517,17 @@ func (p *parser) callonSourceElements1() (interface{}, error) {
 	return p.cur.onSourceElements1(stack["head"], stack["tail"])
 }
 
+func (c *current) onOptionStatement1(declaration interface{}) (interface{}, error) {
+	return optionstmt(declaration, c.text, c.pos)
+
+}
+
+func (p *parser) callonOptionStatement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOptionStatement1(stack["declaration"])
+}
+
 func (c *current) onVariableStatement1(declaration interface{}) (interface{}, error) {
 	return varstmt(declaration, c.text, c.pos)
 
@@ -6077,15 +10605,15 @@ func (p *parser) callonMemberExpressions4() (interface{}, error) {
 	return p.cur.onMemberExpressions4()
 }
 
-func (c *current) onMemberExpressions11(property interface{}) (interface{}, error) {
+func (c *current) onMemberExpressions53(property interface{}) (interface{}, error) {
 	return property, nil
 
 }
 
-func (p *parser) callonMemberExpressions11() (interface{}, error) {
+func (p *parser) callonMemberExpressions53() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMemberExpressions11(stack["property"])
+	return p.cur.onMemberExpressions53(stack["property"])
 }
 
 func (c *current) onMemberExpressions1(head, tail interface{}) (interface{}, error) {
@@ -6121,15 +10649,15 @@ func (p *parser) callonMemberExpressionProperty2() (interface{}, error) {
 	return p.cur.onMemberExpressionProperty2(stack["property"])
 }
 
-func (c *current) onMemberExpressionProperty19(property interface{}) (interface{}, error) {
+func (c *current) onMemberExpressionProperty61(property interface{}) (interface{}, error) {
 	return property, nil
 
 }
 
-func (p *parser) callonMemberExpressionProperty19() (interface{}, error) {
+func (p *parser) callonMemberExpressionProperty61() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMemberExpressionProperty19(stack["property"])
+	return p.cur.onMemberExpressionProperty61(stack["property"])
 }
 
 func (c *current) onCallExpression4(callee, args interface{}) (interface{}, error) {
@@ -6187,15 +10715,15 @@ func (p *parser) callonPipeExpression1() (interface{}, error) {
 	return p.cur.onPipeExpression1(stack["head"], stack["tail"])
 }
 
-func (c *current) onPipeExpressionHead16() (interface{}, error) {
+func (c *current) onPipeExpressionHead22() (interface{}, error) {
 	return nil, errors.New("invalid escape character")
 
 }
 
-func (p *parser) callonPipeExpressionHead16() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead22() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead16()
+	return p.cur.onPipeExpressionHead22()
 }
 
 func (c *current) onPipeExpressionHead3() (interface{}, error) {
@@ -6209,191 +10737,191 @@ func (p *parser) callonPipeExpressionHead3() (interface{}, error) {
 	return p.cur.onPipeExpressionHead3()
 }
 
-func (c *current) onPipeExpressionHead36() (interface{}, error) {
+func (c *current) onPipeExpressionHead48() (interface{}, error) {
 	return nil, errors.New("invalid escape character")
 
 }
 
-func (p *parser) callonPipeExpressionHead36() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead48() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead36()
+	return p.cur.onPipeExpressionHead48()
 }
 
-func (c *current) onPipeExpressionHead23() (interface{}, error) {
-	return "", errors.New("string literal not terminated")
+func (c *current) onPipeExpressionHead29() (interface{}, error) {
+	return unterminatedString()
 
 }
 
-func (p *parser) callonPipeExpressionHead23() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead29() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead23()
+	return p.cur.onPipeExpressionHead29()
 }
 
-func (c *current) onPipeExpressionHead46() (interface{}, error) {
+func (c *current) onPipeExpressionHead58() (interface{}, error) {
 	return booleanLiteral(true, c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead46() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead58() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead46()
+	return p.cur.onPipeExpressionHead58()
 }
 
-func (c *current) onPipeExpressionHead65() (interface{}, error) {
+func (c *current) onPipeExpressionHead77() (interface{}, error) {
 	return booleanLiteral(false, c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead65() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead77() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead65()
+	return p.cur.onPipeExpressionHead77()
 }
 
-func (c *current) onPipeExpressionHead97() (interface{}, error) {
+func (c *current) onPipeExpressionHead109() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead97() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead109() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead97()
+	return p.cur.onPipeExpressionHead109()
 }
 
-func (c *current) onPipeExpressionHead92(re interface{}) (interface{}, error) {
+func (c *current) onPipeExpressionHead104(re interface{}) (interface{}, error) {
 	return re, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead92() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead104() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead92(stack["re"])
+	return p.cur.onPipeExpressionHead104(stack["re"])
 }
 
-func (c *current) onPipeExpressionHead102() (interface{}, error) {
+func (c *current) onPipeExpressionHead114() (interface{}, error) {
 	return []byte{'/'}, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead102() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead114() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead102()
+	return p.cur.onPipeExpressionHead114()
 }
 
-func (c *current) onPipeExpressionHead107() (interface{}, error) {
+func (c *current) onPipeExpressionHead119() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead107() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead119() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead107()
+	return p.cur.onPipeExpressionHead119()
 }
 
-func (c *current) onPipeExpressionHead104() (interface{}, error) {
+func (c *current) onPipeExpressionHead116() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead104() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead116() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead104()
+	return p.cur.onPipeExpressionHead116()
 }
 
-func (c *current) onPipeExpressionHead88(chars interface{}) (interface{}, error) {
+func (c *current) onPipeExpressionHead100(chars interface{}) (interface{}, error) {
 	return regexLiteral(chars, c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead88() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead100() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead88(stack["chars"])
+	return p.cur.onPipeExpressionHead100(stack["chars"])
 }
 
-func (c *current) onPipeExpressionHead84(pattern interface{}) (interface{}, error) {
+func (c *current) onPipeExpressionHead96(pattern interface{}) (interface{}, error) {
 	return pattern, nil
 
 }
 
-func (p *parser) callonPipeExpressionHead84() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead96() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead84(stack["pattern"])
+	return p.cur.onPipeExpressionHead96(stack["pattern"])
 }
 
-func (c *current) onPipeExpressionHead113() (interface{}, error) {
+func (c *current) onPipeExpressionHead125() (interface{}, error) {
 	return pipeLiteral(c.text, c.pos), nil
 
 }
 
-func (p *parser) callonPipeExpressionHead113() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead125() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead113()
+	return p.cur.onPipeExpressionHead125()
 }
 
-func (c *current) onPipeExpressionHead115() (interface{}, error) {
+func (c *current) onPipeExpressionHead127() (interface{}, error) {
 	return durationLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead115() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead127() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead115()
+	return p.cur.onPipeExpressionHead127()
 }
 
-func (c *current) onPipeExpressionHead131() (interface{}, error) {
+func (c *current) onPipeExpressionHead143() (interface{}, error) {
 	return datetime(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead131() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead143() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead131()
+	return p.cur.onPipeExpressionHead143()
 }
 
-func (c *current) onPipeExpressionHead166() (interface{}, error) {
+func (c *current) onPipeExpressionHead178() (interface{}, error) {
 	return numberLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead166() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead178() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead166()
+	return p.cur.onPipeExpressionHead178()
 }
 
-func (c *current) onPipeExpressionHead177() (interface{}, error) {
+func (c *current) onPipeExpressionHead189() (interface{}, error) {
 	return integerLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead177() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead189() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead177()
+	return p.cur.onPipeExpressionHead189()
 }
 
-func (c *current) onPipeExpressionHead186() (interface{}, error) {
+func (c *current) onPipeExpressionHead199() (interface{}, error) {
 	return identifier(c.text, c.pos)
 
 }
 
-func (p *parser) callonPipeExpressionHead186() (interface{}, error) {
+func (p *parser) callonPipeExpressionHead199() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPipeExpressionHead186()
+	return p.cur.onPipeExpressionHead199()
 }
 
 func (c *current) onPipeExpressionPipe1(call interface{}) (interface{}, error) {
@@ -6473,26 +11001,26 @@ func (p *parser) callonArrowFunctionParam2() (interface{}, error) {
 	return p.cur.onArrowFunctionParam2(stack["key"], stack["value"])
 }
 
-func (c *current) onArrowFunctionParam40() (interface{}, error) {
+func (c *current) onArrowFunctionParam82() (interface{}, error) {
 	return identifier(c.text, c.pos)
 
 }
 
-func (p *parser) callonArrowFunctionParam40() (interface{}, error) {
+func (p *parser) callonArrowFunctionParam82() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArrowFunctionParam40()
+	return p.cur.onArrowFunctionParam82()
 }
 
-func (c *current) onArrowFunctionParam37(key interface{}) (interface{}, error) {
+func (c *current) onArrowFunctionParam79(key interface{}) (interface{}, error) {
 	return property(key, nil, c.text, c.pos)
 
 }
 
-func (p *parser) callonArrowFunctionParam37() (interface{}, error) {
+func (p *parser) callonArrowFunctionParam79() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onArrowFunctionParam37(stack["key"])
+	return p.cur.onArrowFunctionParam79(stack["key"])
 }
 
 func (c *current) onArrowFunctionBody2(body interface{}) (interface{}, error) {
@@ -6572,6 +11100,17 @@ func (p *parser) callonProperty1() (interface{}, error) {
 	return p.cur.onProperty1(stack["key"], stack["value"])
 }
 
+func (c *current) onConditionalExpression1(test, consequent, alternate interface{}) (interface{}, error) {
+	return conditionalExpression(test, consequent, alternate, c.text, c.pos)
+
+}
+
+func (p *parser) callonConditionalExpression1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onConditionalExpression1(stack["test"], stack["consequent"], stack["alternate"])
+}
+
 func (c *current) onLogicalExpression16() (interface{}, error) {
 	return logicalOp(c.text)
 
@@ -6704,15 +11243,15 @@ func (p *parser) callonUnaryExpression2() (interface{}, error) {
 	return p.cur.onUnaryExpression2(stack["op"], stack["argument"])
 }
 
-func (c *current) onPrimary17() (interface{}, error) {
+func (c *current) onPrimary23() (interface{}, error) {
 	return nil, errors.New("invalid escape character")
 
 }
 
-func (p *parser) callonPrimary17() (interface{}, error) {
+func (p *parser) callonPrimary23() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary17()
+	return p.cur.onPrimary23()
 }
 
 func (c *current) onPrimary4() (interface{}, error) {
@@ -6726,191 +11265,191 @@ func (p *parser) callonPrimary4() (interface{}, error) {
 	return p.cur.onPrimary4()
 }
 
-func (c *current) onPrimary37() (interface{}, error) {
+func (c *current) onPrimary49() (interface{}, error) {
 	return nil, errors.New("invalid escape character")
 
 }
 
-func (p *parser) callonPrimary37() (interface{}, error) {
+func (p *parser) callonPrimary49() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary37()
+	return p.cur.onPrimary49()
 }
 
-func (c *current) onPrimary24() (interface{}, error) {
-	return "", errors.New("string literal not terminated")
+func (c *current) onPrimary30() (interface{}, error) {
+	return unterminatedString()
 
 }
 
-func (p *parser) callonPrimary24() (interface{}, error) {
+func (p *parser) callonPrimary30() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary24()
+	return p.cur.onPrimary30()
 }
 
-func (c *current) onPrimary47() (interface{}, error) {
+func (c *current) onPrimary59() (interface{}, error) {
 	return booleanLiteral(true, c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary47() (interface{}, error) {
+func (p *parser) callonPrimary59() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary47()
+	return p.cur.onPrimary59()
 }
 
-func (c *current) onPrimary66() (interface{}, error) {
+func (c *current) onPrimary78() (interface{}, error) {
 	return booleanLiteral(false, c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary66() (interface{}, error) {
+func (p *parser) callonPrimary78() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary66()
+	return p.cur.onPrimary78()
 }
 
-func (c *current) onPrimary98() (interface{}, error) {
+func (c *current) onPrimary110() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPrimary98() (interface{}, error) {
+func (p *parser) callonPrimary110() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary98()
+	return p.cur.onPrimary110()
 }
 
-func (c *current) onPrimary93(re interface{}) (interface{}, error) {
+func (c *current) onPrimary105(re interface{}) (interface{}, error) {
 	return re, nil
 
 }
 
-func (p *parser) callonPrimary93() (interface{}, error) {
+func (p *parser) callonPrimary105() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary93(stack["re"])
+	return p.cur.onPrimary105(stack["re"])
 }
 
-func (c *current) onPrimary103() (interface{}, error) {
+func (c *current) onPrimary115() (interface{}, error) {
 	return []byte{'/'}, nil
 
 }
 
-func (p *parser) callonPrimary103() (interface{}, error) {
+func (p *parser) callonPrimary115() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary103()
+	return p.cur.onPrimary115()
 }
 
-func (c *current) onPrimary108() (interface{}, error) {
+func (c *current) onPrimary120() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPrimary108() (interface{}, error) {
+func (p *parser) callonPrimary120() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary108()
+	return p.cur.onPrimary120()
 }
 
-func (c *current) onPrimary105() (interface{}, error) {
+func (c *current) onPrimary117() (interface{}, error) {
 	return c.text, nil
 
 }
 
-func (p *parser) callonPrimary105() (interface{}, error) {
+func (p *parser) callonPrimary117() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary105()
+	return p.cur.onPrimary117()
 }
 
-func (c *current) onPrimary89(chars interface{}) (interface{}, error) {
+func (c *current) onPrimary101(chars interface{}) (interface{}, error) {
 	return regexLiteral(chars, c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary89() (interface{}, error) {
+func (p *parser) callonPrimary101() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary89(stack["chars"])
+	return p.cur.onPrimary101(stack["chars"])
 }
 
-func (c *current) onPrimary85(pattern interface{}) (interface{}, error) {
+func (c *current) onPrimary97(pattern interface{}) (interface{}, error) {
 	return pattern, nil
 
 }
 
-func (p *parser) callonPrimary85() (interface{}, error) {
+func (p *parser) callonPrimary97() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary85(stack["pattern"])
+	return p.cur.onPrimary97(stack["pattern"])
 }
 
-func (c *current) onPrimary114() (interface{}, error) {
+func (c *current) onPrimary126() (interface{}, error) {
 	return pipeLiteral(c.text, c.pos), nil
 
 }
 
-func (p *parser) callonPrimary114() (interface{}, error) {
+func (p *parser) callonPrimary126() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary114()
+	return p.cur.onPrimary126()
 }
 
-func (c *current) onPrimary116() (interface{}, error) {
+func (c *current) onPrimary128() (interface{}, error) {
 	return durationLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary116() (interface{}, error) {
+func (p *parser) callonPrimary128() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary116()
+	return p.cur.onPrimary128()
 }
 
-func (c *current) onPrimary132() (interface{}, error) {
+func (c *current) onPrimary144() (interface{}, error) {
 	return datetime(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary132() (interface{}, error) {
+func (p *parser) callonPrimary144() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary132()
+	return p.cur.onPrimary144()
 }
 
-func (c *current) onPrimary167() (interface{}, error) {
+func (c *current) onPrimary179() (interface{}, error) {
 	return numberLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary167() (interface{}, error) {
+func (p *parser) callonPrimary179() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary167()
+	return p.cur.onPrimary179()
 }
 
-func (c *current) onPrimary178() (interface{}, error) {
+func (c *current) onPrimary190() (interface{}, error) {
 	return integerLiteral(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary178() (interface{}, error) {
+func (p *parser) callonPrimary190() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary178()
+	return p.cur.onPrimary190()
 }
 
-func (c *current) onPrimary187() (interface{}, error) {
+func (c *current) onPrimary200() (interface{}, error) {
 	return identifier(c.text, c.pos)
 
 }
 
-func (p *parser) callonPrimary187() (interface{}, error) {
+func (p *parser) callonPrimary200() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onPrimary187()
+	return p.cur.onPrimary200()
 }
 
 func (c *current) onParens1(expr interface{}) (interface{}, error) {
@@ -6925,6 +11464,9 @@ func (p *parser) callonParens1() (interface{}, error) {
 }
 
 func (c *current) onArray1(elements interface{}) (interface{}, error) {
+	if elements == nil {
+		return array(nil, nil, c.text, c.pos), nil
+	}
 	return elements, nil
 
 }
@@ -6957,10 +11499,69 @@ func (p *parser) callonArrayRest1() (interface{}, error) {
 	return p.cur.onArrayRest1(stack["element"])
 }
 
+func (c *current) onStringExpression2(parts interface{}) (interface{}, error) {
+	return stringExpression(parts, c.text, c.pos)
+
+}
+
+func (p *parser) callonStringExpression2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringExpression2(stack["parts"])
+}
+
+func (c *current) onStringExpression9() (interface{}, error) {
+	return unterminatedString()
+
+}
+
+func (p *parser) callonStringExpression9() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringExpression9()
+}
+
+func (c *current) onStringExpressionPart2(expr interface{}) (interface{}, error) {
+	return interpolatedPart(expr, c.text, c.pos)
+
+}
+
+func (p *parser) callonStringExpressionPart2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringExpressionPart2(stack["expr"])
+}
+
+func (c *current) onStringExpressionPart41() (interface{}, error) {
+	return nil, errors.New("invalid escape character")
+
+}
+
+func (p *parser) callonStringExpressionPart41() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringExpressionPart41()
+}
+
+func (c *current) onStringExpressionPart24() (interface{}, error) {
+	return textPart(c.text, c.pos)
+
+}
+
+func (p *parser) callonStringExpressionPart24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onStringExpressionPart24()
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
 
+	// errInvalidEntrypoint is returned when the specified entrypoint rule
+	// does not exit.
+	errInvalidEntrypoint = errors.New("invalid entrypoint")
+
 	// errInvalidEncoding is returned when the source is not properly
 	// utf8-encoded.
 	errInvalidEncoding = errors.New("invalid encoding")
@@ -6987,6 +11588,38 @@ func MaxExpressions(maxExprCnt uint64) Option {
 	}
 }
 
+// Entrypoint creates an Option to set the rule name to use as entrypoint.
+// The rule name must have been specified in the -alternate-entrypoints
+// if generating the parser with the -optimize-grammar flag, otherwise
+// it may have been optimized out. Passing an empty string sets the
+// entrypoint to the first rule in the grammar.
+//
+// The default is to start parsing at the first rule in the grammar.
+func Entrypoint(ruleName string) Option {
+	return func(p *parser) Option {
+		oldEntrypoint := p.entrypoint
+		p.entrypoint = ruleName
+		if ruleName == "" {
+			p.entrypoint = g.rules[0].name
+		}
+		return Entrypoint(oldEntrypoint)
+	}
+}
+
+// AllowInvalidUTF8 creates an Option to allow invalid UTF-8 bytes.
+// Every invalid UTF-8 byte is treated as a utf8.RuneError (U+FFFD)
+// by character class matchers and is matched by the any matcher.
+// The returned matched value, c.text and c.offset are NOT affected.
+//
+// The default is false.
+func AllowInvalidUTF8(b bool) Option {
+	return func(p *parser) Option {
+		old := p.allowInvalidUTF8
+		p.allowInvalidUTF8 = b
+		return AllowInvalidUTF8(old)
+	}
+}
+
 // Recover creates an Option to set the recover flag to b. When set to
 // true, this causes the parser to recover from panics and convert it
 // to an error. Setting it to false can be useful while debugging to
@@ -7063,10 +11696,16 @@ type current struct {
 	pos  position // start position of the match
 	text []byte   // raw text of the match
 
-	// the globalStore allows the parser to store arbitrary values
-	globalStore map[string]interface{}
+	// globalStore is a general store for the user to store arbitrary key-value
+	// pairs that they need to manage and that they do not want tied to the
+	// backtracking of the parser. This is only modified by the user and never
+	// rolled back by the parser. It is always up to the user to keep this in a
+	// consistent state.
+	globalStore storeDict
 }
 
+type storeDict map[string]interface{}
+
 // the AST types...
 
 type grammar struct {
@@ -7233,11 +11872,13 @@ func newParser(filename string, b []byte, opts ...Option) *parser {
 		pt:       savepoint{position: position{line: 1}},
 		recover:  true,
 		cur: current{
-			globalStore: make(map[string]interface{}),
+			globalStore: make(storeDict),
 		},
 		maxFailPos:      position{col: 1, line: 1},
 		maxFailExpected: make([]string, 0, 20),
 		Stats:           &stats,
+		// start rule is rule [0] unless an alternate entrypoint is specified
+		entrypoint: g.rules[0].name,
 	}
 	p.setOptions(opts)
 
@@ -7310,6 +11951,10 @@ type parser struct {
 
 	// max number of expressions to be parsed
 	maxExprCnt uint64
+	// entrypoint for the parser
+	entrypoint string
+
+	allowInvalidUTF8 bool
 
 	*Stats
 
@@ -7434,8 +12079,8 @@ func (p *parser) read() {
 		p.pt.col = 0
 	}
 
-	if rn == utf8.RuneError {
-		if n == 1 {
+	if rn == utf8.RuneError && n == 1 { // see utf8.DecodeRune
+		if !p.allowInvalidUTF8 {
 			p.addErr(errInvalidEncoding)
 		}
 	}
@@ -7487,9 +12132,14 @@ func (p *parser) parse(g *grammar) (val interface{}, err error) {
 		}()
 	}
 
-	// start rule is rule [0]
+	startRule, ok := p.rules[p.entrypoint]
+	if !ok {
+		p.addErr(errInvalidEntrypoint)
+		return nil, p.errs.err()
+	}
+
 	p.read() // advance to first rune
-	val, ok := p.parseRule(g.rules[0])
+	val, ok = p.parseRule(startRule)
 	if !ok {
 		if len(*p.errs) == 0 {
 			// If parsing fails, but no errors have been recorded, the expected values
@@ -7599,16 +12249,19 @@ func (p *parser) parseActionExpr(act *actionExpr) (interface{}, bool) {
 		if err != nil {
 			p.addErrAt(err, start.position, []string{})
 		}
+
 		val = actVal
 	}
 	return val, ok
 }
 
 func (p *parser) parseAndCodeExpr(and *andCodeExpr) (interface{}, bool) {
+
 	ok, err := and.run(p)
 	if err != nil {
 		p.addErr(err)
 	}
+
 	return nil, ok
 }
 
@@ -7618,18 +12271,20 @@ func (p *parser) parseAndExpr(and *andExpr) (interface{}, bool) {
 	_, ok := p.parseExpr(and.expr)
 	p.popV()
 	p.restore(pt)
+
 	return nil, ok
 }
 
 func (p *parser) parseAnyMatcher(any *anyMatcher) (interface{}, bool) {
-	if p.pt.rn != utf8.RuneError {
-		start := p.pt
-		p.read()
-		p.failAt(true, start.position, ".")
-		return p.sliceFrom(start), true
+	if p.pt.rn == utf8.RuneError && p.pt.w == 0 {
+		// EOF - see utf8.DecodeRune
+		p.failAt(false, p.pt.position, ".")
+		return nil, false
 	}
-	p.failAt(false, p.pt.position, ".")
-	return nil, false
+	start := p.pt
+	p.read()
+	p.failAt(true, start.position, ".")
+	return p.sliceFrom(start), true
 }
 
 func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (interface{}, bool) {
@@ -7637,7 +12292,7 @@ func (p *parser) parseCharClassMatcher(chr *charClassMatcher) (interface{}, bool
 	start := p.pt
 
 	// can't match EOF
-	if cur == utf8.RuneError {
+	if cur == utf8.RuneError && p.pt.w == 0 { // see utf8.DecodeRune
 		p.failAt(false, start.position, chr.val)
 		return nil, false
 	}
@@ -7748,6 +12403,7 @@ func (p *parser) parseNotCodeExpr(not *notCodeExpr) (interface{}, bool) {
 	if err != nil {
 		p.addErr(err)
 	}
+
 	return nil, !ok
 }
 
@@ -7759,6 +12415,7 @@ func (p *parser) parseNotExpr(not *notExpr) (interface{}, bool) {
 	p.maxFailInvertExpected = !p.maxFailInvertExpected
 	p.popV()
 	p.restore(pt)
+
 	return nil, !ok
 }
 
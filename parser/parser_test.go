@@ -428,6 +428,28 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "index call result",
+			raw:  `split(v: "a,b")[1]`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.MemberExpression{
+							Object: &ast.CallExpression{
+								Callee: &ast.Identifier{Name: "split"},
+								Arguments: []ast.Expression{&ast.ObjectExpression{
+									Properties: []*ast.Property{{
+										Key:   &ast.Identifier{Name: "v"},
+										Value: &ast.StringLiteral{Value: "a,b"},
+									}},
+								}},
+							},
+							Property: &ast.IntegerLiteral{Value: 1},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "var as binary expression of other vars",
 			raw: `a = 1
@@ -1542,6 +1564,418 @@ join(tables:[a,b], on:["t1"], fn: (a,b) => (a["_field"] - b["_field"]) / b["_fie
 				},
 			},
 		},
+		{
+			name: "import",
+			raw: `import "strings"
+			strings.title(v:"a")`,
+			want: &ast.Program{
+				Imports: []*ast.ImportDeclaration{
+					{
+						Path: &ast.StringLiteral{Value: "strings"},
+					},
+				},
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.CallExpression{
+							Callee: &ast.MemberExpression{
+								Object:   &ast.Identifier{Name: "strings"},
+								Property: &ast.Identifier{Name: "title"},
+							},
+							Arguments: []ast.Expression{
+								&ast.ObjectExpression{
+									Properties: []*ast.Property{
+										{
+											Key:   &ast.Identifier{Name: "v"},
+											Value: &ast.StringLiteral{Value: "a"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple imports with alias",
+			raw: `import "strings"
+			import a "mycompany/alerts"
+			// comment
+			importance = 1`,
+			want: &ast.Program{
+				Imports: []*ast.ImportDeclaration{
+					{
+						Path: &ast.StringLiteral{Value: "strings"},
+					},
+					{
+						As:   &ast.Identifier{Name: "a"},
+						Path: &ast.StringLiteral{Value: "mycompany/alerts"},
+					},
+				},
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "importance"},
+							Init: &ast.IntegerLiteral{Value: 1},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "package clause",
+			raw: `package alerts
+
+			import "strings"
+
+			crit = 90`,
+			want: &ast.Program{
+				Package: &ast.PackageClause{
+					Name: &ast.Identifier{Name: "alerts"},
+				},
+				Imports: []*ast.ImportDeclaration{
+					{
+						Path: &ast.StringLiteral{Value: "strings"},
+					},
+				},
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "crit"},
+							Init: &ast.IntegerLiteral{Value: 90},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "option statements",
+			raw: `option task = {every: 1m}
+			optional = task.every`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.OptionStatement{
+						Declaration: &ast.VariableDeclarator{
+							ID: &ast.Identifier{Name: "task"},
+							Init: &ast.ObjectExpression{
+								Properties: []*ast.Property{
+									{
+										Key:   &ast.Identifier{Name: "every"},
+										Value: &ast.DurationLiteral{Value: time.Minute},
+									},
+								},
+							},
+						},
+					},
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID: &ast.Identifier{Name: "optional"},
+							Init: &ast.MemberExpression{
+								Object:   &ast.Identifier{Name: "task"},
+								Property: &ast.Identifier{Name: "every"},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:    "option statement in a block",
+			raw:     `f = () => { option x = 1 return x }`,
+			wantErr: true,
+		},
+		{
+			name: "string literal with escape sequences",
+			raw:  `"a\tb\n\"c\" \\ \$ \x41"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringLiteral{Value: "a\tb\n\"c\" \\ $ A"},
+					},
+				},
+			},
+		},
+		{
+			name: "string literal with dollar sign and braces",
+			raw:  `"$5 {not} $ {interpolated} \${x}"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringLiteral{Value: "$5 {not} $ {interpolated} ${x}"},
+					},
+				},
+			},
+		},
+		{
+			name: "string interpolation",
+			raw:  `"cpu ${r.host} is at ${r._value + 1}%"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringExpression{
+							Parts: []ast.StringExpressionPart{
+								&ast.TextPart{Value: "cpu "},
+								&ast.InterpolatedPart{
+									Expression: &ast.MemberExpression{
+										Object:   &ast.Identifier{Name: "r"},
+										Property: &ast.Identifier{Name: "host"},
+									},
+								},
+								&ast.TextPart{Value: " is at "},
+								&ast.InterpolatedPart{
+									Expression: &ast.BinaryExpression{
+										Operator: ast.AdditionOperator,
+										Left: &ast.MemberExpression{
+											Object:   &ast.Identifier{Name: "r"},
+											Property: &ast.Identifier{Name: "_value"},
+										},
+										Right: &ast.IntegerLiteral{Value: 1},
+									},
+								},
+								&ast.TextPart{Value: "%"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "nested string interpolation",
+			raw:  `s = "a${"b${c}"}"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID: &ast.Identifier{Name: "s"},
+							Init: &ast.StringExpression{
+								Parts: []ast.StringExpressionPart{
+									&ast.TextPart{Value: "a"},
+									&ast.InterpolatedPart{
+										Expression: &ast.StringExpression{
+											Parts: []ast.StringExpressionPart{
+												&ast.TextPart{Value: "b"},
+												&ast.InterpolatedPart{
+													Expression: &ast.Identifier{Name: "c"},
+												},
+											},
+										},
+									},
+								},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional expression",
+			raw:  `level = (r) => if r._value > 90 then "crit" else if r._value > 70 then "warn" else "ok"`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID: &ast.Identifier{Name: "level"},
+							Init: &ast.ArrowFunctionExpression{
+								Params: []*ast.Property{{Key: &ast.Identifier{Name: "r"}}},
+								Body: &ast.ConditionalExpression{
+									Test: &ast.BinaryExpression{
+										Operator: ast.GreaterThanOperator,
+										Left: &ast.MemberExpression{
+											Object:   &ast.Identifier{Name: "r"},
+											Property: &ast.Identifier{Name: "_value"},
+										},
+										Right: &ast.IntegerLiteral{Value: 90},
+									},
+									Consequent: &ast.StringLiteral{Value: "crit"},
+									Alternate: &ast.ConditionalExpression{
+										Test: &ast.BinaryExpression{
+											Operator: ast.GreaterThanOperator,
+											Left: &ast.MemberExpression{
+												Object:   &ast.Identifier{Name: "r"},
+												Property: &ast.Identifier{Name: "_value"},
+											},
+											Right: &ast.IntegerLiteral{Value: 70},
+										},
+										Consequent: &ast.StringLiteral{Value: "warn"},
+										Alternate:  &ast.StringLiteral{Value: "ok"},
+									},
+								},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional expression with logical test",
+			raw:  `if a and not b then 1 else 2`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test: &ast.LogicalExpression{
+								Operator: ast.AndOperator,
+								Left:     &ast.Identifier{Name: "a"},
+								Right: &ast.UnaryExpression{
+									Operator: ast.NotOperator,
+									Argument: &ast.Identifier{Name: "b"},
+								},
+							},
+							Consequent: &ast.IntegerLiteral{Value: 1},
+							Alternate:  &ast.IntegerLiteral{Value: 2},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "identifiers starting with keywords",
+			raw:  `iffy = thenable + elsewhere`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID: &ast.Identifier{Name: "iffy"},
+							Init: &ast.BinaryExpression{
+								Operator: ast.AdditionOperator,
+								Left:     &ast.Identifier{Name: "thenable"},
+								Right:    &ast.Identifier{Name: "elsewhere"},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:    "conditional expression without else",
+			raw:     `if a then 1`,
+			wantErr: true,
+		},
+		{
+			name:    "keyword as identifier",
+			raw:     `then = 1`,
+			wantErr: true,
+		},
+		{
+			name: "in operator",
+			raw:  `r.host in ["a", "b"]`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.BinaryExpression{
+							Operator: ast.InOperator,
+							Left: &ast.MemberExpression{
+								Object:   &ast.Identifier{Name: "r"},
+								Property: &ast.Identifier{Name: "host"},
+							},
+							Right: &ast.ArrayExpression{
+								Elements: []ast.Expression{
+									&ast.StringLiteral{Value: "a"},
+									&ast.StringLiteral{Value: "b"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "empty operators",
+			raw:  `empty a and not empty b and not c`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.LogicalExpression{
+							Operator: ast.AndOperator,
+							Left: &ast.LogicalExpression{
+								Operator: ast.AndOperator,
+								Left: &ast.UnaryExpression{
+									Operator: ast.EmptyOperator,
+									Argument: &ast.Identifier{Name: "a"},
+								},
+								Right: &ast.UnaryExpression{
+									Operator: ast.NotEmptyOperator,
+									Argument: &ast.Identifier{Name: "b"},
+								},
+							},
+							Right: &ast.UnaryExpression{
+								Operator: ast.NotOperator,
+								Argument: &ast.Identifier{Name: "c"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "exists operator",
+			raw:  `exists r.host and not exists r._value`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.LogicalExpression{
+							Operator: ast.AndOperator,
+							Left: &ast.UnaryExpression{
+								Operator: ast.ExistsOperator,
+								Argument: &ast.MemberExpression{
+									Object:   &ast.Identifier{Name: "r"},
+									Property: &ast.Identifier{Name: "host"},
+								},
+							},
+							Right: &ast.UnaryExpression{
+								Operator: ast.NotOperator,
+								Argument: &ast.UnaryExpression{
+									Operator: ast.ExistsOperator,
+									Argument: &ast.MemberExpression{
+										Object:   &ast.Identifier{Name: "r"},
+										Property: &ast.Identifier{Name: "_value"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "empty array",
+			raw:  `a = []`,
+			want: &ast.Program{
+				Body: []ast.Statement{
+					&ast.VariableDeclaration{
+						Declarations: []*ast.VariableDeclarator{{
+							ID:   &ast.Identifier{Name: "a"},
+							Init: &ast.ArrayExpression{},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name:    "in as identifier",
+			raw:     `in = 1`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid escape sequence",
+			raw:     `"\q"`,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated interpolation",
+			raw:     `"a ${b"`,
+			wantErr: true,
+		},
+		{
+			name:    "package as identifier",
+			raw:     `package = 1`,
+			wantErr: true,
+		},
+		{
+			name:    "import after statement",
+			raw:     "a = 1\nimport \"strings\"",
+			wantErr: true,
+		},
 		{
 			name:    "parse error extra gibberish",
 			raw:     `from(db:"ifql") &^*&H#IUJBN`,
@@ -1574,6 +2008,66 @@ join(tables:[a,b], on:["t1"], fn: (a,b) => (a["_field"] - b["_field"]) / b["_fie
 	}
 }
 
+func TestParse_Location(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+		want ast.SourceLocation
+	}{
+		{
+			name: "call",
+			raw:  `a = f()`,
+			want: ast.SourceLocation{
+				Start: ast.Position{Line: 1, Column: 5},
+				End:   ast.Position{Line: 1, Column: 8},
+			},
+		},
+		{
+			name: "index call result",
+			raw:  `a = f()[0]`,
+			want: ast.SourceLocation{
+				Start: ast.Position{Line: 1, Column: 5},
+				End:   ast.Position{Line: 1, Column: 11},
+			},
+		},
+		{
+			name: "call call result",
+			raw:  `a = f()(x: 1)`,
+			want: ast.SourceLocation{
+				Start: ast.Position{Line: 1, Column: 5},
+				End:   ast.Position{Line: 1, Column: 14},
+			},
+		},
+		{
+			name: "member of call result",
+			raw:  `a = f().b`,
+			want: ast.SourceLocation{
+				Start: ast.Position{Line: 1, Column: 5},
+				End:   ast.Position{Line: 1, Column: 10},
+			},
+		},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			program, err := parser.NewAST(tt.raw)
+			if err != nil {
+				t.Fatal(err)
+			}
+			init := program.Body[0].(*ast.VariableDeclaration).Declarations[0].Init
+			got := *init.Location()
+			got.Source = nil
+			if !cmp.Equal(tt.want, got) {
+				t.Errorf("unexpected location -want/+got %s", cmp.Diff(tt.want, got))
+			}
+			if src := *init.Location().Source; src != tt.raw[4:] {
+				t.Errorf("unexpected source: want %q, got %q", tt.raw[4:], src)
+			}
+		})
+	}
+}
+
 var benchmarkQuery = []byte(`
 start = -10s
 
@@ -0,0 +1,607 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/influxdata/ifql/ast"
+)
+
+// Diagnostic is a syntax error at a location in the source.
+type Diagnostic struct {
+	Location ast.SourceLocation `json:"location"`
+	Message  string             `json:"message"`
+	// Expected lists what the parser expected at the start of the location, if known.
+	Expected []string `json:"expected,omitempty"`
+}
+
+func (d Diagnostic) Error() string {
+	msg := fmt.Sprintf("%d:%d: %s", d.Location.Start.Line, d.Location.Start.Column, d.Message)
+	if len(d.Expected) > 0 {
+		msg += ", expected " + listJoin(d.Expected, ", ", "or")
+	}
+	return msg
+}
+
+// Diagnostics are the syntax errors of a source, ordered by location.
+type Diagnostics []Diagnostic
+
+func (ds Diagnostics) Error() string {
+	msgs := make([]string, len(ds))
+	for i, d := range ds {
+		msgs[i] = d.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// NewASTWithDiagnostics parses the ifql query like NewAST, but does not stop at the first syntax error.
+// A statement with an error is parsed again without the pipe stage containing the error,
+// or skipped if the error is not within a pipe stage, and parsing continues with the next statement.
+// A statement starts on a line that is not indented and does not start with a pipe, closing bracket or comment.
+// The statements of function bodies are recovered the same way.
+//
+// The program contains the statements that could be parsed, with their locations in the full query.
+func NewASTWithDiagnostics(ifql string, opts ...Option) (*ast.Program, Diagnostics) {
+	if program, err := NewAST(ifql, opts...); err == nil {
+		return program, nil
+	}
+
+	r := &recoverer{
+		opts: opts,
+		seen: make(map[ast.Position]bool),
+	}
+	for _, l := range strings.Split(ifql, "\n") {
+		r.lines = append(r.lines, []rune(l))
+	}
+	program := &ast.Program{
+		BaseNode: base([]byte(ifql), position{line: 1, col: 1}),
+	}
+	r.recoverStatements(r.statements(), func(p *ast.Program) {
+		r.merge(program, p)
+	})
+	sort.SliceStable(r.diagnostics, func(i, j int) bool {
+		a, b := r.diagnostics[i].Location.Start, r.diagnostics[j].Location.Start
+		return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+	})
+	return program, r.diagnostics
+}
+
+// recoverStatements parses the statements and passes each program that could be parsed or recovered to add.
+func (r *recoverer) recoverStatements(statements []span, add func(p *ast.Program)) {
+	for i := 0; i < len(statements); i++ {
+		s := statements[i]
+		p, err := r.parse(s)
+		if err != nil {
+			// A statement may continue on lines that are not indented, until its brackets are balanced.
+			if depth := r.depth(s); depth > 0 {
+				for j := i + 1; j < len(statements) && depth > 0; j++ {
+					depth += r.depth(statements[j])
+					if depth == 0 {
+						s = span{start: s.start, end: statements[j].end}
+						p, err = r.parse(s)
+						i = j
+					}
+				}
+			}
+		}
+		if err != nil {
+			p = r.recover(s, err)
+		}
+		if p != nil {
+			add(p)
+		}
+	}
+}
+
+// span is a range of lines, counted from zero, with an inclusive end.
+type span struct {
+	start, end int
+}
+
+// recoverer parses the statements of a source separately, skipping the text that contains errors.
+// Skipped text is replaced by spaces, so the remaining nodes keep their locations.
+type recoverer struct {
+	opts        []Option
+	lines       [][]rune
+	diagnostics Diagnostics
+	seen        map[ast.Position]bool
+}
+
+// statements splits the source into statements.
+func (r *recoverer) statements() []span {
+	var spans []span
+	for i := range r.lines {
+		if i == 0 || r.isStatementLine(i) {
+			spans = append(spans, span{start: i, end: i})
+		} else {
+			spans[len(spans)-1].end = i
+		}
+	}
+	return spans
+}
+
+// isStatementLine reports whether the line starts a statement,
+// which is a line that is not indented and does not start with a pipe, closing bracket or comment.
+func (r *recoverer) isStatementLine(line int) bool {
+	l := r.lines[line]
+	if len(l) == 0 || unicode.IsSpace(l[0]) || strings.ContainsRune("|)]}", l[0]) {
+		return false
+	}
+	return !strings.HasPrefix(string(l), "//")
+}
+
+// parse parses the lines of the span.
+// The lines are preceded by empty lines so the nodes have their locations in the full source,
+// and followed by a newline so the span may end with a comment.
+func (r *recoverer) parse(s span) (*ast.Program, error) {
+	var b strings.Builder
+	b.WriteString(strings.Repeat("\n", s.start))
+	for i := s.start; i <= s.end; i++ {
+		if i > s.start {
+			b.WriteByte('\n')
+		}
+		b.WriteString(string(r.lines[i]))
+	}
+	// A comment ends with a newline.
+	b.WriteByte('\n')
+	f, err := Parse("", []byte(b.String()), r.opts...)
+	if err != nil {
+		return nil, err
+	}
+	return f.(*ast.Program), nil
+}
+
+// depth returns the number of brackets the span opens but does not close.
+func (r *recoverer) depth(s span) int {
+	depth := 0
+	for i := s.start; i <= s.end; i++ {
+		l := r.lines[i]
+		inString := false
+	line:
+		for j := 0; j < len(l); j++ {
+			switch c := l[j]; {
+			case inString && c == '\\':
+				j++
+			case c == '"':
+				inString = !inString
+			case inString:
+			case c == '/' && j+1 < len(l) && l[j+1] == '/':
+				break line
+			case c == '(' || c == '[' || c == '{':
+				depth++
+			case c == ')' || c == ']' || c == '}':
+				depth--
+			}
+		}
+	}
+	return depth
+}
+
+// recover reports the errors of the statement and parses it again without the pipe stages containing them.
+// If an error is not within a pipe stage, the statement is skipped and recover returns nil.
+func (r *recoverer) recover(s span, err error) *ast.Program {
+	if r.recoverBlocks(s) {
+		p, perr := r.parse(s)
+		if perr == nil {
+			return p
+		}
+		err = perr
+	}
+	for {
+		errs, ok := err.(errList)
+		if !ok {
+			errs = errList{err}
+		}
+		skipped := false
+		for _, e := range errs {
+			pe, ok := e.(*parserError)
+			if !ok {
+				// An error without a position cannot be recovered from.
+				r.add(ast.Position{Line: s.start + 1, Column: 1}, e, nil)
+				r.blank(s.start, 0, s.end, len(r.lines[s.end]))
+				return nil
+			}
+			pos := ast.Position{Line: pe.pos.line, Column: pe.pos.col}
+			if pos.Line > s.end+1 {
+				// The error is at the newline that ends the span.
+				pos = ast.Position{Line: s.end + 1, Column: len(r.lines[s.end]) + 1}
+			}
+			r.add(pos, pe.Inner, pe.expected)
+			if r.skip(s, pos) {
+				skipped = true
+			}
+		}
+		if !skipped {
+			return nil
+		}
+		p, perr := r.parse(s)
+		if perr == nil {
+			return p
+		}
+		err = perr
+	}
+}
+
+// block is a function body within a statement, from the line of its opening brace to the line of its closing brace.
+// The statements of the body are on the lines in between.
+type block struct {
+	open, close int
+}
+
+// recoverBlocks recovers the statements of the function bodies in the span separately,
+// as the statements of the source are recovered.
+// Only bodies whose braces are on lines of their own are recovered, i.e. the opening brace ends its line
+// and the closing brace starts its line.
+// It reports whether any text was skipped.
+func (r *recoverer) recoverBlocks(s span) bool {
+	skipped := false
+	for _, b := range r.blocks(s) {
+		var statements []span
+		for i := b.open + 1; i < b.close; i++ {
+			l := strings.TrimSpace(string(r.lines[i]))
+			if l == "" {
+				continue
+			}
+			if len(statements) == 0 || !strings.ContainsRune("|)]}", rune(l[0])) && !strings.HasPrefix(l, "//") {
+				statements = append(statements, span{start: i, end: i})
+			} else {
+				statements[len(statements)-1].end = i
+			}
+		}
+		saved := r.save(s)
+		r.recoverStatements(statements, func(*ast.Program) {})
+		if r.isBlank(span{start: b.open + 1, end: b.close - 1}) {
+			// A body must have a statement, so the errors are recovered from as errors of the statement.
+			r.restore(s, saved)
+			continue
+		}
+		for i, l := range saved {
+			if string(l) != string(r.lines[s.start+i]) {
+				skipped = true
+			}
+		}
+	}
+	return skipped
+}
+
+// blocks returns the outermost function bodies of the span that can be recovered.
+func (r *recoverer) blocks(s span) []block {
+	type brace struct {
+		line   int
+		isBody bool
+	}
+	var (
+		blocks []block
+		stack  []brace
+		bodies int
+	)
+	for i := s.start; i <= s.end; i++ {
+		l := r.lines[i]
+		inString := false
+	line:
+		for j := 0; j < len(l); j++ {
+			switch c := l[j]; {
+			case inString && c == '\\':
+				j++
+			case c == '"':
+				inString = !inString
+			case inString:
+			case c == '/' && j+1 < len(l) && l[j+1] == '/':
+				break line
+			case c == '{':
+				before := strings.TrimSpace(string(l[:j]))
+				after := strings.TrimSpace(string(l[j+1:]))
+				isBody := strings.HasSuffix(before, "=>") && (after == "" || strings.HasPrefix(after, "//"))
+				if isBody {
+					bodies++
+				}
+				stack = append(stack, brace{line: i, isBody: isBody})
+			case c == '}':
+				if len(stack) == 0 {
+					continue
+				}
+				b := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				if !b.isBody {
+					continue
+				}
+				bodies--
+				if bodies == 0 && strings.TrimSpace(string(l[:j])) == "" {
+					blocks = append(blocks, block{open: b.line, close: i})
+				}
+			}
+		}
+	}
+	return blocks
+}
+
+// atEnd reports whether the position is at the end of the span,
+// in which case the error may be an unclosed bracket in any of its pipe stages.
+func (r *recoverer) atEnd(s span, pos ast.Position) bool {
+	for l := pos.Line - 1; l <= s.end; l++ {
+		from := 0
+		if l == pos.Line-1 {
+			from = pos.Column - 1
+		}
+		if from < len(r.lines[l]) && strings.TrimSpace(string(r.lines[l][from:])) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// skip replaces the pipe stage of the statement that contains the position with spaces,
+// or the whole statement if the position is not within a pipe stage.
+// A pipe stage starts at a pipe and ends before the next pipe.
+// If the position is at the end of the statement, the first pipe stage without which the statement parses is skipped.
+// It reports whether any text was skipped.
+func (r *recoverer) skip(s span, pos ast.Position) bool {
+	pipes := r.pipes(s)
+	if r.atEnd(s, pos) {
+		for i, p := range pipes {
+			end := ast.Position{Line: s.end + 1, Column: len(r.lines[s.end]) + 1}
+			if i+1 < len(pipes) {
+				end = pipes[i+1]
+			}
+			saved := r.save(s)
+			if r.blank(p.Line-1, p.Column-1, end.Line-1, end.Column-1) {
+				if _, err := r.parse(s); err == nil {
+					return true
+				}
+			}
+			r.restore(s, saved)
+		}
+	}
+
+	start := ast.Position{Line: s.start + 1, Column: 1}
+	end := ast.Position{Line: s.end + 1, Column: len(r.lines[s.end]) + 1}
+	for _, p := range pipes {
+		if before(p, pos) {
+			start = p
+		} else if before(pos, p) {
+			end = p
+			break
+		}
+	}
+	return r.blank(start.Line-1, start.Column-1, end.Line-1, end.Column-1)
+}
+
+// pipes returns the positions of the pipes in the statement.
+func (r *recoverer) pipes(s span) []ast.Position {
+	var pipes []ast.Position
+	for i := s.start; i <= s.end; i++ {
+		l := r.lines[i]
+		for j := 0; j+1 < len(l); j++ {
+			if l[j] == '|' && l[j+1] == '>' {
+				pipes = append(pipes, ast.Position{Line: i + 1, Column: j + 1})
+			}
+		}
+	}
+	return pipes
+}
+
+func before(a, b ast.Position) bool {
+	return a.Line < b.Line || a.Line == b.Line && a.Column < b.Column
+}
+
+// blank replaces the text from the start line and column to the end line and column with spaces, all counted from zero.
+// It reports whether any text was replaced.
+func (r *recoverer) blank(startLine, startCol, endLine, endCol int) bool {
+	blanked := false
+	for l := startLine; l <= endLine && l < len(r.lines); l++ {
+		from, to := 0, len(r.lines[l])
+		if l == startLine {
+			from = startCol
+		}
+		if l == endLine && endCol < to {
+			to = endCol
+		}
+		for i := from; i < to; i++ {
+			if !unicode.IsSpace(r.lines[l][i]) {
+				r.lines[l][i] = ' '
+				blanked = true
+			}
+		}
+	}
+	return blanked
+}
+
+// isBlank reports whether the lines of the span contain only spaces.
+func (r *recoverer) isBlank(s span) bool {
+	for i := s.start; i <= s.end; i++ {
+		if strings.TrimSpace(string(r.lines[i])) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *recoverer) save(s span) [][]rune {
+	saved := make([][]rune, s.end-s.start+1)
+	for i := range saved {
+		saved[i] = append([]rune(nil), r.lines[s.start+i]...)
+	}
+	return saved
+}
+
+func (r *recoverer) restore(s span, saved [][]rune) {
+	for i, l := range saved {
+		r.lines[s.start+i] = l
+	}
+}
+
+// add adds a diagnostic for an error at the position, unless the position already has one.
+// The location of the diagnostic is the token at the position.
+func (r *recoverer) add(pos ast.Position, err error, expected []string) {
+	if r.seen[pos] {
+		return
+	}
+	r.seen[pos] = true
+
+	token := r.token(pos)
+	end := pos
+	end.Column += len([]rune(token))
+	msg := err.Error()
+	if strings.HasPrefix(msg, "no match found") {
+		if token == "" {
+			msg = "unexpected end of statement"
+		} else {
+			msg = fmt.Sprintf("unexpected %q", token)
+		}
+	}
+	r.diagnostics = append(r.diagnostics, Diagnostic{
+		Location: ast.SourceLocation{
+			Start:  pos,
+			End:    end,
+			Source: &token,
+		},
+		Message:  msg,
+		Expected: hints(expected),
+	})
+}
+
+// hints returns readable names for the expected tokens, in order and without duplicates.
+// Whitespace and comments are left out, since they are expected almost everywhere,
+// and so is the end of the source, since each statement is parsed on its own.
+// Where an expression is expected, the tokens that start one are named "expression".
+func hints(expected []string) []string {
+	var hs []string
+	seen := make(map[string]bool)
+	isExpr := false
+	for _, e := range expected {
+		// A string is only expected where an expression is.
+		if e == `"\""` {
+			isExpr = true
+		}
+	}
+	for _, e := range expected {
+		if e == `[ \t\r\n]` || e == `"//"` || e == "EOF" {
+			continue
+		}
+		h := hint(e)
+		if isExpr && exprHints[e] {
+			h = "expression"
+		}
+		if !seen[h] {
+			seen[h] = true
+			hs = append(hs, h)
+		}
+	}
+	return hs
+}
+
+// exprHints are the expected tokens of the grammar that start an expression.
+var exprHints = map[string]bool{
+	`"("`: true, `"["`: true, `"{"`: true, `"/"`: true, `"\""`: true,
+	`"0"`: true, `[0-9]`: true, `[1-9]`: true, `[_\pL]`: true,
+	`"true"`: true, `"false"`: true, `"if"`: true,
+	`"-"`: true, `"not"`: true, `"empty"`: true, `"exists"`: true, `"<-"`: true,
+}
+
+// operatorHints are the literals of the grammar that are operators.
+var operatorHints = map[string]bool{
+	"+": true, "-": true, "*": true, "/": true,
+	"==": true, "!=": true, "=~": true, "!~": true,
+	"<": true, "<=": true, ">": true, ">=": true,
+	"and": true, "or": true, "not": true, "in": true,
+	"startswith": true, "empty": true, "exists": true,
+	"|>": true, "<-": true,
+}
+
+// durationUnitHints are the literals of the grammar that are duration units.
+var durationUnitHints = map[string]bool{
+	"ns": true, "us": true, "µs": true, "μs": true,
+	"ms": true, "s": true, "m": true, "h": true,
+}
+
+// hint returns a readable name for an expected token, which is a literal or character class of the grammar.
+// Punctuation and keywords are returned as they are written, e.g. "(" or "true".
+func hint(e string) string {
+	if strings.HasPrefix(e, "[") {
+		switch {
+		case strings.Contains(e, `\pL`):
+			return "identifier"
+		case strings.Contains(e, "0-9") || strings.Contains(e, "1-9"):
+			return "number"
+		default:
+			return "operator"
+		}
+	}
+	lit, err := strconv.Unquote(strings.TrimSuffix(e, "i"))
+	if err != nil {
+		return e
+	}
+	switch {
+	case lit == `"`:
+		return "string"
+	case lit == "/":
+		// A slash starts a regular expression where a primary expression is expected.
+		// Where an operator is expected, the grammar uses the character class [*/] instead.
+		return "regular expression"
+	case lit != "" && unicode.IsDigit([]rune(lit)[0]):
+		return "number"
+	case operatorHints[lit]:
+		return "operator"
+	case durationUnitHints[lit]:
+		return "duration unit"
+	}
+	return strconv.Quote(lit)
+}
+
+// token returns the word or single character at the position.
+func (r *recoverer) token(pos ast.Position) string {
+	line, col := pos.Line-1, pos.Column-1
+	if line >= len(r.lines) || col >= len(r.lines[line]) {
+		return ""
+	}
+	l := r.lines[line]
+	if unicode.IsSpace(l[col]) {
+		return ""
+	}
+	end := col + 1
+	if isWordRune(l[col]) {
+		for end < len(l) && isWordRune(l[end]) {
+			end++
+		}
+	}
+	return string(l[col:end])
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// merge adds the package, imports and statements of a recovered statement to the program.
+// A package clause or import declaration that does not precede all statements is an error.
+func (r *recoverer) merge(program, p *ast.Program) {
+	if p.Package != nil {
+		if program.Package != nil || len(program.Imports) > 0 || len(program.Body) > 0 {
+			r.misplaced(p.Package, "package clause must precede imports and statements")
+		} else {
+			program.Package = p.Package
+		}
+	}
+	for _, imp := range p.Imports {
+		if len(program.Body) > 0 {
+			r.misplaced(imp, "import declaration must precede statements")
+		} else {
+			program.Imports = append(program.Imports, imp)
+		}
+	}
+	program.Body = append(program.Body, p.Body...)
+}
+
+func (r *recoverer) misplaced(n ast.Node, msg string) {
+	loc := n.Location()
+	if loc == nil || r.seen[loc.Start] {
+		return
+	}
+	r.seen[loc.Start] = true
+	r.diagnostics = append(r.diagnostics, Diagnostic{
+		Location: *loc,
+		Message:  msg,
+	})
+}
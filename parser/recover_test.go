@@ -0,0 +1,214 @@
+package parser_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/format"
+	"github.com/influxdata/ifql/parser"
+)
+
+func TestNewASTWithDiagnostics(t *testing.T) {
+	type diagnostic struct {
+		Start   ast.Position
+		End     ast.Position
+		Message string
+	}
+	testCases := []struct {
+		name        string
+		raw         string
+		want        string
+		diagnostics []diagnostic
+	}{
+		{
+			name: "valid",
+			raw: `a = from(db:"telegraf")
+    |> range(start:-1h)`,
+			want: `a = from(db: "telegraf")
+    |> range(start: -1h)
+`,
+		},
+		{
+			name: "statements",
+			raw: `a = 1 +
+b = 2
+c = "unterminated
+d = [1,
+    2]`,
+			want: `b = 2
+d = [1, 2]
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 1, Column: 8}, End: ast.Position{Line: 1, Column: 8}, Message: "unexpected end of statement"},
+				{Start: ast.Position{Line: 3, Column: 5}, End: ast.Position{Line: 3, Column: 6}, Message: "string literal not terminated"},
+			},
+		},
+		{
+			name: "pipe stages",
+			raw: `from(db:"telegraf")
+    |> range(start:-1h)
+    |> filter(fn: (r) => r._value > )
+    |> limit(n:)
+    |> sum()
+x = 1`,
+			want: `from(db: "telegraf")
+    |> range(start: -1h)
+    |> sum()
+x = 1
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 3, Column: 37}, End: ast.Position{Line: 3, Column: 38}, Message: `unexpected ")"`},
+				{Start: ast.Position{Line: 4, Column: 16}, End: ast.Position{Line: 4, Column: 17}, Message: `unexpected ")"`},
+			},
+		},
+		{
+			name: "unclosed call",
+			raw: `a = from(db:"telegraf")
+    |> range(start:-1h
+    |> sum()
+b = a`,
+			want: `a = from(db: "telegraf")
+    |> sum()
+b = a
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 3, Column: 13}, End: ast.Position{Line: 3, Column: 13}, Message: "unexpected end of statement"},
+			},
+		},
+		{
+			name: "block body",
+			raw: `f = (r) => {
+x = r *
+y = 2
+return x + y
+}
+g = f(r: 1)`,
+			want: `f = (r) => {
+    y = 2
+    return x + y
+}
+g = f(r: 1)
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 2, Column: 8}, End: ast.Position{Line: 2, Column: 8}, Message: "unexpected end of statement"},
+			},
+		},
+		{
+			name: "trailing comment",
+			raw: `x = 1 // ok
+y = )`,
+			want: `x = 1
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 2, Column: 5}, End: ast.Position{Line: 2, Column: 6}, Message: `unexpected ")"`},
+			},
+		},
+		{
+			name: "function body statements",
+			raw: `f = (r) => {
+    a = 
+    return 1
+}
+b = ]`,
+			want: `f = (r) => {
+    return 1
+}
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 2, Column: 9}, End: ast.Position{Line: 2, Column: 9}, Message: "unexpected end of statement"},
+				{Start: ast.Position{Line: 5, Column: 5}, End: ast.Position{Line: 5, Column: 6}, Message: `unexpected "]"`},
+			},
+		},
+		{
+			name: "empty function body",
+			raw: `f = () => {
+    )
+}
+b = 1`,
+			want: `b = 1
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 2, Column: 5}, End: ast.Position{Line: 2, Column: 6}, Message: `unexpected ")"`},
+			},
+		},
+		{
+			name: "misplaced import",
+			raw: `a = 1
+import "strings"
+b = 2`,
+			want: `a = 1
+b = 2
+`,
+			diagnostics: []diagnostic{
+				{Start: ast.Position{Line: 2, Column: 1}, End: ast.Position{Line: 2, Column: 17}, Message: "import declaration must precede statements"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			program, diagnostics := parser.NewASTWithDiagnostics(tc.raw)
+			var got []diagnostic
+			for _, d := range diagnostics {
+				got = append(got, diagnostic{
+					Start:   d.Location.Start,
+					End:     d.Location.End,
+					Message: d.Message,
+				})
+			}
+			if !cmp.Equal(tc.diagnostics, got) {
+				t.Errorf("unexpected diagnostics -want/+got:\n%s", cmp.Diff(tc.diagnostics, got))
+			}
+			if src := format.Node(program); src != tc.want {
+				t.Errorf("unexpected program -want/+got:\n%s", cmp.Diff(tc.want, src))
+			}
+		})
+	}
+}
+
+func TestNewASTWithDiagnostics_Expected(t *testing.T) {
+	testCases := []struct {
+		name     string
+		raw      string
+		expected []string
+	}{
+		{
+			name:     "pipe destination",
+			raw:      `from(db:"telegraf") |> `,
+			expected: []string{"identifier"},
+		},
+		{
+			name:     "expression",
+			raw:      `a = 1 +`,
+			expected: []string{"expression"},
+		},
+		{
+			name:     "array element",
+			raw:      `a = [1, `,
+			expected: []string{"expression"},
+		},
+		{
+			name:     "statement",
+			raw:      `a = 1 )`,
+			expected: []string{"operator", "expression", `"option"`, `"return"`},
+		},
+		{
+			name:     "call argument",
+			raw:      `a = f(b: 1`,
+			expected: []string{"operator", `")"`, `","`},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, diags := parser.NewASTWithDiagnostics(tc.raw)
+			if len(diags) != 1 {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !cmp.Equal(tc.expected, diags[0].Expected) {
+				t.Errorf("unexpected expected tokens -want/+got:\n%s", cmp.Diff(tc.expected, diags[0].Expected))
+			}
+		})
+	}
+}
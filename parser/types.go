@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"errors"
 	"regexp"
 	"strconv"
 	"strings"
@@ -16,13 +17,49 @@ func toIfaceSlice(v interface{}) []interface{} {
 	return v.([]interface{})
 }
 
-func program(body interface{}, text []byte, pos position) (*ast.Program, error) {
-	return &ast.Program{
-		Body:     body.([]ast.Statement),
+func program(pkg, imports, body interface{}, text []byte, pos position) (*ast.Program, error) {
+	p := &ast.Program{
+		BaseNode: base(text, pos),
+	}
+	if pkg != nil {
+		p.Package = pkg.(*ast.PackageClause)
+	}
+	if imports != nil {
+		p.Imports = imports.([]*ast.ImportDeclaration)
+	}
+	if body != nil {
+		p.Body = body.([]ast.Statement)
+	}
+	return p, nil
+}
+
+func pkgclause(name interface{}, text []byte, pos position) (*ast.PackageClause, error) {
+	return &ast.PackageClause{
+		Name:     name.(*ast.Identifier),
 		BaseNode: base(text, pos),
 	}, nil
 }
 
+func importdecls(head, tails interface{}) ([]*ast.ImportDeclaration, error) {
+	decls := []*ast.ImportDeclaration{head.(*ast.ImportDeclaration)}
+	for _, tail := range toIfaceSlice(tails) {
+		decl := toIfaceSlice(tail)[1] // Skip whitespace
+		decls = append(decls, decl.(*ast.ImportDeclaration))
+	}
+	return decls, nil
+}
+
+func importdecl(as, path interface{}, text []byte, pos position) (*ast.ImportDeclaration, error) {
+	d := &ast.ImportDeclaration{
+		Path:     path.(*ast.StringLiteral),
+		BaseNode: base(text, pos),
+	}
+	if as != nil {
+		d.As = toIfaceSlice(as)[0].(*ast.Identifier)
+	}
+	return d, nil
+}
+
 func srcElems(head, tails interface{}) ([]ast.Statement, error) {
 	elems := []ast.Statement{head.(ast.Statement)}
 	for _, tail := range toIfaceSlice(tails) {
@@ -52,6 +89,13 @@ func varstmt(declaration interface{}, text []byte, pos position) (*ast.VariableD
 	}, nil
 }
 
+func optionstmt(declaration interface{}, text []byte, pos position) (*ast.OptionStatement, error) {
+	return &ast.OptionStatement{
+		Declaration: declaration.(*ast.VariableDeclarator),
+		BaseNode:    base(text, pos),
+	}, nil
+}
+
 func vardecl(id, initializer interface{}, text []byte, pos position) (*ast.VariableDeclarator, error) {
 	return &ast.VariableDeclarator{
 		ID:   id.(*ast.Identifier),
@@ -115,7 +159,7 @@ func memberexpr(object, property interface{}, text []byte, pos position) (*ast.M
 	}
 
 	if property != nil {
-		m.Property = property.(*ast.Identifier)
+		m.Property = property.(ast.Expression)
 	}
 
 	return m, nil
@@ -138,12 +182,18 @@ func callexpr(callee, args interface{}, text []byte, pos position) (*ast.CallExp
 
 func callexprs(head, tail interface{}, text []byte, pos position) (ast.Expression, error) {
 	expr := head.(ast.Expression)
+	// Each call or member of the tail spans from the start of the head to its own end.
+	end := len(*expr.Location().Source)
 	for _, i := range toIfaceSlice(tail) {
 		switch elem := i.(type) {
 		case *ast.CallExpression:
+			end += len(*elem.Loc.Source)
+			elem.BaseNode = base(text[:end], pos)
 			elem.Callee = expr
 			expr = elem
 		case *ast.MemberExpression:
+			end += len(*elem.Loc.Source)
+			elem.BaseNode = base(text[:end], pos)
 			elem.Object = expr
 			expr = elem
 		}
@@ -245,6 +295,15 @@ func binaryExpression(head, tails interface{}, text []byte, pos position) (ast.E
 	return res, nil
 }
 
+func conditionalExpression(test, consequent, alternate interface{}, text []byte, pos position) (*ast.ConditionalExpression, error) {
+	return &ast.ConditionalExpression{
+		Test:       test.(ast.Expression),
+		Consequent: consequent.(ast.Expression),
+		Alternate:  alternate.(ast.Expression),
+		BaseNode:   base(text, pos),
+	}, nil
+}
+
 func unaryExpression(op, argument interface{}, text []byte, pos position) (*ast.UnaryExpression, error) {
 	return &ast.UnaryExpression{
 		Operator: op.(ast.OperatorKind),
@@ -254,11 +313,13 @@ func unaryExpression(op, argument interface{}, text []byte, pos position) (*ast.
 }
 
 func operator(text []byte) (ast.OperatorKind, error) {
-	return ast.OperatorLookup(strings.ToLower(string(text))), nil
+	// Operators made of several words, i.e. "not empty", may be separated by any whitespace.
+	op := strings.Join(strings.Fields(string(text)), " ")
+	return ast.OperatorLookup(strings.ToLower(op)), nil
 }
 
 func stringLiteral(text []byte, pos position) (*ast.StringLiteral, error) {
-	s, err := strconv.Unquote(string(text))
+	s, err := unescape(text[1 : len(text)-1])
 	if err != nil {
 		return nil, err
 	}
@@ -268,6 +329,80 @@ func stringLiteral(text []byte, pos position) (*ast.StringLiteral, error) {
 	}, nil
 }
 
+// unterminatedString returns the error of a string literal without a closing quote.
+// The literal is a nil expression, so the enclosing nodes can still be built.
+func unterminatedString() (*ast.StringLiteral, error) {
+	return nil, errors.New("string literal not terminated")
+}
+
+func stringExpression(parts interface{}, text []byte, pos position) (*ast.StringExpression, error) {
+	ps := toIfaceSlice(parts)
+	e := &ast.StringExpression{
+		BaseNode: base(text, pos),
+		Parts:    make([]ast.StringExpressionPart, len(ps)),
+	}
+	for i, p := range ps {
+		e.Parts[i] = p.(ast.StringExpressionPart)
+	}
+	return e, nil
+}
+
+func textPart(text []byte, pos position) (*ast.TextPart, error) {
+	s, err := unescape(text)
+	if err != nil {
+		return nil, err
+	}
+	return &ast.TextPart{
+		BaseNode: base(text, pos),
+		Value:    s,
+	}, nil
+}
+
+func interpolatedPart(expr interface{}, text []byte, pos position) (*ast.InterpolatedPart, error) {
+	return &ast.InterpolatedPart{
+		BaseNode:   base(text, pos),
+		Expression: expr.(ast.Expression),
+	}, nil
+}
+
+// unescape replaces the escape sequences of the text of a string.
+// The grammar has already validated the escape sequences.
+func unescape(text []byte) (string, error) {
+	b := new(strings.Builder)
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' {
+			b.WriteByte(text[i])
+			continue
+		}
+		i++
+		if i >= len(text) {
+			return "", errors.New("invalid escape character")
+		}
+		switch text[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 'r':
+			b.WriteByte('\r')
+		case 't':
+			b.WriteByte('\t')
+		case 'x':
+			if i+2 >= len(text) {
+				return "", errors.New("invalid byte value")
+			}
+			n, err := strconv.ParseUint(string(text[i+1:i+3]), 16, 8)
+			if err != nil {
+				return "", err
+			}
+			b.WriteByte(byte(n))
+			i += 2
+		default:
+			// Escaped double quote, backslash or dollar sign
+			b.WriteByte(text[i])
+		}
+	}
+	return b.String(), nil
+}
+
 func pipeLiteral(text []byte, pos position) *ast.PipeLiteral {
 	return &ast.PipeLiteral{
 		BaseNode: base(text, pos),
@@ -1,6 +1,7 @@
 package promql
 
 import (
+	"regexp"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/semantic"
 	"github.com/influxdata/ifql/semantic/semantictest"
 )
@@ -416,7 +418,7 @@ func TestBuild(t *testing.T) {
 						},
 					},
 					{
-						ID: query.OperationID("count"), Spec: &functions.CountOpSpec{},
+						ID: query.OperationID("count"), Spec: &functions.CountOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
 					},
 				},
 				Edges: []query.Edge{
@@ -443,7 +445,7 @@ func TestBuild(t *testing.T) {
 					{
 						ID: query.OperationID("range"),
 						Spec: &functions.RangeOpSpec{
-							Start: query.Time{Relative: -time.Minute * 7},
+							Start: query.Time{IsRelative: true, Relative: -time.Minute * 7},
 						},
 					},
 					{
@@ -494,6 +496,69 @@ func TestBuild(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "range of time with a regular expression label matcher",
+			promql: `node_cpu{mode=~"us.*"}[2m] offset 5m`,
+			want: &query.Spec{
+				Operations: []*query.Operation{
+					{
+						ID:   query.OperationID("from"),
+						Spec: &functions.FromOpSpec{Database: "prometheus"},
+					},
+					{
+						ID: query.OperationID("range"),
+						Spec: &functions.RangeOpSpec{
+							Start: query.Time{IsRelative: true, Relative: -time.Minute * 7},
+						},
+					},
+					{
+						ID: "where",
+						Spec: &functions.FilterOpSpec{
+							Fn: &semantic.FunctionExpression{
+								Params: []*semantic.FunctionParam{{Key: &semantic.Identifier{Name: "r"}}},
+								Body: &semantic.LogicalExpression{
+									Operator: ast.AndOperator,
+									Left: &semantic.BinaryExpression{
+										Operator: ast.EqualOperator,
+										Left: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "r",
+											},
+											Property: "_metric",
+										},
+										Right: &semantic.StringLiteral{
+											Value: "node_cpu",
+										},
+									},
+									Right: &semantic.BinaryExpression{
+										Operator: ast.RegexpMatchOperator,
+										Left: &semantic.MemberExpression{
+											Object: &semantic.IdentifierExpression{
+												Name: "r",
+											},
+											Property: "mode",
+										},
+										Right: &semantic.RegexpLiteral{
+											Value: regexp.MustCompile(`^(?:us.*)$`),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				Edges: []query.Edge{
+					{
+						Parent: query.OperationID("from"),
+						Child:  query.OperationID("range"),
+					},
+					{
+						Parent: query.OperationID("range"),
+						Child:  query.OperationID("where"),
+					},
+				},
+			},
+		},
 
 		{
 			name:   "sum over a range",
@@ -507,7 +572,7 @@ func TestBuild(t *testing.T) {
 					{
 						ID: query.OperationID("range"),
 						Spec: &functions.RangeOpSpec{
-							Start: query.Time{Relative: -170 * time.Hour},
+							Start: query.Time{IsRelative: true, Relative: -170 * time.Hour},
 						},
 					},
 					{
@@ -546,7 +611,7 @@ func TestBuild(t *testing.T) {
 						},
 					},
 					{
-						ID: query.OperationID("sum"), Spec: &functions.SumOpSpec{},
+						ID: query.OperationID("sum"), Spec: &functions.SumOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
 					},
 				},
 				Edges: []query.Edge{
@@ -2,6 +2,7 @@ package promql
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/influxdata/ifql/ast"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/semantic"
 )
 
@@ -194,7 +196,8 @@ func NewRangeOp(rng, offset time.Duration) (*query.Operation, error) {
 		ID: "range", // TODO: Change this to a UUID
 		Spec: &functions.RangeOpSpec{
 			Start: query.Time{
-				Relative: -rng - offset,
+				IsRelative: true,
+				Relative:   -rng - offset,
 			},
 		},
 	}, nil
@@ -203,8 +206,8 @@ func NewRangeOp(rng, offset time.Duration) (*query.Operation, error) {
 var operatorLookup = map[MatchKind]ast.OperatorKind{
 	Equal:        ast.EqualOperator,
 	NotEqual:     ast.NotEqualOperator,
-	RegexMatch:   ast.EqualOperator,
-	RegexNoMatch: ast.NotEqualOperator,
+	RegexMatch:   ast.RegexpMatchOperator,
+	RegexNoMatch: ast.NotRegexpMatchOperator,
 }
 
 func NewWhereOperation(metricName string, labels []*LabelMatcher) (*query.Operation, error) {
@@ -233,7 +236,16 @@ func NewWhereOperation(metricName string, labels []*LabelMatcher) (*query.Operat
 			Property: label.Name,
 		}
 		var value semantic.Expression
-		if label.Value.Type() == StringKind {
+		if label.Kind == RegexMatch || label.Kind == RegexNoMatch {
+			// Regular expressions of label matchers are anchored, they match the whole label value.
+			re, err := regexp.Compile("^(?:" + fmt.Sprint(label.Value.Value()) + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression for label %q: %v", label.Name, err)
+			}
+			value = &semantic.RegexpLiteral{
+				Value: re,
+			}
+		} else if label.Value.Type() == StringKind {
 			value = &semantic.StringLiteral{
 				Value: label.Value.Value().(string),
 			}
@@ -373,7 +385,7 @@ func (o *Operator) QuerySpec() (*query.Operation, error) {
 	case CountKind:
 		return &query.Operation{
 			ID:   "count",
-			Spec: &functions.CountOpSpec{},
+			Spec: &functions.CountOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
 		}, nil
 	//case TopKind:
 	//	return &query.Operation{
@@ -383,7 +395,7 @@ func (o *Operator) QuerySpec() (*query.Operation, error) {
 	case SumKind:
 		return &query.Operation{
 			ID:   "sum",
-			Spec: &functions.SumOpSpec{},
+			Spec: &functions.SumOpSpec{AggregateConfig: execute.DefaultAggregateConfig},
 		}, nil
 	//case MinKind:
 	//	return &query.Operation{
@@ -29,6 +29,9 @@ type Config struct {
 	MemoryBytesQuota int
 
 	Verbose bool
+
+	// SearchPath is the list of directories searched for imported IFQL packages.
+	SearchPath []string
 }
 
 // Use type aliases to expose simple API for entire project
@@ -46,6 +49,7 @@ func NewController(conf Config) (*Controller, error) {
 		MemoryBytesQuota:     int64(conf.MemoryBytesQuota),
 		ExecutorDependencies: conf.Dependencies,
 		Verbose:              conf.Verbose,
+		SearchPath:           conf.SearchPath,
 	}
 	return control.New(c), nil
 }
@@ -32,8 +32,16 @@ func Verbose(v bool) Option {
 	}
 }
 
+// SearchPath sets the directories searched for IFQL packages imported by the query.
+func SearchPath(dirs []string) Option {
+	return func(o *options) {
+		o.searchPath = dirs
+	}
+}
+
 type options struct {
-	verbose bool
+	verbose    bool
+	searchPath []string
 }
 
 // Compile evaluates an IFQL script producing a query Spec.
@@ -53,7 +61,11 @@ func Compile(ctx context.Context, q string, opts ...Option) (*Spec, error) {
 
 	qd := new(queryDomain)
 	scope, decls := builtIns(qd)
+	imp := newImporter(qd, scope, decls, o.searchPath)
 	interpScope := interpreter.NewScopeWithValues(scope)
+	if err := imp.Import(astProg.Imports, interpScope, decls); err != nil {
+		return nil, err
+	}
 
 	// Convert AST program to a semantic program
 	semProg, err := semantic.New(astProg, decls)
@@ -65,6 +77,9 @@ func Compile(ctx context.Context, q string, opts ...Option) (*Spec, error) {
 		return nil, err
 	}
 	spec := qd.ToSpec()
+	if err := spec.ApplyOptions(interpScope.Options()); err != nil {
+		return nil, err
+	}
 
 	if o.verbose {
 		log.Println("Query Spec: ", Formatted(spec, FmtJSON))
@@ -26,7 +26,8 @@ type Controller struct {
 	queryDone     chan *Query
 	cancelRequest chan QueryID
 
-	verbose bool
+	verbose    bool
+	searchPath []string
 
 	lplanner plan.LogicalPlanner
 	pplanner plan.Planner
@@ -42,6 +43,8 @@ type Config struct {
 	MemoryBytesQuota     int64
 	ExecutorDependencies execute.Dependencies
 	Verbose              bool
+	// SearchPath is the list of directories searched for imported IFQL packages.
+	SearchPath []string
 }
 
 type QueryID uint64
@@ -59,6 +62,7 @@ func New(c Config) *Controller {
 		pplanner:             plan.NewPlanner(),
 		executor:             execute.NewExecutor(c.ExecutorDependencies),
 		verbose:              c.Verbose,
+		searchPath:           c.SearchPath,
 	}
 	go ctrl.run()
 	return ctrl
@@ -69,12 +73,30 @@ func New(c Config) *Controller {
 // Done must be called on any returned Query objects.
 func (c *Controller) QueryWithCompile(ctx context.Context, orgID id.ID, queryStr string) (*Query, error) {
 	q := c.createQuery(ctx, orgID)
+	return q, c.compileAndEnqueue(q, queryStr)
+}
+
+// AnalyzeWithCompile submits a query for execution like QueryWithCompile,
+// collecting statistics about the execution of each procedure of its plan.
+// The plans and statistics are reported by the query once its results have been read.
+// Done must be called on any returned Query objects.
+func (c *Controller) AnalyzeWithCompile(ctx context.Context, orgID id.ID, queryStr string) (*Query, error) {
+	q := c.createQuery(ctx, orgID)
+	q.analyze = true
+	return q, c.compileAndEnqueue(q, queryStr)
+}
+
+func (c *Controller) compileAndEnqueue(q *Query, queryStr string) error {
 	err := c.compileQuery(q, queryStr)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	err = c.enqueueQuery(q)
-	return q, err
+	return c.enqueueQuery(q)
+}
+
+// SearchPath reports the directories searched for imported IFQL packages.
+func (c *Controller) SearchPath() []string {
+	return c.searchPath
 }
 
 // Query submits a query for execution returning immediately.
@@ -110,7 +132,7 @@ func (c *Controller) compileQuery(q *Query, queryStr string) error {
 	if !q.tryCompile() {
 		return errors.New("failed to transition query to compiling state")
 	}
-	spec, err := query.Compile(q.compilingCtx, queryStr, query.Verbose(c.verbose))
+	spec, err := query.Compile(q.compilingCtx, queryStr, query.Verbose(c.verbose), query.SearchPath(c.searchPath))
 	if err != nil {
 		return errors.Wrap(err, "failed to compile query")
 	}
@@ -128,6 +150,9 @@ func (c *Controller) enqueueQuery(q *Query) error {
 	if err := q.spec.Validate(); err != nil {
 		return errors.Wrap(err, "invalid query")
 	}
+	if !q.spec.Now.IsZero() {
+		q.now = q.spec.Now
+	}
 	// Add query to the queue
 	c.newQueries <- q
 	return nil
@@ -200,6 +225,10 @@ func (c *Controller) processQuery(pq *PriorityQueue, q *Query) error {
 		if c.verbose {
 			log.Println("logical plan", plan.Formatted(lp))
 		}
+		if q.analyze {
+			// Keep the logical plan, the physical planner modifies its procedures.
+			q.logicalPlan = lp.Copy()
+		}
 
 		p, err := c.pplanner.Plan(lp, nil, q.now)
 		if err != nil {
@@ -228,7 +257,15 @@ func (c *Controller) processQuery(pq *PriorityQueue, q *Query) error {
 		if !q.tryExec() {
 			return errors.New("failed to transition query into executing state")
 		}
-		r, err := c.executor.Execute(q.executeCtx, q.orgID, q.plan)
+		var (
+			r   map[string]execute.Result
+			err error
+		)
+		if q.analyze {
+			r, q.statistics, err = c.executor.Analyze(q.executeCtx, q.orgID, q.plan)
+		} else {
+			r, err = c.executor.Execute(q.executeCtx, q.orgID, q.plan)
+		}
 		if err != nil {
 			return errors.Wrap(err, "failed to execute query")
 		}
@@ -297,6 +334,11 @@ type Query struct {
 
 	plan *plan.PlanSpec
 
+	// analyze reports whether statistics are collected while the query is executed.
+	analyze     bool
+	logicalPlan *plan.LogicalPlanSpec
+	statistics  <-chan execute.Statistics
+
 	concurrency int
 	memory      int64
 }
@@ -314,6 +356,25 @@ func (q *Query) Spec() *query.Spec {
 	return &q.spec
 }
 
+// LogicalPlan reports the logical plan of an analyzed query, before it was modified by the physical planner.
+// It is set once the results of the query are ready.
+func (q *Query) LogicalPlan() *plan.LogicalPlanSpec {
+	return q.logicalPlan
+}
+
+// Plan reports the physical plan of the query.
+// It is set once the results of the query are ready.
+func (q *Query) Plan() *plan.PlanSpec {
+	return q.plan
+}
+
+// Statistics returns a channel that delivers the statistics of an analyzed query once its execution has finished.
+// The execution only finishes once all of its results have been read.
+// The channel is nil if the query was not analyzed or its results are not ready.
+func (q *Query) Statistics() <-chan execute.Statistics {
+	return q.statistics
+}
+
 // Cancel will stop the query execution.
 func (q *Query) Cancel() {
 	q.mu.Lock()
@@ -0,0 +1,403 @@
+package query
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/format"
+	"github.com/influxdata/ifql/semantic"
+)
+
+// Decompile returns IFQL source that compiles to an equivalent query spec.
+//
+// Every operation spec must implement CallArgumentsSpec.
+// An operation with a single child is piped into it,
+// an operation with several children or that is an argument of its child is assigned to a variable named after its ID.
+// Operations without children are expression statements.
+// The builtin packages whose functions are called are imported, and the time of the spec is the now option.
+// Functions that are called by the functions of arguments are assigned to variables and called by name.
+func Decompile(q *Spec) (string, error) {
+	d := &decompiler{
+		q:             q,
+		args:          make(map[OperationID][]Argument),
+		refs:          make(map[OperationID]map[OperationID]bool),
+		names:         make(map[OperationID]string),
+		used:          make(map[string]bool),
+		exprs:         make(map[OperationID]ast.Expression),
+		imports:       make(map[string]bool),
+		functionNames: make(map[string]string),
+	}
+	program, err := d.program()
+	if err != nil {
+		return "", err
+	}
+	return format.Node(program), nil
+}
+
+type decompiler struct {
+	q *Spec
+	// args are the call arguments of each operation.
+	args map[OperationID][]Argument
+	// refs are the operations that are arguments of each operation.
+	refs map[OperationID]map[OperationID]bool
+	// names are the variable names of the operations assigned to a variable.
+	names map[OperationID]string
+	// used are the names that may not be used for a variable.
+	used map[string]bool
+	// exprs are the expressions of the operations that are not assigned to a variable.
+	exprs map[OperationID]ast.Expression
+	// imports are the paths of the packages whose functions are called.
+	imports map[string]bool
+	// functions are the declarations of the called functions, which precede the next statement.
+	functions []ast.Statement
+	// functionNames are the variable names of the declared functions by their source.
+	functionNames map[string]string
+}
+
+func (d *decompiler) program() (*ast.Program, error) {
+	if err := d.q.Validate(); err != nil {
+		return nil, err
+	}
+	order := d.order()
+
+	// Find the arguments of every operation and the identifiers they use before naming any variable.
+	referenced := make(map[OperationID]bool)
+	for _, o := range order {
+		spec, ok := o.Spec.(CallArgumentsSpec)
+		if !ok {
+			return nil, fmt.Errorf("cannot decompile operation %q, operations of kind %q have no call arguments", o.ID, o.Spec.Kind())
+		}
+		d.used[string(o.Spec.Kind())] = true
+		args := spec.CallArguments()
+		d.args[o.ID] = args
+		refs := make(map[OperationID]bool)
+		for _, a := range args {
+			d.reserve(a.Value, refs)
+		}
+		d.refs[o.ID] = refs
+		for id := range refs {
+			referenced[id] = true
+		}
+	}
+	for _, o := range order {
+		if referenced[o.ID] || len(d.q.Children(o.ID)) > 1 {
+			d.names[o.ID] = d.name(o.ID)
+		}
+	}
+
+	program := new(ast.Program)
+	pkgpaths := make([]string, 0, len(d.imports))
+	for pkgpath := range d.imports {
+		pkgpaths = append(pkgpaths, pkgpath)
+	}
+	sort.Strings(pkgpaths)
+	for _, pkgpath := range pkgpaths {
+		program.Imports = append(program.Imports, &ast.ImportDeclaration{
+			Path: &ast.StringLiteral{Value: pkgpath},
+		})
+	}
+	if !d.q.Now.IsZero() {
+		program.Body = append(program.Body, &ast.OptionStatement{
+			Declaration: &ast.VariableDeclarator{
+				ID:   &ast.Identifier{Name: NowOption},
+				Init: &ast.DateTimeLiteral{Value: d.q.Now},
+			},
+		})
+	}
+	for _, o := range order {
+		if err := d.statement(program, o); err != nil {
+			return nil, err
+		}
+	}
+	return program, nil
+}
+
+// order returns the operations in the order of the spec, with every operation after its parents.
+func (d *decompiler) order() []*Operation {
+	var order []*Operation
+	visited := make(map[OperationID]bool)
+	var visit func(o *Operation)
+	visit = func(o *Operation) {
+		if visited[o.ID] {
+			return
+		}
+		visited[o.ID] = true
+		for _, p := range d.q.Parents(o.ID) {
+			visit(p)
+		}
+		order = append(order, o)
+	}
+	for _, o := range d.q.Operations {
+		visit(o)
+	}
+	return order
+}
+
+// statement adds the operation to the program, unless it is part of the expression of its child.
+func (d *decompiler) statement(program *ast.Program, o *Operation) error {
+	call := &ast.CallExpression{
+		Callee: &ast.Identifier{Name: string(o.Spec.Kind())},
+	}
+	if args := d.args[o.ID]; len(args) > 0 {
+		obj := &ast.ObjectExpression{
+			Properties: make([]*ast.Property, len(args)),
+		}
+		for i, a := range args {
+			v, err := d.value(a.Value)
+			if err != nil {
+				return fmt.Errorf("cannot decompile argument %q of operation %q: %v", a.Key, o.ID, err)
+			}
+			obj.Properties[i] = &ast.Property{
+				Key:   &ast.Identifier{Name: a.Key},
+				Value: v,
+			}
+		}
+		call.Arguments = []ast.Expression{obj}
+	}
+	program.Body = append(program.Body, d.functions...)
+	d.functions = nil
+
+	// Parents that are not arguments are piped into the call.
+	var piped []OperationID
+	for _, p := range d.q.Parents(o.ID) {
+		if !d.refs[o.ID][p.ID] {
+			piped = append(piped, p.ID)
+		}
+	}
+	var expr ast.Expression = call
+	switch len(piped) {
+	case 0:
+	case 1:
+		expr = &ast.PipeExpression{
+			Argument: d.table(piped[0]),
+			Call:     call,
+		}
+	default:
+		return fmt.Errorf("cannot decompile operation %q, only one of its %d parents can be piped", o.ID, len(piped))
+	}
+
+	if name, ok := d.names[o.ID]; ok {
+		program.Body = append(program.Body, &ast.VariableDeclaration{
+			Declarations: []*ast.VariableDeclarator{{
+				ID:   &ast.Identifier{Name: name},
+				Init: expr,
+			}},
+		})
+	} else if len(d.q.Children(o.ID)) == 0 {
+		program.Body = append(program.Body, &ast.ExpressionStatement{
+			Expression: expr,
+		})
+	} else {
+		d.exprs[o.ID] = expr
+	}
+	return nil
+}
+
+// reserve records the identifiers used by the value and the operations it references.
+func (d *decompiler) reserve(v interface{}, refs map[OperationID]bool) {
+	switch v := v.(type) {
+	case OperationID:
+		refs[v] = true
+	case map[string]interface{}:
+		for _, e := range v {
+			d.reserve(e, refs)
+		}
+	case *semantic.FunctionExpression:
+		ast.Walk(identifierVisitor(d.used), semantic.ToAST(v))
+		semantic.Walk(packageVisitor{d: d}, v)
+	}
+}
+
+// name returns an unused variable name for the operation.
+func (d *decompiler) name(id OperationID) string {
+	return d.unique(string(id))
+}
+
+// unique returns an unused variable name based on the name.
+func (d *decompiler) unique(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, name)
+	if !ast.IsIdentifier(name) {
+		name = "_" + name
+	}
+	unique := name
+	for i := 1; d.used[unique]; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	d.used[unique] = true
+	return unique
+}
+
+// table returns the expression of the table produced by the operation.
+func (d *decompiler) table(id OperationID) ast.Expression {
+	if name, ok := d.names[id]; ok {
+		return &ast.Identifier{Name: name}
+	}
+	return d.exprs[id]
+}
+
+func (d *decompiler) value(v interface{}) (ast.Expression, error) {
+	switch v := v.(type) {
+	case string:
+		return &ast.StringLiteral{Value: v}, nil
+	case bool:
+		return &ast.BooleanLiteral{Value: v}, nil
+	case int64:
+		return &ast.IntegerLiteral{Value: v}, nil
+	case float64:
+		return &ast.FloatLiteral{Value: v}, nil
+	case []string:
+		array := &ast.ArrayExpression{
+			Elements: make([]ast.Expression, len(v)),
+		}
+		for i, s := range v {
+			array.Elements[i] = &ast.StringLiteral{Value: s}
+		}
+		return array, nil
+	case Duration:
+		return durationExpression(time.Duration(v)), nil
+	case Time:
+		if v.IsRelative {
+			return durationExpression(v.Relative), nil
+		}
+		return &ast.DateTimeLiteral{Value: v.Absolute}, nil
+	case *semantic.FunctionExpression:
+		return d.function(v), nil
+	case OperationID:
+		name, ok := d.names[v]
+		if !ok {
+			return nil, fmt.Errorf("unknown operation %q", v)
+		}
+		return &ast.Identifier{Name: name}, nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		obj := &ast.ObjectExpression{
+			Properties: make([]*ast.Property, len(keys)),
+		}
+		for i, k := range keys {
+			e, err := d.value(v[k])
+			if err != nil {
+				return nil, err
+			}
+			obj.Properties[i] = &ast.Property{
+				Key:   &ast.Identifier{Name: k},
+				Value: e,
+			}
+		}
+		return obj, nil
+	default:
+		return nil, fmt.Errorf("unsupported value of type %T", v)
+	}
+}
+
+// function returns the expression of the function.
+// The functions of packages are called by their qualified names, which are relative to the imported package names.
+// The functions it calls are declared as variables, since a function expression cannot be called.
+func (d *decompiler) function(fn *semantic.FunctionExpression) ast.Expression {
+	fn = fn.Copy().(*semantic.FunctionExpression)
+	semantic.Walk(packageVisitor{d: d, rename: true}, fn)
+	semantic.Walk(callVisitor{d: d}, fn)
+	return semantic.ToAST(fn).(ast.Expression)
+}
+
+// durationExpression returns a duration literal, negative durations are negated positive literals.
+func durationExpression(d time.Duration) ast.Expression {
+	if d < 0 {
+		return &ast.UnaryExpression{
+			Operator: ast.SubtractionOperator,
+			Argument: &ast.DurationLiteral{Value: -d},
+		}
+	}
+	return &ast.DurationLiteral{Value: d}
+}
+
+// identifierVisitor records the names of all identifiers it visits.
+type identifierVisitor map[string]bool
+
+func (v identifierVisitor) Visit(n ast.Node) ast.Visitor {
+	if id, ok := n.(*ast.Identifier); ok {
+		v[id.Name] = true
+	}
+	return v
+}
+
+func (v identifierVisitor) Done() {}
+
+// packageVisitor finds the qualified names of the functions of builtin packages,
+// which are the import path and name of the function joined by a dot, e.g. "strings.sprintf".
+// It records the packages to import and renames the functions relative to the package name if rename is set.
+type packageVisitor struct {
+	d      *decompiler
+	rename bool
+}
+
+func (v packageVisitor) Visit(n semantic.Node) semantic.Visitor {
+	id, ok := n.(*semantic.IdentifierExpression)
+	if !ok {
+		return v
+	}
+	i := strings.LastIndex(id.Name, ".")
+	if i < 0 {
+		return v
+	}
+	pkgpath := id.Name[:i]
+	if _, ok := builtinPackages[pkgpath]; !ok {
+		return v
+	}
+	if v.rename {
+		id.Name = path.Base(pkgpath) + id.Name[i:]
+	} else {
+		v.d.imports[pkgpath] = true
+		v.d.used[path.Base(pkgpath)] = true
+	}
+	return v
+}
+
+func (v packageVisitor) Done() {}
+
+// callVisitor declares the functions that are called as variables and calls them by name.
+type callVisitor struct {
+	d *decompiler
+}
+
+func (v callVisitor) Visit(n semantic.Node) semantic.Visitor {
+	call, ok := n.(*semantic.CallExpression)
+	if !ok {
+		return v
+	}
+	fn, ok := call.Callee.(*semantic.FunctionExpression)
+	if !ok {
+		return v
+	}
+	init := v.d.function(fn)
+	src := format.Node(init)
+	name, ok := v.d.functionNames[src]
+	if !ok {
+		name = v.d.unique("f")
+		v.d.functionNames[src] = name
+		v.d.functions = append(v.d.functions, &ast.VariableDeclaration{
+			Declarations: []*ast.VariableDeclarator{{
+				ID:   &ast.Identifier{Name: name},
+				Init: init,
+			}},
+		})
+	}
+	call.Callee = &semantic.IdentifierExpression{Name: name}
+	return v
+}
+
+func (v callVisitor) Done() {}
@@ -0,0 +1,217 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/promql"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic/semantictest"
+)
+
+func TestDecompile(t *testing.T) {
+	testCases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "pipe chain",
+			src:  `from(db:"telegraf") |> range(start:-1h) |> filter(fn: (r) => r._measurement == "cpu" and r["my-tag"] =~ /a.*/) |> sum()`,
+			want: `from(db: "telegraf")
+    |> range(start: -1h)
+    |> filter(fn: (r) => r._measurement == "cpu" and r["my-tag"] =~ /a.*/)
+    |> sum()
+`,
+		},
+		{
+			name: "arguments",
+			src: `from(bucket:"b", hosts:["a:8082"])
+    |> range(start:2018-05-22T19:53:26Z, stop:2018-05-22T19:54:26Z)
+    |> window(every:1m, period:5m)
+    |> derivative(unit:1m, nonNegative:true)
+    |> percentile(p:0.99, exact:true, columns:["x"])
+    |> limit(n:10)
+    |> yield(name:"x")`,
+			want: `from(bucket: "b", hosts: ["a:8082"])
+    |> range(start: 2018-05-22T19:53:26Z, stop: 2018-05-22T19:54:26Z)
+    |> window(every: 1m, period: 5m)
+    |> derivative(unit: 1m, nonNegative: true)
+    |> percentile(p: 0.99, exact: true, columns: ["x"])
+    |> limit(n: 10)
+    |> yield(name: "x")
+`,
+		},
+		{
+			name: "defaults",
+			src: `from(db:"telegraf")
+    |> range(start:-1h, stop:0s)
+    |> window(every:1m, period:1m)
+    |> sort(cols:["_value"], desc:false)
+    |> sum(columns:["_value"], timeSrc:"_stop")`,
+			want: `from(db: "telegraf")
+    |> range(start: -1h)
+    |> window(every: 1m)
+    |> sort()
+    |> sum()
+`,
+		},
+		{
+			name: "join",
+			src: `a = from(db:"a") |> range(start:-1h)
+b = from(db:"b") |> range(start:-1h)
+join(tables:{a:a, b:b}, on:["host"], fn:(t) => ({_value: t.a._value + t.b._value}))`,
+			want: `range1 = from(db: "a")
+    |> range(start: -1h)
+range3 = from(db: "b")
+    |> range(start: -1h)
+join(tables: {a: range1, b: range3}, on: ["host"], fn: (t) => ({_value: t.a._value + t.b._value}))
+`,
+		},
+		{
+			name: "several children",
+			src: `data = from(db:"telegraf")
+data |> count()
+data |> map(fn: (r) => r._value * 2.0)`,
+			want: `from0 = from(db: "telegraf")
+from0
+    |> count()
+from0
+    |> map(fn: (r) => r._value * 2.0)
+`,
+		},
+		{
+			name: "package functions",
+			src: `import "math"
+import "strings"
+from(db:"telegraf") |> map(fn: (r) => ({_value: math.abs(x: r._value), host: strings.toUpper(v: r.host)}))`,
+			want: `import "math"
+import "strings"
+
+from(db: "telegraf")
+    |> map(fn: (r) => ({_value: math.abs(x: r._value), host: strings.toUpper(v: r.host)}))
+`,
+		},
+		{
+			name: "user function",
+			src: `double = (v) => v * 2.0
+quadruple = (v) => double(v: double(v: v))
+from(db:"telegraf") |> map(fn: (r) => quadruple(v: r._value))`,
+			want: `f = (v) => v * 2.0
+f_1 = (v) => f(v: f(v: v))
+from(db: "telegraf")
+    |> map(fn: (r) => f_1(v: r._value))
+`,
+		},
+		{
+			name: "now option",
+			src: `option now = 2018-05-22T19:53:26Z
+from(db:"telegraf") |> range(start:-1h)`,
+			want: `option now = 2018-05-22T19:53:26Z
+from(db: "telegraf")
+    |> range(start: -1h)
+`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			spec, err := query.Compile(context.Background(), tc.src)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := query.Decompile(spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("unexpected source -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+
+			// The decompiled source compiles to the same spec
+			again, err := query.Compile(context.Background(), got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			opts := append(semantictest.CmpOptions, cmp.AllowUnexported(query.Spec{}), cmpopts.IgnoreUnexported(query.Spec{}))
+			if !cmp.Equal(spec, again, opts...) {
+				t.Errorf("unexpected spec -want/+got:\n%s", cmp.Diff(spec, again, opts...))
+			}
+		})
+	}
+}
+
+func TestDecompile_Spec(t *testing.T) {
+	promqlSpec, err := promql.Build(`sum(node_cpu{mode="user"}[5m]) by (cpu)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCases := []struct {
+		name    string
+		spec    *query.Spec
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "promql",
+			spec: promqlSpec,
+			want: `from(db: "prometheus")
+    |> range(start: -5m)
+    |> filter(fn: (r) => r._metric == "node_cpu" and r.mode == "user")
+    |> group(by: ["cpu"])
+    |> sum()
+`,
+		},
+		{
+			name: "names",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "from", Spec: &functions.FromOpSpec{Database: "a"}},
+					{ID: "my data", Spec: &functions.FromOpSpec{Database: "b"}},
+					{ID: "join", Spec: &functions.JoinOpSpec{
+						TableNames: map[query.OperationID]string{"from": "a", "my data": "b"},
+						Fn:         promqlSpec.Operations[2].Spec.(*functions.FilterOpSpec).Fn,
+					}},
+				},
+				Edges: []query.Edge{
+					{Parent: "from", Child: "join"},
+					{Parent: "my data", Child: "join"},
+				},
+			},
+			want: `from_1 = from(db: "a")
+my_data = from(db: "b")
+join(tables: {a: from_1, b: my_data}, fn: (r) => r._metric == "node_cpu" and r.mode == "user")
+`,
+		},
+		{
+			name: "several piped parents",
+			spec: &query.Spec{
+				Operations: []*query.Operation{
+					{ID: "a", Spec: &functions.FromOpSpec{Database: "a"}},
+					{ID: "b", Spec: &functions.FromOpSpec{Database: "b"}},
+					{ID: "sum", Spec: &functions.SumOpSpec{}},
+				},
+				Edges: []query.Edge{
+					{Parent: "a", Child: "sum"},
+					{Parent: "b", Child: "sum"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := query.Decompile(tc.spec)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("unexpected source -want/+got:\n%s", cmp.Diff(tc.want, got))
+			}
+		})
+	}
+}
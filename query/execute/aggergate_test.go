@@ -59,6 +59,38 @@ func TestAggregate_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name:   "single with nulls",
+			config: execute.DefaultAggregateConfig,
+			agg:    countAgg,
+			data: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(0), nil},
+					{execute.Time(0), execute.Time(100), execute.Time(10), 1.0},
+					{execute.Time(0), execute.Time(100), execute.Time(20), nil},
+					{execute.Time(0), execute.Time(100), execute.Time(30), 3.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TInt},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(100), int64(2)},
+				},
+			}},
+		},
 		{
 			name: "single use start time",
 			config: execute.AggregateConfig{
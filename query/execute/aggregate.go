@@ -69,6 +69,33 @@ func (c *AggregateConfig) ReadArgs(args query.Arguments) error {
 	return nil
 }
 
+// CallArguments returns the arguments that differ from the default config.
+func (c AggregateConfig) CallArguments() []query.Argument {
+	var args []query.Argument
+	if !stringsEqual(c.Columns, DefaultAggregateConfig.Columns) {
+		args = append(args, query.Argument{Key: "columns", Value: c.Columns})
+	}
+	if c.TimeSrc != DefaultAggregateConfig.TimeSrc {
+		args = append(args, query.Argument{Key: "timeSrc", Value: c.TimeSrc})
+	}
+	if c.TimeDst != DefaultAggregateConfig.TimeDst {
+		args = append(args, query.Argument{Key: "timeDst", Value: c.TimeDst})
+	}
+	return args
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 func NewAggregateTransformation(d Dataset, c BlockBuilderCache, agg Aggregate, config AggregateConfig) *aggregateTransformation {
 	return &aggregateTransformation{
 		d:      d,
@@ -154,17 +181,19 @@ func (t *aggregateTransformation) Process(id DatasetID, b Block) error {
 			tj := blockColMap[j]
 			c := b.Cols()[tj]
 
+			// Null values are not aggregated.
+			vr := SkipNulls(cr, tj)
 			switch c.Type {
 			case TBool:
-				vf.(DoBoolAgg).DoBool(cr.Bools(tj))
+				vf.(DoBoolAgg).DoBool(vr.Bools(tj))
 			case TInt:
-				vf.(DoIntAgg).DoInt(cr.Ints(tj))
+				vf.(DoIntAgg).DoInt(vr.Ints(tj))
 			case TUInt:
-				vf.(DoUIntAgg).DoUInt(cr.UInts(tj))
+				vf.(DoUIntAgg).DoUInt(vr.UInts(tj))
 			case TFloat:
-				vf.(DoFloatAgg).DoFloat(cr.Floats(tj))
+				vf.(DoFloatAgg).DoFloat(vr.Floats(tj))
 			case TString:
-				vf.(DoStringAgg).DoString(cr.Strings(tj))
+				vf.(DoStringAgg).DoString(vr.Strings(tj))
 			default:
 				return fmt.Errorf("unsupport aggregate type %v", c.Type)
 			}
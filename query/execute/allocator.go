@@ -21,6 +21,19 @@ type Allocator struct {
 	Limit          int64
 	bytesAllocated int64
 	maxAllocated   int64
+	totalAllocated int64
+
+	// parent is the allocator of the query when the allocator tracks the memory of a single procedure.
+	// All memory is also accounted for by the parent, whose limit applies instead.
+	parent *Allocator
+}
+
+// newChildAllocator returns an allocator whose memory is also accounted for by the parent.
+func newChildAllocator(parent *Allocator) *Allocator {
+	return &Allocator{
+		Limit:  parent.Limit,
+		parent: parent,
+	}
 }
 
 func (a *Allocator) count(n, size int) (c int64) {
@@ -36,6 +49,9 @@ func (a *Allocator) count(n, size int) (c int64) {
 // Free informs the allocator that memory has been freed.
 func (a *Allocator) Free(n, size int) {
 	a.count(-n, size)
+	if a.parent != nil {
+		a.parent.Free(n, size)
+	}
 }
 
 // Max reports the maximum amount of allocated memory at any point in the query.
@@ -43,8 +59,17 @@ func (a *Allocator) Max() int64 {
 	return atomic.LoadInt64(&a.maxAllocated)
 }
 
+// Total reports the total amount of memory allocated during the query, including memory that has been freed.
+func (a *Allocator) Total() int64 {
+	return atomic.LoadInt64(&a.totalAllocated)
+}
+
 func (a *Allocator) account(n, size int) {
-	if want := a.count(n, size); want > a.Limit {
+	if a.parent != nil {
+		// The parent panics if its limit is reached.
+		a.parent.account(n, size)
+		a.count(n, size)
+	} else if want := a.count(n, size); want > a.Limit {
 		allocated := a.count(-n, size)
 		panic(AllocError{
 			Limit:     a.Limit,
@@ -52,6 +77,7 @@ func (a *Allocator) account(n, size int) {
 			Wanted:    want - allocated,
 		})
 	}
+	atomic.AddInt64(&a.totalAllocated, int64(n*size))
 }
 
 // Bools makes a slice of bool values.
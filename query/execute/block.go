@@ -146,6 +146,12 @@ func partitionKeyEqual(a, b PartitionKey) bool {
 		if aCols[j] != bCols[j] {
 			return false
 		}
+		if an, bn := a.Value(j) == nil, b.Value(j) == nil; an || bn {
+			if an != bn {
+				return false
+			}
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			if a.ValueBool(j) != b.ValueBool(j) {
@@ -186,6 +192,13 @@ func partitionKeyLess(a, b PartitionKey) bool {
 		if aCols[j] != bCols[j] {
 			return aCols[j].Label < bCols[j].Label
 		}
+		// Null values sort before all other values.
+		if an, bn := a.Value(j) == nil, b.Value(j) == nil; an || bn {
+			if an != bn {
+				return an
+			}
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			if av, bv := a.ValueBool(j), b.ValueBool(j); av != bv {
@@ -239,6 +252,10 @@ func PartitionKeyForRow(i int, cr ColReader) PartitionKey {
 			continue
 		}
 		colsCpy = append(colsCpy, c)
+		if IsNull(cr, i, j) {
+			values = append(values, nil)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			values = append(values, cr.Bools(j)[i])
@@ -268,6 +285,10 @@ func PartitionKeyForRowOn(i int, cr ColReader, on map[string]bool) PartitionKey
 			continue
 		}
 		cols = append(cols, c)
+		if IsNull(cr, i, j) {
+			values = append(values, nil)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			values = append(values, cr.Bools(j)[i])
@@ -413,11 +434,49 @@ func AppendCol(bj, cj int, cr ColReader, builder BlockBuilder) {
 	default:
 		PanicUnknownType(c.Type)
 	}
+	if valid := cr.Valid(cj); valid != nil {
+		start := builder.NRows() - len(valid)
+		for i, ok := range valid {
+			if !ok {
+				builder.SetNil(start+i, bj)
+			}
+		}
+	}
+}
+
+// AppendValueAt appends the value of row i from cr onto builder.
+// The indexes bj and cj are builder and col reader indexes respectively.
+func AppendValueAt(bj, cj, i int, cr ColReader, builder BlockBuilder) {
+	if IsNull(cr, i, cj) {
+		builder.AppendNil(bj)
+		return
+	}
+	c := cr.Cols()[cj]
+	switch c.Type {
+	case TBool:
+		builder.AppendBool(bj, cr.Bools(cj)[i])
+	case TInt:
+		builder.AppendInt(bj, cr.Ints(cj)[i])
+	case TUInt:
+		builder.AppendUInt(bj, cr.UInts(cj)[i])
+	case TFloat:
+		builder.AppendFloat(bj, cr.Floats(cj)[i])
+	case TString:
+		builder.AppendString(bj, cr.Strings(cj)[i])
+	case TTime:
+		builder.AppendTime(bj, cr.Times(cj)[i])
+	default:
+		PanicUnknownType(c.Type)
+	}
 }
 
 // AppendMappedRecord appends the record from cr onto builder assuming matching columns.
 func AppendRecord(i int, cr ColReader, builder BlockBuilder) {
 	for j, c := range builder.Cols() {
+		if IsNull(cr, i, j) {
+			builder.AppendNil(j)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			builder.AppendBool(j, cr.Bools(j)[i])
@@ -440,6 +499,10 @@ func AppendRecord(i int, cr ColReader, builder BlockBuilder) {
 // AppendMappedRecord appends the records from cr onto builder, using colMap as a map of builder index to cr index.
 func AppendMappedRecord(i int, cr ColReader, builder BlockBuilder, colMap []int) {
 	for j, c := range builder.Cols() {
+		if IsNull(cr, i, colMap[j]) {
+			builder.AppendNil(j)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			builder.AppendBool(j, cr.Bools(colMap[j])[i])
@@ -462,6 +525,10 @@ func AppendMappedRecord(i int, cr ColReader, builder BlockBuilder, colMap []int)
 // AppendRecordForCols appends the only the columns provided from cr onto builder.
 func AppendRecordForCols(i int, cr ColReader, builder BlockBuilder, cols []ColMeta) {
 	for j, c := range cols {
+		if IsNull(cr, i, j) {
+			builder.AppendNil(j)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			builder.AppendBool(j, cr.Bools(j)[i])
@@ -484,6 +551,10 @@ func AppendRecordForCols(i int, cr ColReader, builder BlockBuilder, cols []ColMe
 func AppendKeyValues(key PartitionKey, builder BlockBuilder) {
 	for j, c := range key.Cols() {
 		idx := ColIdx(c.Label, builder.Cols())
+		if key.Value(j) == nil {
+			builder.AppendNil(idx)
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			builder.AppendBool(idx, key.ValueBool(j))
@@ -559,6 +630,11 @@ type BlockBuilder interface {
 	AppendStrings(j int, values []string)
 	AppendTimes(j int, values []Time)
 
+	// SetNil marks the value at the specified coordinates as null.
+	SetNil(i, j int)
+	// AppendNil appends a null value to the column.
+	AppendNil(j int)
+
 	// Sort the rows of the by the values of the columns in the order listed.
 	Sort(cols []string, desc bool)
 
@@ -628,6 +704,103 @@ type ColReader interface {
 	Floats(j int) []float64
 	Strings(j int) []string
 	Times(j int) []Time
+	// Valid reports which values of column j are valid, i.e. are not null.
+	// A nil slice means that all values of the column are valid.
+	Valid(j int) []bool
+}
+
+// IsNull reports whether the value of column j in row i is null.
+func IsNull(cr ColReader, i, j int) bool {
+	valid := cr.Valid(j)
+	return valid != nil && !valid[i]
+}
+
+// SkipNulls returns a ColReader of the rows of cr whose value in column j is not null.
+// If the column has no null values cr is returned directly.
+func SkipNulls(cr ColReader, j int) ColReader {
+	valid := cr.Valid(j)
+	if valid == nil {
+		return cr
+	}
+	rows := make([]int, 0, len(valid))
+	for i, ok := range valid {
+		if ok {
+			rows = append(rows, i)
+		}
+	}
+	if len(rows) == len(valid) {
+		return cr
+	}
+	return rowsColReader{ColReader: cr, rows: rows}
+}
+
+// rowsColReader is a ColReader of a subset of the rows of another ColReader.
+type rowsColReader struct {
+	ColReader
+	rows []int
+}
+
+func (cr rowsColReader) Len() int {
+	return len(cr.rows)
+}
+func (cr rowsColReader) Bools(j int) []bool {
+	vs := cr.ColReader.Bools(j)
+	sub := make([]bool, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
+}
+func (cr rowsColReader) Ints(j int) []int64 {
+	vs := cr.ColReader.Ints(j)
+	sub := make([]int64, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
+}
+func (cr rowsColReader) UInts(j int) []uint64 {
+	vs := cr.ColReader.UInts(j)
+	sub := make([]uint64, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
+}
+func (cr rowsColReader) Floats(j int) []float64 {
+	vs := cr.ColReader.Floats(j)
+	sub := make([]float64, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
+}
+func (cr rowsColReader) Strings(j int) []string {
+	vs := cr.ColReader.Strings(j)
+	sub := make([]string, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
+}
+func (cr rowsColReader) Times(j int) []Time {
+	vs := cr.ColReader.Times(j)
+	sub := make([]Time, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
+}
+func (cr rowsColReader) Valid(j int) []bool {
+	vs := cr.ColReader.Valid(j)
+	if vs == nil {
+		return nil
+	}
+	sub := make([]bool, len(cr.rows))
+	for k, i := range cr.rows {
+		sub[k] = vs[i]
+	}
+	return sub
 }
 
 type ColListBlockBuilder struct {
@@ -699,107 +872,155 @@ func (b ColListBlockBuilder) AddCol(c ColMeta) int {
 
 func (b ColListBlockBuilder) SetBool(i int, j int, value bool) {
 	b.checkColType(j, TBool)
-	b.blk.cols[j].(*boolColumn).data[i] = value
+	col := b.blk.cols[j].(*boolColumn)
+	col.data[i] = value
+	col.SetValid(i, true)
 }
 func (b ColListBlockBuilder) AppendBool(j int, value bool) {
 	b.checkColType(j, TBool)
 	col := b.blk.cols[j].(*boolColumn)
 	col.data = b.alloc.AppendBools(col.data, value)
+	col.grow(1)
 	b.blk.nrows = len(col.data)
 }
 func (b ColListBlockBuilder) AppendBools(j int, values []bool) {
 	b.checkColType(j, TBool)
 	col := b.blk.cols[j].(*boolColumn)
 	col.data = b.alloc.AppendBools(col.data, values...)
+	col.grow(len(values))
 	b.blk.nrows = len(col.data)
 }
 
 func (b ColListBlockBuilder) SetInt(i int, j int, value int64) {
 	b.checkColType(j, TInt)
-	b.blk.cols[j].(*intColumn).data[i] = value
+	col := b.blk.cols[j].(*intColumn)
+	col.data[i] = value
+	col.SetValid(i, true)
 }
 func (b ColListBlockBuilder) AppendInt(j int, value int64) {
 	b.checkColType(j, TInt)
 	col := b.blk.cols[j].(*intColumn)
 	col.data = b.alloc.AppendInts(col.data, value)
+	col.grow(1)
 	b.blk.nrows = len(col.data)
 }
 func (b ColListBlockBuilder) AppendInts(j int, values []int64) {
 	b.checkColType(j, TInt)
 	col := b.blk.cols[j].(*intColumn)
 	col.data = b.alloc.AppendInts(col.data, values...)
+	col.grow(len(values))
 	b.blk.nrows = len(col.data)
 }
 
 func (b ColListBlockBuilder) SetUInt(i int, j int, value uint64) {
 	b.checkColType(j, TUInt)
-	b.blk.cols[j].(*uintColumn).data[i] = value
+	col := b.blk.cols[j].(*uintColumn)
+	col.data[i] = value
+	col.SetValid(i, true)
 }
 func (b ColListBlockBuilder) AppendUInt(j int, value uint64) {
 	b.checkColType(j, TUInt)
 	col := b.blk.cols[j].(*uintColumn)
 	col.data = b.alloc.AppendUInts(col.data, value)
+	col.grow(1)
 	b.blk.nrows = len(col.data)
 }
 func (b ColListBlockBuilder) AppendUInts(j int, values []uint64) {
 	b.checkColType(j, TUInt)
 	col := b.blk.cols[j].(*uintColumn)
 	col.data = b.alloc.AppendUInts(col.data, values...)
+	col.grow(len(values))
 	b.blk.nrows = len(col.data)
 }
 
 func (b ColListBlockBuilder) SetFloat(i int, j int, value float64) {
 	b.checkColType(j, TFloat)
-	b.blk.cols[j].(*floatColumn).data[i] = value
+	col := b.blk.cols[j].(*floatColumn)
+	col.data[i] = value
+	col.SetValid(i, true)
 }
 func (b ColListBlockBuilder) AppendFloat(j int, value float64) {
 	b.checkColType(j, TFloat)
 	col := b.blk.cols[j].(*floatColumn)
 	col.data = b.alloc.AppendFloats(col.data, value)
+	col.grow(1)
 	b.blk.nrows = len(col.data)
 }
 func (b ColListBlockBuilder) AppendFloats(j int, values []float64) {
 	b.checkColType(j, TFloat)
 	col := b.blk.cols[j].(*floatColumn)
 	col.data = b.alloc.AppendFloats(col.data, values...)
+	col.grow(len(values))
 	b.blk.nrows = len(col.data)
 }
 
 func (b ColListBlockBuilder) SetString(i int, j int, value string) {
 	b.checkColType(j, TString)
-	b.blk.cols[j].(*stringColumn).data[i] = value
+	col := b.blk.cols[j].(*stringColumn)
+	col.data[i] = value
+	col.SetValid(i, true)
 }
 func (b ColListBlockBuilder) AppendString(j int, value string) {
 	meta := b.blk.cols[j].Meta()
 	CheckColType(meta, TString)
 	col := b.blk.cols[j].(*stringColumn)
 	col.data = b.alloc.AppendStrings(col.data, value)
+	col.grow(1)
 	b.blk.nrows = len(col.data)
 }
 func (b ColListBlockBuilder) AppendStrings(j int, values []string) {
 	b.checkColType(j, TString)
 	col := b.blk.cols[j].(*stringColumn)
 	col.data = b.alloc.AppendStrings(col.data, values...)
+	col.grow(len(values))
 	b.blk.nrows = len(col.data)
 }
 
 func (b ColListBlockBuilder) SetTime(i int, j int, value Time) {
 	b.checkColType(j, TTime)
-	b.blk.cols[j].(*timeColumn).data[i] = value
+	col := b.blk.cols[j].(*timeColumn)
+	col.data[i] = value
+	col.SetValid(i, true)
 }
 func (b ColListBlockBuilder) AppendTime(j int, value Time) {
 	b.checkColType(j, TTime)
 	col := b.blk.cols[j].(*timeColumn)
 	col.data = b.alloc.AppendTimes(col.data, value)
+	col.grow(1)
 	b.blk.nrows = len(col.data)
 }
 func (b ColListBlockBuilder) AppendTimes(j int, values []Time) {
 	b.checkColType(j, TTime)
 	col := b.blk.cols[j].(*timeColumn)
 	col.data = b.alloc.AppendTimes(col.data, values...)
+	col.grow(len(values))
 	b.blk.nrows = len(col.data)
 }
 
+func (b ColListBlockBuilder) SetNil(i, j int) {
+	b.blk.cols[j].SetValid(i, false)
+}
+func (b ColListBlockBuilder) AppendNil(j int) {
+	c := b.blk.colMeta[j]
+	switch c.Type {
+	case TBool:
+		b.AppendBool(j, false)
+	case TInt:
+		b.AppendInt(j, 0)
+	case TUInt:
+		b.AppendUInt(j, 0)
+	case TFloat:
+		b.AppendFloat(j, 0)
+	case TString:
+		b.AppendString(j, "")
+	case TTime:
+		b.AppendTime(j, 0)
+	default:
+		PanicUnknownType(c.Type)
+	}
+	b.SetNil(b.blk.nrows-1, j)
+}
+
 func (b ColListBlockBuilder) checkColType(j int, typ DataType) {
 	CheckColType(b.blk.colMeta[j], typ)
 }
@@ -912,6 +1133,10 @@ func (b *ColListBlock) Times(j int) []Time {
 	return b.cols[j].(*timeColumn).data
 }
 
+func (b *ColListBlock) Valid(j int) []bool {
+	return b.cols[j].Valid()
+}
+
 func (b *ColListBlock) Copy() *ColListBlock {
 	cpy := new(ColListBlock)
 	cpy.key = b.key
@@ -964,10 +1189,79 @@ type column interface {
 	Equal(i, j int) bool
 	Less(i, j int) bool
 	Swap(i, j int)
+	Valid() []bool
+	SetValid(i int, valid bool)
+}
+
+// validity tracks which values of a column are null.
+// The valid slice is only allocated once the first null value is set,
+// until then all values of the column are valid.
+type validity struct {
+	valid []bool
+}
+
+func (v *validity) Valid() []bool {
+	return v.valid
+}
+
+// set marks the value at index i as valid or null, n is the number of values in the column.
+func (v *validity) set(i, n int, valid bool) {
+	if v.valid == nil {
+		if valid {
+			return
+		}
+		v.valid = make([]bool, n)
+		for k := range v.valid {
+			v.valid[k] = true
+		}
+	}
+	v.valid[i] = valid
+}
+
+// grow marks n appended values as valid.
+func (v *validity) grow(n int) {
+	if v.valid == nil {
+		return
+	}
+	for k := 0; k < n; k++ {
+		v.valid = append(v.valid, true)
+	}
+}
+
+func (v *validity) isNull(i int) bool {
+	return v.valid != nil && !v.valid[i]
+}
+
+func (v *validity) copy() validity {
+	if v.valid == nil {
+		return validity{}
+	}
+	cpy := make([]bool, len(v.valid))
+	copy(cpy, v.valid)
+	return validity{valid: cpy}
+}
+
+func (v *validity) clear() {
+	v.valid = nil
+}
+
+func (v *validity) swap(i, j int) {
+	if v.valid != nil {
+		v.valid[i], v.valid[j] = v.valid[j], v.valid[i]
+	}
+}
+
+// compare compares the null state of the values at i and j.
+// It reports whether either value is null, and if so whether they are equal and whether i is less than j.
+// Null values are equal to each other and sort before all other values.
+func (v *validity) compare(i, j int) (null, equal, less bool) {
+	in, jn := v.isNull(i), v.isNull(j)
+	return in || jn, in == jn, in && !jn
 }
 
 type boolColumn struct {
 	ColMeta
+	validity
 	data  []bool
 	alloc *Allocator
 }
@@ -979,11 +1273,13 @@ func (c *boolColumn) Meta() ColMeta {
 func (c *boolColumn) Clear() {
 	c.alloc.Free(len(c.data), boolSize)
 	c.data = c.data[0:0]
+	c.clear()
 }
 func (c *boolColumn) Copy() column {
 	cpy := &boolColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:  c.ColMeta,
+		validity: c.validity.copy(),
+		alloc:    c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Bools(l, l)
@@ -991,9 +1287,15 @@ func (c *boolColumn) Copy() column {
 	return cpy
 }
 func (c *boolColumn) Equal(i, j int) bool {
+	if null, equal, _ := c.compare(i, j); null {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *boolColumn) Less(i, j int) bool {
+	if null, _, less := c.compare(i, j); null {
+		return less
+	}
 	if c.data[i] == c.data[j] {
 		return false
 	}
@@ -1001,10 +1303,15 @@ func (c *boolColumn) Less(i, j int) bool {
 }
 func (c *boolColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swap(i, j)
+}
+func (c *boolColumn) SetValid(i int, valid bool) {
+	c.set(i, len(c.data), valid)
 }
 
 type intColumn struct {
 	ColMeta
+	validity
 	data  []int64
 	alloc *Allocator
 }
@@ -1016,11 +1323,13 @@ func (c *intColumn) Meta() ColMeta {
 func (c *intColumn) Clear() {
 	c.alloc.Free(len(c.data), int64Size)
 	c.data = c.data[0:0]
+	c.clear()
 }
 func (c *intColumn) Copy() column {
 	cpy := &intColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:  c.ColMeta,
+		validity: c.validity.copy(),
+		alloc:    c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Ints(l, l)
@@ -1028,17 +1337,28 @@ func (c *intColumn) Copy() column {
 	return cpy
 }
 func (c *intColumn) Equal(i, j int) bool {
+	if null, equal, _ := c.compare(i, j); null {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *intColumn) Less(i, j int) bool {
+	if null, _, less := c.compare(i, j); null {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *intColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swap(i, j)
+}
+func (c *intColumn) SetValid(i int, valid bool) {
+	c.set(i, len(c.data), valid)
 }
 
 type uintColumn struct {
 	ColMeta
+	validity
 	data  []uint64
 	alloc *Allocator
 }
@@ -1050,11 +1370,13 @@ func (c *uintColumn) Meta() ColMeta {
 func (c *uintColumn) Clear() {
 	c.alloc.Free(len(c.data), uint64Size)
 	c.data = c.data[0:0]
+	c.clear()
 }
 func (c *uintColumn) Copy() column {
 	cpy := &uintColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:  c.ColMeta,
+		validity: c.validity.copy(),
+		alloc:    c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.UInts(l, l)
@@ -1062,17 +1384,28 @@ func (c *uintColumn) Copy() column {
 	return cpy
 }
 func (c *uintColumn) Equal(i, j int) bool {
+	if null, equal, _ := c.compare(i, j); null {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *uintColumn) Less(i, j int) bool {
+	if null, _, less := c.compare(i, j); null {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *uintColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swap(i, j)
+}
+func (c *uintColumn) SetValid(i int, valid bool) {
+	c.set(i, len(c.data), valid)
 }
 
 type floatColumn struct {
 	ColMeta
+	validity
 	data  []float64
 	alloc *Allocator
 }
@@ -1084,11 +1417,13 @@ func (c *floatColumn) Meta() ColMeta {
 func (c *floatColumn) Clear() {
 	c.alloc.Free(len(c.data), float64Size)
 	c.data = c.data[0:0]
+	c.clear()
 }
 func (c *floatColumn) Copy() column {
 	cpy := &floatColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:  c.ColMeta,
+		validity: c.validity.copy(),
+		alloc:    c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Floats(l, l)
@@ -1096,17 +1431,28 @@ func (c *floatColumn) Copy() column {
 	return cpy
 }
 func (c *floatColumn) Equal(i, j int) bool {
+	if null, equal, _ := c.compare(i, j); null {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *floatColumn) Less(i, j int) bool {
+	if null, _, less := c.compare(i, j); null {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *floatColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swap(i, j)
+}
+func (c *floatColumn) SetValid(i int, valid bool) {
+	c.set(i, len(c.data), valid)
 }
 
 type stringColumn struct {
 	ColMeta
+	validity
 	data  []string
 	alloc *Allocator
 }
@@ -1118,11 +1464,13 @@ func (c *stringColumn) Meta() ColMeta {
 func (c *stringColumn) Clear() {
 	c.alloc.Free(len(c.data), stringSize)
 	c.data = c.data[0:0]
+	c.clear()
 }
 func (c *stringColumn) Copy() column {
 	cpy := &stringColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:  c.ColMeta,
+		validity: c.validity.copy(),
+		alloc:    c.alloc,
 	}
 
 	l := len(c.data)
@@ -1131,17 +1479,28 @@ func (c *stringColumn) Copy() column {
 	return cpy
 }
 func (c *stringColumn) Equal(i, j int) bool {
+	if null, equal, _ := c.compare(i, j); null {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *stringColumn) Less(i, j int) bool {
+	if null, _, less := c.compare(i, j); null {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *stringColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swap(i, j)
+}
+func (c *stringColumn) SetValid(i int, valid bool) {
+	c.set(i, len(c.data), valid)
 }
 
 type timeColumn struct {
 	ColMeta
+	validity
 	data  []Time
 	alloc *Allocator
 }
@@ -1153,11 +1512,13 @@ func (c *timeColumn) Meta() ColMeta {
 func (c *timeColumn) Clear() {
 	c.alloc.Free(len(c.data), timeSize)
 	c.data = c.data[0:0]
+	c.clear()
 }
 func (c *timeColumn) Copy() column {
 	cpy := &timeColumn{
-		ColMeta: c.ColMeta,
-		alloc:   c.alloc,
+		ColMeta:  c.ColMeta,
+		validity: c.validity.copy(),
+		alloc:    c.alloc,
 	}
 	l := len(c.data)
 	cpy.data = c.alloc.Times(l, l)
@@ -1165,13 +1526,23 @@ func (c *timeColumn) Copy() column {
 	return cpy
 }
 func (c *timeColumn) Equal(i, j int) bool {
+	if null, equal, _ := c.compare(i, j); null {
+		return equal
+	}
 	return c.data[i] == c.data[j]
 }
 func (c *timeColumn) Less(i, j int) bool {
+	if null, _, less := c.compare(i, j); null {
+		return less
+	}
 	return c.data[i] < c.data[j]
 }
 func (c *timeColumn) Swap(i, j int) {
 	c.data[i], c.data[j] = c.data[j], c.data[i]
+	c.swap(i, j)
+}
+func (c *timeColumn) SetValid(i int, valid bool) {
+	c.set(i, len(c.data), valid)
 }
 
 type BlockBuilderCache interface {
@@ -23,6 +23,7 @@ type Block struct {
 	ColMeta []execute.ColMeta
 	// Data is a list of rows, i.e. Data[row][col]
 	// Each row must be a list with length equal to len(ColMeta)
+	// A nil value represents a null value.
 	Data [][]interface{}
 }
 
@@ -89,27 +90,40 @@ func (cr ColReader) Len() int {
 }
 
 func (cr ColReader) Bools(j int) []bool {
-	return []bool{cr.row[j].(bool)}
+	v, _ := cr.row[j].(bool)
+	return []bool{v}
 }
 
 func (cr ColReader) Ints(j int) []int64 {
-	return []int64{cr.row[j].(int64)}
+	v, _ := cr.row[j].(int64)
+	return []int64{v}
 }
 
 func (cr ColReader) UInts(j int) []uint64 {
-	return []uint64{cr.row[j].(uint64)}
+	v, _ := cr.row[j].(uint64)
+	return []uint64{v}
 }
 
 func (cr ColReader) Floats(j int) []float64 {
-	return []float64{cr.row[j].(float64)}
+	v, _ := cr.row[j].(float64)
+	return []float64{v}
 }
 
 func (cr ColReader) Strings(j int) []string {
-	return []string{cr.row[j].(string)}
+	v, _ := cr.row[j].(string)
+	return []string{v}
 }
 
 func (cr ColReader) Times(j int) []execute.Time {
-	return []execute.Time{cr.row[j].(execute.Time)}
+	v, _ := cr.row[j].(execute.Time)
+	return []execute.Time{v}
+}
+
+func (cr ColReader) Valid(j int) []bool {
+	if cr.row[j] == nil {
+		return []bool{false}
+	}
+	return nil
 }
 
 func BlocksFromCache(c execute.DataCache) (blocks []*Block, err error) {
@@ -154,6 +168,9 @@ func ConvertBlock(b execute.Block) (*Block, error) {
 		for i := 0; i < l; i++ {
 			row := make([]interface{}, len(blk.ColMeta))
 			for j, c := range blk.ColMeta {
+				if execute.IsNull(cr, i, j) {
+					continue
+				}
 				var v interface{}
 				switch c.Type {
 				case execute.TBool:
@@ -4,6 +4,8 @@ import "github.com/influxdata/ifql/query/execute"
 
 type Result struct {
 	Blks []*Block
+	// Err is returned once all blocks have been iterated.
+	Err error
 }
 
 func NewResult(blocks []*Block) *Result {
@@ -12,7 +14,8 @@ func NewResult(blocks []*Block) *Result {
 
 func (r *Result) Blocks() execute.BlockIterator {
 	return &BlockIterator{
-		r.Blks,
+		blocks: r.Blks,
+		err:    r.Err,
 	}
 }
 
@@ -22,6 +25,7 @@ func (r *Result) Normalize() {
 
 type BlockIterator struct {
 	blocks []*Block
+	err    error
 }
 
 func (bi *BlockIterator) Do(f func(execute.Block) error) error {
@@ -30,5 +34,5 @@ func (bi *BlockIterator) Do(f func(execute.Block) error) error {
 			return err
 		}
 	}
-	return nil
+	return bi.err
 }
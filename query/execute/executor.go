@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"runtime/debug"
+	"sync"
 
 	"github.com/influxdata/ifql/id"
 	"github.com/influxdata/ifql/query"
@@ -13,6 +14,9 @@ import (
 
 type Executor interface {
 	Execute(ctx context.Context, orgID id.ID, p *plan.PlanSpec) (map[string]Result, error)
+	// Analyze executes the plan as Execute does, collecting statistics about each of its procedures.
+	// The statistics are sent on the returned channel once the execution has finished.
+	Analyze(ctx context.Context, orgID id.ID, p *plan.PlanSpec) (map[string]Result, <-chan Statistics, error)
 }
 
 type executor struct {
@@ -44,10 +48,15 @@ type executionState struct {
 	transports []Transport
 
 	dispatcher *poolDispatcher
+
+	// statistics holds the statistics of each procedure, it is nil unless the execution is analyzed.
+	statistics map[plan.ProcedureID]*procedureStatistics
+	// statisticsC delivers the statistics once the execution has finished.
+	statisticsC chan Statistics
 }
 
 func (e *executor) Execute(ctx context.Context, orgID id.ID, p *plan.PlanSpec) (map[string]Result, error) {
-	es, err := e.createExecutionState(ctx, orgID, p)
+	es, err := e.createExecutionState(ctx, orgID, p, false)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to initialize execute state")
 	}
@@ -55,6 +64,15 @@ func (e *executor) Execute(ctx context.Context, orgID id.ID, p *plan.PlanSpec) (
 	return es.results, nil
 }
 
+func (e *executor) Analyze(ctx context.Context, orgID id.ID, p *plan.PlanSpec) (map[string]Result, <-chan Statistics, error) {
+	es, err := e.createExecutionState(ctx, orgID, p, true)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to initialize execute state")
+	}
+	es.do(ctx)
+	return es.results, es.statisticsC, nil
+}
+
 func validatePlan(p *plan.PlanSpec) error {
 	if p.Resources.ConcurrencyQuota == 0 {
 		return errors.New("plan must have a non-zero concurrency quota")
@@ -62,7 +80,7 @@ func validatePlan(p *plan.PlanSpec) error {
 	return nil
 }
 
-func (e *executor) createExecutionState(ctx context.Context, orgID id.ID, p *plan.PlanSpec) (*executionState, error) {
+func (e *executor) createExecutionState(ctx context.Context, orgID id.ID, p *plan.PlanSpec, analyze bool) (*executionState, error) {
 	if err := validatePlan(p); err != nil {
 		return nil, errors.Wrap(err, "invalid plan")
 	}
@@ -82,6 +100,10 @@ func (e *executor) createExecutionState(ctx context.Context, orgID id.ID, p *pla
 			Stop:  Time(p.Bounds.Stop.Time(p.Now).UnixNano()),
 		},
 	}
+	if analyze {
+		es.statistics = make(map[plan.ProcedureID]*procedureStatistics, len(p.Procedures))
+		es.statisticsC = make(chan Statistics, 1)
+	}
 	nodes := make(map[plan.ProcedureID]Node, len(p.Procedures))
 	for name, yield := range p.Results {
 		ds, err := es.createNode(ctx, p.Procedures[yield.ID], nodes)
@@ -89,7 +111,7 @@ func (e *executor) createExecutionState(ctx context.Context, orgID id.ID, p *pla
 			return nil, err
 		}
 		r := newResult(yield)
-		ds.AddTransformation(r)
+		ds.AddTransformation(es.output(yield.ID, r))
 		es.results[name] = r
 	}
 	return es, nil
@@ -110,7 +132,14 @@ func (es *executionState) createNode(ctx context.Context, pr *plan.Procedure, no
 	}
 	// Build execution context
 	ec := executionContext{
-		es: es,
+		es:    es,
+		alloc: es.alloc,
+	}
+	var stats *procedureStatistics
+	if es.statistics != nil {
+		stats = newProcedureStatistics(pr, es.alloc)
+		es.statistics[pr.ID] = stats
+		ec.alloc = stats.alloc
 	}
 	if len(pr.Parents) > 0 {
 		ec.parents = make([]DatasetID, len(pr.Parents))
@@ -125,6 +154,12 @@ func (es *executionState) createNode(ctx context.Context, pr *plan.Procedure, no
 		if err != nil {
 			return nil, err
 		}
+		if stats != nil {
+			s = &statisticsSource{
+				Source: s,
+				s:      stats,
+			}
+		}
 		es.sources = append(es.sources, s)
 		nodes[pr.ID] = s
 		return s, nil
@@ -141,6 +176,12 @@ func (es *executionState) createNode(ctx context.Context, pr *plan.Procedure, no
 		return nil, err
 	}
 	nodes[pr.ID] = ds
+	if stats != nil {
+		t = &statisticsTransformation{
+			t: t,
+			s: stats,
+		}
+	}
 
 	// Setup triggering
 	var ts query.TriggerSpec = DefaultTriggerSpec
@@ -157,12 +198,34 @@ func (es *executionState) createNode(ctx context.Context, pr *plan.Procedure, no
 		}
 		transport := newConescutiveTransport(es.dispatcher, t)
 		es.transports = append(es.transports, transport)
-		parent.AddTransformation(transport)
+		parent.AddTransformation(es.output(parentID, transport))
 	}
 
 	return ds, nil
 }
 
+// output returns the transformation to add to the node of the procedure,
+// it counts the blocks the procedure produces when the execution is analyzed.
+func (es *executionState) output(id plan.ProcedureID, t Transformation) Transformation {
+	if es.statistics == nil {
+		return t
+	}
+	return es.statistics[id].output(t)
+}
+
+// collectStatistics returns the statistics of the procedures in the order of the plan.
+func (es *executionState) collectStatistics() Statistics {
+	s := Statistics{
+		Procedures: make([]ProcedureStatistics, 0, len(es.statistics)),
+	}
+	for _, id := range es.p.Order {
+		if ps, ok := es.statistics[id]; ok {
+			s.Procedures = append(s.Procedures, ps.statistics())
+		}
+	}
+	return s
+}
+
 func (es *executionState) abort(err error) {
 	for _, r := range es.results {
 		r.(*result).abort(err)
@@ -170,8 +233,11 @@ func (es *executionState) abort(err error) {
 }
 
 func (es *executionState) do(ctx context.Context) {
+	var wg sync.WaitGroup
 	for _, src := range es.sources {
+		wg.Add(1)
 		go func(src Source) {
+			defer wg.Done()
 			// Setup panic handling on the source goroutines
 			defer func() {
 				if e := recover(); e != nil {
@@ -208,11 +274,18 @@ func (es *executionState) do(ctx context.Context) {
 		if err != nil {
 			es.abort(err)
 		}
+		if es.statisticsC != nil {
+			// Sources are timed until they return.
+			wg.Wait()
+			es.statisticsC <- es.collectStatistics()
+			close(es.statisticsC)
+		}
 	}()
 }
 
 type executionContext struct {
 	es      *executionState
+	alloc   *Allocator
 	parents []DatasetID
 }
 
@@ -229,7 +302,7 @@ func (ec executionContext) Bounds() Bounds {
 }
 
 func (ec executionContext) Allocator() *Allocator {
-	return ec.es.alloc
+	return ec.alloc
 }
 
 func (ec executionContext) Parents() []DatasetID {
@@ -385,6 +385,107 @@ func TestExecutor_Execute(t *testing.T) {
 	}
 }
 
+func TestExecutor_Analyze(t *testing.T) {
+	fromID := plan.ProcedureIDFromOperationID("from")
+	sumID := plan.ProcedureIDFromOperationID("sum")
+	p := &plan.PlanSpec{
+		Now: epoch.Add(5),
+		Resources: query.ResourceManagement{
+			ConcurrencyQuota: 1,
+			MemoryBytesQuota: math.MaxInt64,
+		},
+		Bounds: plan.BoundsSpec{
+			Start: query.Time{Absolute: time.Unix(0, 1)},
+			Stop:  query.Time{Absolute: time.Unix(0, 5)},
+		},
+		Procedures: map[plan.ProcedureID]*plan.Procedure{
+			fromID: {
+				ID: fromID,
+				Spec: &testFromProcedureSource{
+					data: []execute.Block{&executetest.Block{
+						KeyCols: []string{"_start", "_stop"},
+						ColMeta: []execute.ColMeta{
+							{Label: "_start", Type: execute.TTime},
+							{Label: "_stop", Type: execute.TTime},
+							{Label: "_time", Type: execute.TTime},
+							{Label: "_value", Type: execute.TFloat},
+						},
+						Data: [][]interface{}{
+							{execute.Time(0), execute.Time(5), execute.Time(0), 1.0},
+							{execute.Time(0), execute.Time(5), execute.Time(1), 2.0},
+							{execute.Time(0), execute.Time(5), execute.Time(2), 3.0},
+							{execute.Time(0), execute.Time(5), execute.Time(3), 4.0},
+							{execute.Time(0), execute.Time(5), execute.Time(4), 5.0},
+						},
+					}},
+				},
+				Children: []plan.ProcedureID{sumID},
+			},
+			sumID: {
+				ID: sumID,
+				Spec: &functions.SumProcedureSpec{
+					AggregateConfig: execute.DefaultAggregateConfig,
+				},
+				Parents: []plan.ProcedureID{fromID},
+			},
+		},
+		Order: []plan.ProcedureID{fromID, sumID},
+		Results: map[string]plan.YieldSpec{
+			plan.DefaultYieldName: {ID: sumID},
+		},
+	}
+	want := execute.Statistics{
+		Procedures: []execute.ProcedureStatistics{
+			{
+				ID:        fromID,
+				Kind:      "from-test",
+				BlocksOut: 1,
+				RowsOut:   5,
+			},
+			{
+				ID:        sumID,
+				Kind:      functions.SumKind,
+				BlocksIn:  1,
+				RowsIn:    5,
+				BlocksOut: 1,
+				RowsOut:   1,
+			},
+		},
+	}
+
+	exe := execute.NewExecutor(nil)
+	results, statistics, err := exe.Analyze(context.Background(), orgID, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range results {
+		if err := r.Blocks().Do(func(b execute.Block) error {
+			_, err := executetest.ConvertBlock(b)
+			return err
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got := <-statistics
+
+	// Memory and time depend on the implementation, only check that they are reported.
+	for i := range got.Procedures {
+		ps := &got.Procedures[i]
+		if ps.WallTime <= 0 {
+			t.Errorf("procedure %s: expected a positive wall time, got %v", ps.Kind, ps.WallTime)
+		}
+		if ps.Kind == functions.SumKind && (ps.BytesAllocated <= 0 || ps.MaxBytesAllocated <= 0) {
+			t.Errorf("procedure %s: expected allocated bytes, got %d and max %d", ps.Kind, ps.BytesAllocated, ps.MaxBytesAllocated)
+		}
+		ps.WallTime = 0
+		ps.BytesAllocated = 0
+		ps.MaxBytesAllocated = 0
+	}
+	if !cmp.Equal(got, want) {
+		t.Error("unexpected statistics -want/+got", cmp.Diff(want, got))
+	}
+}
+
 type testFromProcedureSource struct {
 	data []execute.Block
 	ts   []execute.Transformation
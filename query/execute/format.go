@@ -211,6 +211,9 @@ func (f *Formatter) writeHeaderSeparator(w *writeToHelper) {
 }
 
 func (f *Formatter) valueBuf(i, j int, typ DataType, cr ColReader) (buf []byte) {
+	if IsNull(cr, i, j) {
+		return f.fmtBuf[0:0]
+	}
 	switch typ {
 	case TBool:
 		buf = strconv.AppendBool(f.fmtBuf[0:0], cr.Bools(j)[i])
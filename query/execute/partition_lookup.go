@@ -79,6 +79,10 @@ func computeKeyHash(key PartitionKey) uint64 {
 	h := fnv.New64()
 	for j, c := range key.Cols() {
 		h.Write([]byte(c.Label))
+		if key.Value(j) == nil {
+			// Null values hash as an empty value.
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			if key.ValueBool(j) {
@@ -23,38 +23,46 @@ type rowFn struct {
 
 	recordCols map[string]int
 	references []string
+	// allCols is set when the record is used as a whole, i.e. passed to a function,
+	// in which case every column is referenced.
+	allCols bool
 }
 
 func newRowFn(fn *semantic.FunctionExpression) (rowFn, error) {
 	if len(fn.Params) != 1 {
 		return rowFn{}, fmt.Errorf("function should only have a single parameter, got %d", len(fn.Params))
 	}
-	scope, decls := query.BuiltIns()
+	scope, decls := query.CompilerBuiltIns()
+	references, allCols := findColReferences(fn)
 	return rowFn{
 		compilationCache: compiler.NewCompilationCache(fn, scope, decls),
 		scope:            make(compiler.Scope, 1),
 		recordName:       fn.Params[0].Key.Name,
-		references:       findColReferences(fn),
+		references:       references,
 		recordCols:       make(map[string]int),
+		allCols:          allCols,
 	}, nil
 }
 
 func (f *rowFn) prepare(cols []ColMeta) error {
+	if f.allCols {
+		f.references = f.references[:0]
+		for _, c := range cols {
+			f.references = append(f.references, c.Label)
+		}
+	}
 	// Prepare types and recordCols
 	propertyTypes := make(map[string]semantic.Type, len(f.references))
 	for _, r := range f.references {
-		found := false
-		for j, c := range cols {
-			if r == c.Label {
-				f.recordCols[r] = j
-				found = true
-				propertyTypes[r] = ConvertToKind(c.Type)
-				break
-			}
-		}
-		if !found {
-			return fmt.Errorf("function references unknown column %q", r)
+		j := ColIdx(r, cols)
+		if j < 0 {
+			// Columns that do not exist are null, so that their absence can be checked with exists.
+			delete(f.recordCols, r)
+			propertyTypes[r] = semantic.Nil
+			continue
 		}
+		f.recordCols[r] = j
+		propertyTypes[r] = ConvertToKind(cols[j].Type)
 	}
 	f.record = NewRecord(semantic.NewObjectType(propertyTypes))
 	// Compile fn for given types
@@ -114,7 +122,12 @@ func ConvertFromKind(k semantic.Kind) DataType {
 
 func (f *rowFn) eval(row int, cr ColReader) (values.Value, error) {
 	for _, r := range f.references {
-		f.record.Set(r, ValueForRow(row, f.recordCols[r], cr))
+		j, ok := f.recordCols[r]
+		if !ok {
+			f.record.Set(r, values.Null)
+			continue
+		}
+		f.record.Set(r, ValueForRow(row, j, cr))
 	}
 	f.scope[f.recordName] = f.record
 	return f.preparedFn.Eval(f.scope)
@@ -150,6 +163,10 @@ func (f *RowPredicateFn) Eval(row int, cr ColReader) (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	// A null result does not match the predicate.
+	if values.IsNull(v) {
+		return false, nil
+	}
 	return v.Bool(), nil
 }
 
@@ -201,10 +218,17 @@ func (f *RowMapFn) Eval(row int, cr ColReader) (values.Object, error) {
 		f.wrapObj.Set(DefaultValueColLabel, v)
 		return f.wrapObj, nil
 	}
+	if values.IsNull(v) {
+		return nil, errors.New("map function returned a null object")
+	}
 	return v.Object(), nil
 }
 
+// ValueForRow returns the value of column j in row i, null values are returned as values.Null.
 func ValueForRow(i, j int, cr ColReader) values.Value {
+	if IsNull(cr, i, j) {
+		return values.Null
+	}
 	t := cr.Cols()[j].Type
 	switch t {
 	case TString:
@@ -225,7 +249,12 @@ func ValueForRow(i, j int, cr ColReader) values.Value {
 	}
 }
 
+// AppendValue appends v to column j of the builder, a nil value is appended as null.
 func AppendValue(builder BlockBuilder, j int, v values.Value) {
+	if values.IsNull(v) {
+		builder.AppendNil(j)
+		return
+	}
 	switch k := v.Type().Kind(); k {
 	case semantic.Bool:
 		builder.AppendBool(j, v.Bool())
@@ -244,23 +273,32 @@ func AppendValue(builder BlockBuilder, j int, v values.Value) {
 	}
 }
 
-func findColReferences(fn *semantic.FunctionExpression) []string {
+// findColReferences returns the columns referenced as properties of the record,
+// and whether the record is used as a whole so that all columns are referenced.
+func findColReferences(fn *semantic.FunctionExpression) ([]string, bool) {
 	v := &colReferenceVisitor{
 		recordName: fn.Params[0].Key.Name,
 	}
-	semantic.Walk(v, fn)
-	return v.refs
+	semantic.Walk(v, fn.Body)
+	return v.refs, v.all
 }
 
 type colReferenceVisitor struct {
 	recordName string
 	refs       []string
+	all        bool
 }
 
 func (c *colReferenceVisitor) Visit(node semantic.Node) semantic.Visitor {
-	if me, ok := node.(*semantic.MemberExpression); ok {
-		if obj, ok := me.Object.(*semantic.IdentifierExpression); ok && obj.Name == c.recordName {
-			c.refs = append(c.refs, me.Property)
+	switch n := node.(type) {
+	case *semantic.MemberExpression:
+		if obj, ok := n.Object.(*semantic.IdentifierExpression); ok && obj.Name == c.recordName {
+			c.refs = append(c.refs, n.Property)
+			return nil
+		}
+	case *semantic.IdentifierExpression:
+		if n.Name == c.recordName {
+			c.all = true
 		}
 	}
 	return c
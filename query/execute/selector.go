@@ -26,6 +26,14 @@ func (c *SelectorConfig) ReadArgs(args query.Arguments) error {
 	return nil
 }
 
+// CallArguments returns the arguments that differ from the default config.
+func (c SelectorConfig) CallArguments() []query.Argument {
+	if c.Column == "" {
+		return nil
+	}
+	return []query.Argument{{Key: "column", Value: c.Column}}
+}
+
 type rowSelectorTransformation struct {
 	selectorTransformation
 	selector RowSelector
@@ -123,6 +131,8 @@ func (t *indexSelectorTransformation) Process(id DatasetID, b Block) error {
 	}
 
 	return b.Do(func(cr ColReader) error {
+		// Null values are never selected.
+		cr = SkipNulls(cr, valueIdx)
 		switch valueCol.Type {
 		case TBool:
 			selected := s.(DoBoolIndexSelector).DoBool(cr.Bools(valueIdx))
@@ -170,6 +180,8 @@ func (t *rowSelectorTransformation) Process(id DatasetID, b Block) error {
 	}
 
 	b.Do(func(cr ColReader) error {
+		// Null values are never selected.
+		cr = SkipNulls(cr, valueIdx)
 		switch valueCol.Type {
 		case TBool:
 			rower.(DoBoolRowSelector).DoBool(cr.Bools(valueIdx), cr)
@@ -198,6 +210,10 @@ func (t *indexSelectorTransformation) appendSelected(selected []int, builder Blo
 	cols := builder.Cols()
 	for j, c := range cols {
 		for _, i := range selected {
+			if IsNull(cr, i, j) {
+				builder.AppendNil(j)
+				continue
+			}
 			switch c.Type {
 			case TBool:
 				builder.AppendBool(j, cr.Bools(j)[i])
@@ -223,6 +239,10 @@ func (t *rowSelectorTransformation) appendRows(builder BlockBuilder, rows []Row)
 	for j, c := range cols {
 		for _, row := range rows {
 			v := row.Values[j]
+			if v == nil {
+				builder.AppendNil(j)
+				continue
+			}
 			switch c.Type {
 			case TBool:
 				builder.AppendBool(j, v.(bool))
@@ -303,10 +323,14 @@ type Row struct {
 	Values []interface{}
 }
 
+// ReadRow reads the values of row i, null values are read as nil.
 func ReadRow(i int, cr ColReader) (row Row) {
 	cols := cr.Cols()
 	row.Values = make([]interface{}, len(cols))
 	for j, c := range cols {
+		if IsNull(cr, i, j) {
+			continue
+		}
 		switch c.Type {
 		case TBool:
 			row.Values[j] = cr.Bools(j)[i]
@@ -58,6 +58,39 @@ func TestRowSelector_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "single with nulls",
+			config: execute.SelectorConfig{
+				Column: "_value",
+			},
+			data: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(1), nil},
+					{execute.Time(0), execute.Time(100), execute.Time(10), 2.0},
+					{execute.Time(0), execute.Time(100), execute.Time(20), 1.0},
+					{execute.Time(0), execute.Time(100), execute.Time(30), nil},
+				},
+			}},
+			want: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(20), 1.0},
+				},
+			}},
+		},
 		{
 			name: "single custom column",
 			config: execute.SelectorConfig{
@@ -255,6 +288,38 @@ func TestIndexSelector_Process(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "single with nulls",
+			config: execute.SelectorConfig{
+				Column: "_value",
+			},
+			data: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(1), nil},
+					{execute.Time(0), execute.Time(100), execute.Time(10), 1.0},
+					{execute.Time(0), execute.Time(100), execute.Time(20), 2.0},
+				},
+			}},
+			want: []*executetest.Block{{
+				KeyCols: []string{"_start", "_stop"},
+				ColMeta: []execute.ColMeta{
+					{Label: "_start", Type: execute.TTime},
+					{Label: "_stop", Type: execute.TTime},
+					{Label: "_time", Type: execute.TTime},
+					{Label: "_value", Type: execute.TFloat},
+				},
+				Data: [][]interface{}{
+					{execute.Time(0), execute.Time(100), execute.Time(10), 1.0},
+				},
+			}},
+		},
 		{
 			name: "multiple blocks",
 			config: execute.SelectorConfig{
@@ -0,0 +1,189 @@
+package execute
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/ifql/query/plan"
+)
+
+// Statistics reports how the procedures of a plan were executed.
+type Statistics struct {
+	// Procedures holds the statistics of each procedure, in the order of the plan.
+	Procedures []ProcedureStatistics `json:"procedures"`
+}
+
+// ProcedureStatistics reports how a single procedure of a plan was executed.
+type ProcedureStatistics struct {
+	ID   plan.ProcedureID   `json:"id"`
+	Kind plan.ProcedureKind `json:"kind"`
+
+	// BlocksIn and RowsIn count the blocks the procedure processed and their rows.
+	// Both are zero for sources.
+	BlocksIn int64 `json:"blocks_in"`
+	RowsIn   int64 `json:"rows_in"`
+	// BlocksOut and RowsOut count the blocks the procedure produced and their rows.
+	BlocksOut int64 `json:"blocks_out"`
+	RowsOut   int64 `json:"rows_out"`
+
+	// BytesAllocated is the memory allocated by the procedure, including memory that has been freed.
+	BytesAllocated int64 `json:"bytes_allocated"`
+	// MaxBytesAllocated is the maximum memory allocated by the procedure at any point.
+	MaxBytesAllocated int64 `json:"max_bytes_allocated"`
+
+	// WallTime is the time spent executing the procedure, in nanoseconds.
+	// For sources it is the time spent reading from storage, other procedures are timed as they process their parents' data.
+	WallTime time.Duration `json:"wall_time"`
+}
+
+// procedureStatistics collects the statistics of a procedure while it is executed.
+type procedureStatistics struct {
+	id    plan.ProcedureID
+	kind  plan.ProcedureKind
+	alloc *Allocator
+
+	blocksIn  int64
+	rowsIn    int64
+	blocksOut int64
+	rowsOut   int64
+	wallTime  int64
+
+	// counted reports whether a child already counts the blocks the procedure produces,
+	// the blocks are sent to all children so only one of them counts them.
+	counted bool
+}
+
+func newProcedureStatistics(pr *plan.Procedure, alloc *Allocator) *procedureStatistics {
+	return &procedureStatistics{
+		id:    pr.ID,
+		kind:  pr.Spec.Kind(),
+		alloc: newChildAllocator(alloc),
+	}
+}
+
+// since adds the time elapsed since start to the wall time of the procedure.
+func (s *procedureStatistics) since(start time.Time) {
+	atomic.AddInt64(&s.wallTime, int64(time.Since(start)))
+}
+
+// output returns the transformation that receives the blocks produced by the procedure for a child.
+// The blocks are counted for the first child only.
+func (s *procedureStatistics) output(t Transformation) Transformation {
+	if s.counted {
+		return t
+	}
+	s.counted = true
+	return &statisticsOutput{
+		Transformation: t,
+		s:              s,
+	}
+}
+
+func (s *procedureStatistics) statistics() ProcedureStatistics {
+	return ProcedureStatistics{
+		ID:                s.id,
+		Kind:              s.kind,
+		BlocksIn:          atomic.LoadInt64(&s.blocksIn),
+		RowsIn:            atomic.LoadInt64(&s.rowsIn),
+		BlocksOut:         atomic.LoadInt64(&s.blocksOut),
+		RowsOut:           atomic.LoadInt64(&s.rowsOut),
+		BytesAllocated:    s.alloc.Total(),
+		MaxBytesAllocated: s.alloc.Max(),
+		WallTime:          time.Duration(atomic.LoadInt64(&s.wallTime)),
+	}
+}
+
+// statisticsTransformation collects the statistics of the procedure of a transformation.
+type statisticsTransformation struct {
+	t Transformation
+	s *procedureStatistics
+}
+
+func (t *statisticsTransformation) RetractBlock(id DatasetID, key PartitionKey) error {
+	defer t.s.since(time.Now())
+	return t.t.RetractBlock(id, key)
+}
+
+func (t *statisticsTransformation) Process(id DatasetID, b Block) error {
+	defer t.s.since(time.Now())
+	atomic.AddInt64(&t.s.blocksIn, 1)
+	return t.t.Process(id, countRows(b, &t.s.rowsIn))
+}
+
+func (t *statisticsTransformation) UpdateWatermark(id DatasetID, mark Time) error {
+	defer t.s.since(time.Now())
+	return t.t.UpdateWatermark(id, mark)
+}
+
+func (t *statisticsTransformation) UpdateProcessingTime(id DatasetID, pt Time) error {
+	defer t.s.since(time.Now())
+	return t.t.UpdateProcessingTime(id, pt)
+}
+
+func (t *statisticsTransformation) Finish(id DatasetID, err error) {
+	defer t.s.since(time.Now())
+	t.t.Finish(id, err)
+}
+
+// statisticsOutput counts the blocks a procedure produces before passing them to a child.
+type statisticsOutput struct {
+	Transformation
+	s *procedureStatistics
+}
+
+func (t *statisticsOutput) Process(id DatasetID, b Block) error {
+	atomic.AddInt64(&t.s.blocksOut, 1)
+	return t.Transformation.Process(id, countRows(b, &t.s.rowsOut))
+}
+
+// statisticsSource collects the statistics of the procedure of a source.
+type statisticsSource struct {
+	Source
+	s *procedureStatistics
+}
+
+func (s *statisticsSource) Run(ctx context.Context) {
+	defer s.s.since(time.Now())
+	s.Source.Run(ctx)
+}
+
+// countRows adds the number of rows of the block to n.
+// The rows of blocks that do not know their size are counted as they are read,
+// so the returned block must be used in place of b.
+func countRows(b Block, n *int64) Block {
+	if sb, ok := b.(interface {
+		NRows() int
+	}); ok {
+		atomic.AddInt64(n, int64(sb.NRows()))
+		return b
+	}
+	cb := &countingBlock{
+		Block: b,
+		rows:  n,
+	}
+	if _, ok := b.(OneTimeBlock); ok {
+		return &countingOneTimeBlock{cb}
+	}
+	return cb
+}
+
+// countingBlock counts the rows of a block as they are read.
+type countingBlock struct {
+	Block
+	rows *int64
+}
+
+func (b *countingBlock) Do(f func(ColReader) error) error {
+	return b.Block.Do(func(cr ColReader) error {
+		atomic.AddInt64(b.rows, int64(cr.Len()))
+		return f(cr)
+	})
+}
+
+// countingOneTimeBlock counts the rows of a OneTimeBlock as they are read.
+type countingOneTimeBlock struct {
+	*countingBlock
+}
+
+func (b *countingOneTimeBlock) onetime() {}
@@ -3,9 +3,15 @@ package query
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/influxdata/ifql/format"
+	"github.com/influxdata/ifql/semantic"
 )
 
-// TODO(nathanielc): Add better options for formatting plans as Graphviz dot format.
 type FormatOption func(*formatter)
 
 func Formatted(q *Spec, opts ...FormatOption) fmt.Formatter {
@@ -20,9 +26,24 @@ func Formatted(q *Spec, opts ...FormatOption) fmt.Formatter {
 
 func FmtJSON(f *formatter) { f.json = true }
 
+// FmtDOT formats the query in the Graphviz DOT language.
+// Each operation is labeled with its kind and the fields of its spec, the graph with the resources of the query.
+func FmtDOT(f *formatter) { f.dot = true }
+
+// FmtDOTCluster formats the query in the Graphviz DOT language as a cluster subgraph, to be embedded in another graph.
+// The IDs of the nodes are prefixed with the name of the cluster, so they are unique across clusters.
+func FmtDOTCluster(name string) FormatOption {
+	return func(f *formatter) {
+		f.dot = true
+		f.cluster = name
+	}
+}
+
 type formatter struct {
-	q    *Spec
-	json bool
+	q       *Spec
+	json    bool
+	dot     bool
+	cluster string
 }
 
 func (f formatter) Format(fs fmt.State, c rune) {
@@ -30,9 +51,12 @@ func (f formatter) Format(fs fmt.State, c rune) {
 		fmt.Fprintf(fs, "%#v", f.q)
 		return
 	}
-	if f.json {
+	switch {
+	case f.json:
 		f.formatJSON(fs)
-	} else {
+	case f.dot:
+		f.formatDOT(fs)
+	default:
 		f.formatDAG(fs)
 	}
 }
@@ -53,3 +77,128 @@ func (f formatter) formatDAG(fs fmt.State) {
 	})
 	fmt.Fprintln(fs, "}")
 }
+
+func (f formatter) formatDOT(fs fmt.State) {
+	if f.cluster != "" {
+		fmt.Fprintf(fs, "subgraph %s {\n", DOTID("cluster_"+f.cluster))
+	} else {
+		fmt.Fprint(fs, "digraph QuerySpec {\n")
+	}
+	details := SpecDetails(f.q.Resources)
+	if !f.q.Now.IsZero() {
+		details = append([]string{"now=" + f.q.Now.Format(time.RFC3339Nano)}, details...)
+	}
+	fmt.Fprintf(fs, "label=%s;\nlabeljust=l;\n", DOTLabel("query", details))
+	fmt.Fprint(fs, "node [shape=box];\n")
+	_ = f.q.Walk(func(o *Operation) error {
+		fmt.Fprintf(fs, "%s [label=%s];\n", DOTClusterID(f.cluster, string(o.ID)), DOTLabel(string(o.ID)+": "+string(o.Spec.Kind()), SpecDetails(o.Spec)))
+		for _, child := range f.q.Children(o.ID) {
+			fmt.Fprintf(fs, "%s -> %s;\n", DOTClusterID(f.cluster, string(o.ID)), DOTClusterID(f.cluster, string(child.ID)))
+		}
+		return nil
+	})
+	fmt.Fprintln(fs, "}")
+}
+
+// SpecDetails describes the exported fields of a spec as lines of key=value, sorted by key.
+// Keys are the JSON names of the fields, the fields of nested structs are described with the key of the struct as prefix.
+// Functions are formatted as IFQL source, other values are encoded as JSON.
+// Fields with zero values are omitted.
+func SpecDetails(spec interface{}) []string {
+	v := reflect.Indirect(reflect.ValueOf(spec))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	var details []string
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// Unexported field
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n := strings.Split(tag, ",")[0]; n != "" {
+				name = n
+			} else if f.Anonymous {
+				// Embedded structs are flattened, as in JSON.
+				name = ""
+			}
+		} else if f.Anonymous {
+			name = ""
+		}
+		details = append(details, fieldDetails(name, v.Field(i).Interface())...)
+	}
+	sort.Strings(details)
+	return details
+}
+
+// fieldDetails describes the value of the field with the given name, or its fields if it has no name.
+func fieldDetails(name string, v interface{}) []string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return []string{name + "=error: " + err.Error()}
+	}
+	if isZeroJSON(data) {
+		return nil
+	}
+	if n, ok := v.(semantic.Node); ok {
+		if a := semantic.ToAST(n); a != nil {
+			return []string{name + "=" + format.Node(a)}
+		}
+	}
+	if data[0] == '{' && reflect.Indirect(reflect.ValueOf(v)).Kind() == reflect.Struct {
+		details := SpecDetails(v)
+		if name != "" {
+			for i, d := range details {
+				details[i] = name + "." + d
+			}
+		}
+		return details
+	}
+	return []string{name + "=" + string(data)}
+}
+
+// isZeroJSON reports whether the JSON value is the encoding of a zero value.
+func isZeroJSON(v []byte) bool {
+	switch string(v) {
+	case "null", "false", "0", `""`, "[]", "{}", `"0001-01-01T00:00:00Z"`, `"0s"`:
+		return true
+	default:
+		return false
+	}
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// DOTID quotes the string as an ID in the Graphviz DOT language.
+func DOTID(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
+
+// DOTClusterID returns the quoted Graphviz ID of a node in the cluster, which is prefixed with the name of the cluster.
+// Outside of a cluster, i.e. if the name is empty, it is the same as DOTID.
+func DOTClusterID(cluster, s string) string {
+	if cluster == "" {
+		return DOTID(s)
+	}
+	return DOTID(cluster + ":" + s)
+}
+
+// DOTLabel returns a quoted, left justified Graphviz label made of a title followed by a line for each detail.
+func DOTLabel(title string, details []string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	b.WriteString(dotEscaper.Replace(title))
+	b.WriteString(`\l`)
+	for _, d := range details {
+		b.WriteString(dotEscaper.Replace(d))
+		b.WriteString(`\l`)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
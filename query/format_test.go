@@ -0,0 +1,48 @@
+package query_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/influxdata/ifql/query"
+)
+
+func TestFormatted_DOT(t *testing.T) {
+	spec, err := query.Compile(context.Background(), `from(db: "mydb")
+	|> range(start: -1h)
+	|> filter(fn: (r) => r._measurement == "cpu")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.Resources.ConcurrencyQuota = 2
+
+	want := `digraph QuerySpec {
+label="query\lconcurrency_quota=2\lpriority=\"high\"\l";
+labeljust=l;
+node [shape=box];
+"from0" [label="from0: from\ldb=\"mydb\"\l"];
+"from0" -> "range1";
+"range1" [label="range1: range\lstart=\"-1h0m0s\"\lstop=\"now\"\l"];
+"range1" -> "filter2";
+"filter2" [label="filter2: filter\lfn=(r) => r._measurement == \"cpu\"\l"];
+}
+`
+	if got := fmt.Sprint(query.Formatted(spec, query.FmtDOT)); got != want {
+		t.Errorf("unexpected DOT format:\n%s\nwant:\n%s", got, want)
+	}
+	wantCluster := `subgraph "cluster_spec" {
+label="query\lconcurrency_quota=2\lpriority=\"high\"\l";
+labeljust=l;
+node [shape=box];
+"spec:from0" [label="from0: from\ldb=\"mydb\"\l"];
+"spec:from0" -> "spec:range1";
+"spec:range1" [label="range1: range\lstart=\"-1h0m0s\"\lstop=\"now\"\l"];
+"spec:range1" -> "spec:filter2";
+"spec:filter2" [label="filter2: filter\lfn=(r) => r._measurement == \"cpu\"\l"];
+}
+`
+	if got := fmt.Sprint(query.Formatted(spec, query.FmtDOTCluster("spec"))); got != wantCluster {
+		t.Errorf("unexpected DOT cluster format:\n%s\nwant:\n%s", got, wantCluster)
+	}
+}
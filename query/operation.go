@@ -71,6 +71,24 @@ type OperationSpec interface {
 	Kind() OperationKind
 }
 
+// Argument is a named argument of the function call that creates an operation.
+//
+// The value is one of string, bool, int64, float64, []string, Duration, Time,
+// *semantic.FunctionExpression, OperationID, which refers to the table produced by another operation,
+// or a map[string]interface{} of these values.
+type Argument struct {
+	Key   string
+	Value interface{}
+}
+
+// CallArgumentsSpec is an OperationSpec that knows the arguments of the function call that creates it.
+type CallArgumentsSpec interface {
+	OperationSpec
+	// CallArguments returns the arguments of the call, omitting any argument that has its default value.
+	// The table piped into the call is not an argument, it is implied by the edges of the query.
+	CallArguments() []Argument
+}
+
 // OperationID is a unique ID within a query for the operation.
 type OperationID string
 
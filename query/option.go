@@ -0,0 +1,144 @@
+package query
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+// The options that configure the query spec.
+// Any other option is recorded in the options of the spec.
+const (
+	// NowOption is the time the query is relative to.
+	NowOption = "now"
+	// ConcurrencyOption is the number of concurrency workers allowed to process the query.
+	ConcurrencyOption = "concurrency"
+	// MemoryOption is the number of bytes of RAM the query may consume.
+	MemoryOption = "memory"
+	// PriorityOption is the priority of the query, an integer or either "high" or "low".
+	PriorityOption = "priority"
+)
+
+// ApplyOptions sets the query-wide settings of the spec from the values of option statements.
+func (q *Spec) ApplyOptions(options map[string]values.Value) error {
+	for name, v := range options {
+		if err := q.applyOption(name, v); err != nil {
+			return fmt.Errorf("invalid option %q: %v", name, err)
+		}
+	}
+	return nil
+}
+
+func (q *Spec) applyOption(name string, v values.Value) error {
+	switch name {
+	case NowOption:
+		if k := v.Type().Kind(); k != semantic.Time {
+			return fmt.Errorf("expected a time, got %v", k)
+		}
+		q.Now = v.Time().Time()
+	case ConcurrencyOption:
+		n, err := positiveOption(v, math.MaxInt32)
+		if err != nil {
+			return err
+		}
+		q.Resources.ConcurrencyQuota = int(n)
+	case MemoryOption:
+		n, err := positiveOption(v, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+		q.Resources.MemoryBytesQuota = n
+	case PriorityOption:
+		if v.Type().Kind() == semantic.String {
+			return q.Resources.Priority.UnmarshalText([]byte(v.Str()))
+		}
+		n, err := positiveOption(v, int64(Low))
+		if err != nil {
+			return err
+		}
+		q.Resources.Priority = Priority(n)
+	default:
+		value, err := optionValue(v)
+		if err != nil {
+			return err
+		}
+		if q.Options == nil {
+			q.Options = make(map[string]interface{})
+		}
+		q.Options[name] = value
+	}
+	return nil
+}
+
+// positiveOption returns the value of an integer option, which must be between zero and max.
+func positiveOption(v values.Value, max int64) (int64, error) {
+	var n int64
+	switch k := v.Type().Kind(); k {
+	case semantic.Int:
+		n = v.Int()
+	case semantic.UInt:
+		if v.UInt() > math.MaxInt64 {
+			return 0, fmt.Errorf("value %d is greater than %d", v.UInt(), max)
+		}
+		n = int64(v.UInt())
+	default:
+		return 0, fmt.Errorf("expected an integer, got %v", k)
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("value %d is negative", n)
+	}
+	if n > max {
+		return 0, fmt.Errorf("value %d is greater than %d", n, max)
+	}
+	return n, nil
+}
+
+// optionValue converts the value of an option to a Go value.
+// Durations are Duration values, times are time.Time values,
+// arrays are []interface{} values and objects are map[string]interface{} values.
+func optionValue(v values.Value) (interface{}, error) {
+	switch k := v.Type().Kind(); k {
+	case semantic.String:
+		return v.Str(), nil
+	case semantic.Int:
+		return v.Int(), nil
+	case semantic.UInt:
+		return v.UInt(), nil
+	case semantic.Float:
+		return v.Float(), nil
+	case semantic.Bool:
+		return v.Bool(), nil
+	case semantic.Time:
+		return v.Time().Time(), nil
+	case semantic.Duration:
+		return Duration(v.Duration()), nil
+	case semantic.Regexp:
+		return v.Regexp().String(), nil
+	case semantic.Array:
+		arr := v.Array()
+		elements := make([]interface{}, arr.Len())
+		var err error
+		arr.Range(func(i int, e values.Value) {
+			if err != nil {
+				return
+			}
+			elements[i], err = optionValue(e)
+		})
+		return elements, err
+	case semantic.Object:
+		obj := v.Object()
+		properties := make(map[string]interface{}, obj.Len())
+		var err error
+		obj.Range(func(name string, p values.Value) {
+			if err != nil {
+				return
+			}
+			properties[name], err = optionValue(p)
+		})
+		return properties, err
+	default:
+		return nil, fmt.Errorf("options of kind %v are not supported", k)
+	}
+}
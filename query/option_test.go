@@ -0,0 +1,112 @@
+package query_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+)
+
+func TestCompile_Options(t *testing.T) {
+	withOptions := func(f func(spec *query.Spec)) *query.Spec {
+		spec := &query.Spec{
+			Operations: []*query.Operation{
+				{
+					ID:   "from0",
+					Spec: &functions.FromOpSpec{Database: "telegraf"},
+				},
+			},
+		}
+		f(spec)
+		return spec
+	}
+
+	testCases := []struct {
+		name    string
+		raw     string
+		want    *query.Spec
+		wantErr bool
+	}{
+		{
+			name: "now",
+			raw: `option now = 2018-01-01T00:00:00Z
+from(db: "telegraf")`,
+			want: withOptions(func(spec *query.Spec) {
+				spec.Now = time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+			}),
+		},
+		{
+			name: "resources",
+			raw: `option concurrency = 2
+option memory = 1024
+option priority = "low"
+from(db: "telegraf")`,
+			want: withOptions(func(spec *query.Spec) {
+				spec.Resources.ConcurrencyQuota = 2
+				spec.Resources.MemoryBytesQuota = 1024
+				spec.Resources.Priority = query.Low
+			}),
+		},
+		{
+			name: "other options",
+			raw: `option task = {every: 1m, name: "cpu", tags: ["a", "b"]}
+from(db: "telegraf")`,
+			want: withOptions(func(spec *query.Spec) {
+				spec.Options = map[string]interface{}{
+					"task": map[string]interface{}{
+						"every": query.Duration(time.Minute),
+						"name":  "cpu",
+						"tags":  []interface{}{"a", "b"},
+					},
+				}
+			}),
+		},
+		{
+			name: "options are variables",
+			raw: `option db = "telegraf"
+from(db: db)`,
+			want: withOptions(func(spec *query.Spec) {
+				spec.Options = map[string]interface{}{"db": "telegraf"}
+			}),
+		},
+		{
+			name: "invalid now",
+			raw: `option now = "yesterday"
+from(db: "telegraf")`,
+			wantErr: true,
+		},
+		{
+			name: "negative concurrency",
+			raw: `option concurrency = -1
+from(db: "telegraf")`,
+			wantErr: true,
+		},
+		{
+			name: "function option",
+			raw: `option f = (r) => r
+from(db: "telegraf")`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := query.Compile(context.Background(), tc.raw)
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			if !cmp.Equal(tc.want, got, cmpopts.IgnoreUnexported(query.Spec{})) {
+				t.Errorf("unexpected spec -want/+got:\n%s", cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(query.Spec{})))
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+package query
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/influxdata/ifql/ast"
+	"github.com/influxdata/ifql/interpreter"
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+	"github.com/pkg/errors"
+)
+
+// PackageFileExt is the file extension of IFQL source files that make up a package.
+const PackageFileExt = ".ifql"
+
+// builtinPackage is a package implemented in Go.
+type builtinPackage struct {
+	values map[string]values.Value
+}
+
+var builtinPackages = make(map[string]*builtinPackage)
+
+func lookupBuiltinPackage(pkgpath string) *builtinPackage {
+	if finalized {
+		panic(errors.New("already finalized, cannot register builtin package"))
+	}
+	p, ok := builtinPackages[pkgpath]
+	if !ok {
+		p = &builtinPackage{
+			values: make(map[string]values.Value),
+		}
+		builtinPackages[pkgpath] = p
+	}
+	return p
+}
+
+// RegisterPackageValue adds the value to the builtin package with the import path pkgpath.
+func RegisterPackageValue(pkgpath, name string, v values.Value) {
+	p := lookupBuiltinPackage(pkgpath)
+	if _, ok := p.values[name]; ok {
+		panic(fmt.Errorf("duplicate registration for %q in package %q", name, pkgpath))
+	}
+	p.values[name] = v
+}
+
+// packageFunction is a function of a builtin package implemented in Go.
+// It resolves to its qualified name, so that compiled functions can call it, see CompilerBuiltIns.
+type packageFunction struct {
+	fn   values.Function
+	name string
+}
+
+// newPackageFunction returns the package function of the value if it is a Go function that is not otherwise resolvable.
+func newPackageFunction(pkgpath, name string, v values.Value) (packageFunction, bool) {
+	if v.Type().Kind() != semantic.Function {
+		return packageFunction{}, false
+	}
+	switch v.Function().(type) {
+	case function, interpreter.Resolver:
+		return packageFunction{}, false
+	}
+	return packageFunction{
+		fn:   v.Function(),
+		name: pkgpath + "." + name,
+	}, true
+}
+
+func (f packageFunction) Type() semantic.Type {
+	return f.fn.Type()
+}
+func (f packageFunction) Str() string {
+	return f.fn.Str()
+}
+func (f packageFunction) Int() int64 {
+	return f.fn.Int()
+}
+func (f packageFunction) UInt() uint64 {
+	return f.fn.UInt()
+}
+func (f packageFunction) Float() float64 {
+	return f.fn.Float()
+}
+func (f packageFunction) Bool() bool {
+	return f.fn.Bool()
+}
+func (f packageFunction) Time() values.Time {
+	return f.fn.Time()
+}
+func (f packageFunction) Duration() values.Duration {
+	return f.fn.Duration()
+}
+func (f packageFunction) Regexp() *regexp.Regexp {
+	return f.fn.Regexp()
+}
+func (f packageFunction) Array() values.Array {
+	return f.fn.Array()
+}
+func (f packageFunction) Object() values.Object {
+	return f.fn.Object()
+}
+func (f packageFunction) Function() values.Function {
+	return f
+}
+
+func (f packageFunction) Call(args values.Object) (values.Value, error) {
+	return f.fn.Call(args)
+}
+
+func (f packageFunction) Resolve() (semantic.Node, error) {
+	return &semantic.IdentifierExpression{Name: f.name}, nil
+}
+
+// CompilerBuiltIns returns the builtins of BuiltIns and the Go functions of the builtin packages by their qualified names,
+// which is the import path and name of the function joined by a dot, e.g. "strings.sprintf".
+// Functions that call package functions resolve to their qualified names, so these are the builtins for compiling resolved functions.
+func CompilerBuiltIns() (map[string]values.Value, semantic.DeclarationScope) {
+	scope, decls := BuiltIns()
+	for pkgpath, bp := range builtinPackages {
+		for name, v := range bp.values {
+			if f, ok := newPackageFunction(pkgpath, name, v); ok {
+				scope[f.name] = f
+				decls[f.name] = semantic.NewExternalVariableDeclaration(f.name, f.Type())
+			}
+		}
+	}
+	return scope, decls
+}
+
+// Package is an imported package.
+type Package struct {
+	// Name is the name the package is bound to by default when imported.
+	Name string
+	// Path is the import path of the package.
+	Path string
+	// Object holds the members of the package.
+	Object values.Object
+}
+
+// Importer loads packages by their import path.
+// Builtin packages are found first, otherwise the package is loaded from the IFQL files of the search path.
+// A package is only loaded once per importer.
+type Importer struct {
+	qd           *queryDomain
+	scope        map[string]values.Value
+	declarations semantic.DeclarationScope
+	searchPath   []string
+
+	packages  map[string]*Package
+	importing map[string]bool
+}
+
+// BuiltInsWithImporter returns the builtins like BuiltIns and an importer that loads IFQL packages from the directories of the search path.
+// The functions of the builtins and of the imported packages share a query domain, so their operations have unique IDs.
+func BuiltInsWithImporter(searchPath []string) (map[string]values.Value, semantic.DeclarationScope, *Importer) {
+	qd := new(queryDomain)
+	scope, decls := builtIns(qd)
+	return scope, decls, newImporter(qd, scope, decls, searchPath)
+}
+
+func newImporter(qd *queryDomain, scope map[string]values.Value, declarations semantic.DeclarationScope, searchPath []string) *Importer {
+	universe := make(map[string]values.Value, len(scope))
+	for k, v := range scope {
+		universe[k] = v
+	}
+	return &Importer{
+		qd:           qd,
+		scope:        universe,
+		declarations: declarations.Copy(),
+		searchPath:   searchPath,
+		packages:     make(map[string]*Package),
+		importing:    make(map[string]bool),
+	}
+}
+
+// Import loads the imported packages and binds each package to its name in the scope and declarations.
+func (imp *Importer) Import(imports []*ast.ImportDeclaration, scope *interpreter.Scope, declarations semantic.DeclarationScope) error {
+	names := make(map[string]bool, len(imports))
+	for _, decl := range imports {
+		pkg, err := imp.Package(decl.Path.Value)
+		if err != nil {
+			return err
+		}
+		name := pkg.Name
+		if decl.As != nil {
+			name = decl.As.Name
+		}
+		if names[name] {
+			return fmt.Errorf("package name %q imported more than once", name)
+		}
+		names[name] = true
+
+		scope.Set(name, pkg.Object)
+		declarations[name] = semantic.NewExternalVariableDeclaration(name, pkg.Object.Type())
+	}
+	return nil
+}
+
+// Package returns the package with the import path pkgpath, loading it if needed.
+func (imp *Importer) Package(pkgpath string) (*Package, error) {
+	if pkg, ok := imp.packages[pkgpath]; ok {
+		return pkg, nil
+	}
+	if imp.importing[pkgpath] {
+		return nil, fmt.Errorf("import cycle not allowed, package %q is already being imported", pkgpath)
+	}
+	imp.importing[pkgpath] = true
+	defer delete(imp.importing, pkgpath)
+
+	var pkg *Package
+	if bp, ok := builtinPackages[pkgpath]; ok {
+		pkg = imp.loadBuiltin(pkgpath, bp)
+	} else {
+		var err error
+		if pkg, err = imp.loadFiles(pkgpath); err != nil {
+			return nil, err
+		}
+	}
+	imp.packages[pkgpath] = pkg
+	return pkg, nil
+}
+
+func (imp *Importer) loadBuiltin(pkgpath string, bp *builtinPackage) *Package {
+	obj := values.NewObject()
+	for name, v := range bp.values {
+		if v.Type().Kind() == semantic.Function {
+			if f, ok := v.Function().(function); ok {
+				f.qd = imp.qd
+				v = f
+			} else if f, ok := newPackageFunction(pkgpath, name, v); ok {
+				v = f
+			}
+		}
+		obj.Set(name, v)
+	}
+	return &Package{
+		Name:   path.Base(pkgpath),
+		Path:   pkgpath,
+		Object: obj,
+	}
+}
+
+func (imp *Importer) loadFiles(pkgpath string) (*Package, error) {
+	files, err := imp.findFiles(pkgpath)
+	if err != nil {
+		return nil, err
+	}
+
+	name := path.Base(pkgpath)
+	scope := interpreter.NewScopeWithValues(imp.scope).Nest()
+	declarations := imp.declarations.Copy()
+	obj := values.NewObject()
+	for i, file := range files {
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		astProg, err := parser.NewAST(string(src))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse %q", file)
+		}
+		if astProg.Package != nil {
+			if i > 0 && astProg.Package.Name.Name != name {
+				return nil, fmt.Errorf("found packages %q and %q in %q", name, astProg.Package.Name.Name, pkgpath)
+			}
+			name = astProg.Package.Name.Name
+		}
+		// Imports are scoped to the file that declares them.
+		fileScope := scope.Nest()
+		fileDeclarations := declarations.Copy()
+		if err := imp.Import(astProg.Imports, fileScope, fileDeclarations); err != nil {
+			return nil, errors.Wrapf(err, "failed to import packages of %q", file)
+		}
+		if err := imp.evalMembers(astProg, fileScope, fileDeclarations, obj); err != nil {
+			return nil, errors.Wrapf(err, "failed to evaluate %q", file)
+		}
+		// Members of the package are visible to the remaining files of the package.
+		obj.Range(func(k string, v values.Value) {
+			scope.Set(k, v)
+			declarations[k] = semantic.NewExternalVariableDeclaration(k, v.Type())
+		})
+	}
+	return &Package{
+		Name:   name,
+		Path:   pkgpath,
+		Object: obj,
+	}, nil
+}
+
+// evalMembers evaluates the program and sets the top level variables as members of the package object.
+// Variables whose names begin with an underscore are private to the package.
+func (imp *Importer) evalMembers(astProg *ast.Program, scope *interpreter.Scope, declarations semantic.DeclarationScope, obj values.Object) error {
+	semProg, err := semantic.New(astProg, declarations)
+	if err != nil {
+		return err
+	}
+	if err := interpreter.Eval(semProg, scope); err != nil {
+		return err
+	}
+	for _, stmt := range semProg.Body {
+		decl, ok := stmt.(*semantic.NativeVariableDeclaration)
+		if !ok || strings.HasPrefix(decl.Identifier.Name, "_") {
+			continue
+		}
+		if v, ok := scope.Lookup(decl.Identifier.Name); ok {
+			obj.Set(decl.Identifier.Name, v)
+		}
+	}
+	return nil
+}
+
+// findFiles returns the IFQL files of the package in the first directory of the search path that contains it.
+// A package is either a single file named after the last element of its path or a directory of files.
+func (imp *Importer) findFiles(pkgpath string) ([]string, error) {
+	if pkgpath == "" || path.IsAbs(pkgpath) || path.Clean(pkgpath) != pkgpath || strings.HasPrefix(pkgpath, "..") {
+		return nil, fmt.Errorf("invalid import path %q", pkgpath)
+	}
+	for _, dir := range imp.searchPath {
+		base := filepath.Join(dir, filepath.FromSlash(pkgpath))
+		if fi, err := os.Stat(base + PackageFileExt); err == nil && !fi.IsDir() {
+			return []string{base + PackageFileExt}, nil
+		}
+		fi, err := os.Stat(base)
+		if err != nil || !fi.IsDir() {
+			continue
+		}
+		files, err := filepath.Glob(filepath.Join(base, "*"+PackageFileExt))
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			continue
+		}
+		sort.Strings(files)
+		return files, nil
+	}
+	return nil, fmt.Errorf("cannot find package %q", pkgpath)
+}
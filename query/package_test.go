@@ -0,0 +1,214 @@
+package query_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/interpreter"
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/semantic"
+	"github.com/influxdata/ifql/values"
+)
+
+func init() {
+	query.RegisterPackageValue("testing/consts", "db", values.NewStringValue("consts"))
+	query.RegisterPackageValue("testing/consts", "n", values.NewIntValue(5))
+}
+
+var packageFiles = map[string]string{
+	"mycompany/alerts.ifql": `
+package alerts
+
+import "testing/consts"
+
+db = "telegraf"
+crit = consts.n * 2
+_private = 1
+`,
+	"mycompany/sources.ifql": `telegraf = () => from(db: "telegraf")`,
+	"shared/a.ifql":          `x = 1`,
+	"shared/b.ifql":          `y = x + 1`,
+	"cycle/a.ifql": `import "cycle/b"
+a = 1`,
+	"cycle/b.ifql": `import "cycle/a"
+b = 1`,
+	"mixed/a.ifql": `package one
+a = 1`,
+	"mixed/b.ifql": `package two
+b = 1`,
+}
+
+// writePackageFiles writes the package files to a temporary directory and returns the directory.
+func writePackageFiles(t *testing.T) string {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "ifql-packages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, src := range packageFiles {
+		file := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(file, []byte(src), 0644); err != nil {
+			os.RemoveAll(dir)
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestCompile_Import(t *testing.T) {
+	dir := writePackageFiles(t)
+	defer os.RemoveAll(dir)
+
+	fromLimit := func(db string, n int64) *query.Spec {
+		return &query.Spec{
+			Operations: []*query.Operation{
+				{
+					ID:   "from0",
+					Spec: &functions.FromOpSpec{Database: db},
+				},
+				{
+					ID:   "limit1",
+					Spec: &functions.LimitOpSpec{N: n},
+				},
+			},
+			Edges: []query.Edge{
+				{Parent: "from0", Child: "limit1"},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		raw     string
+		want    *query.Spec
+		wantErr bool
+	}{
+		{
+			name: "builtin package",
+			raw: `import "testing/consts"
+from(db: consts.db) |> limit(n: consts.n)`,
+			want: fromLimit("consts", 5),
+		},
+		{
+			name: "file package",
+			raw: `import "mycompany/alerts"
+from(db: alerts.db) |> limit(n: alerts.crit)`,
+			want: fromLimit("telegraf", 10),
+		},
+		{
+			name: "package alias",
+			raw: `import a "mycompany/alerts"
+from(db: a.db) |> limit(n: a.crit)`,
+			want: fromLimit("telegraf", 10),
+		},
+		{
+			name: "directory package",
+			raw: `import "shared"
+from(db: "mydb") |> limit(n: shared.y)`,
+			want: fromLimit("mydb", 2),
+		},
+		{
+			name: "private member",
+			raw: `import "mycompany/alerts"
+from(db: "mydb") |> limit(n: alerts._private)`,
+			wantErr: true,
+		},
+		{
+			name: "imports are not transitive",
+			raw: `import "mycompany/alerts"
+from(db: consts.db)`,
+			wantErr: true,
+		},
+		{
+			name:    "missing package",
+			raw:     `import "mycompany/missing"`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid path",
+			raw:     `import "../alerts"`,
+			wantErr: true,
+		},
+		{
+			name:    "import cycle",
+			raw:     `import "cycle/a"`,
+			wantErr: true,
+		},
+		{
+			name:    "mixed package names",
+			raw:     `import "mixed"`,
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			raw: `import "mycompany/alerts"
+import alerts "shared"`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := query.Compile(context.Background(), tc.raw, query.SearchPath([]string{dir}))
+			if err != nil {
+				if tc.wantErr {
+					return
+				}
+				t.Fatal(err)
+			} else if tc.wantErr {
+				t.Fatal("expected error")
+			}
+			if !cmp.Equal(tc.want, got, cmpopts.IgnoreUnexported(query.Spec{})) {
+				t.Errorf("unexpected spec -want/+got:\n%s", cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(query.Spec{})))
+			}
+		})
+	}
+}
+
+func TestBuiltInsWithImporter(t *testing.T) {
+	dir := writePackageFiles(t)
+	defer os.RemoveAll(dir)
+
+	scope, declarations, importer := query.BuiltInsWithImporter([]string{dir})
+	interpScope := interpreter.NewScopeWithValues(scope)
+	astProg, err := parser.NewAST(`import "mycompany/sources"
+a = from(db: "mydb")
+b = sources.telegraf()`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := importer.Import(astProg.Imports, interpScope, declarations); err != nil {
+		t.Fatal(err)
+	}
+	semProg, err := semantic.New(astProg, declarations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := interpreter.Eval(semProg, interpScope); err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []query.OperationID
+	for _, name := range []string{"a", "b"} {
+		v, ok := interpScope.Lookup(name)
+		if !ok {
+			t.Fatalf("missing %q", name)
+		}
+		ids = append(ids, v.(query.TableObject).ID)
+	}
+	want := []query.OperationID{"from0", "from1"}
+	if !cmp.Equal(want, ids) {
+		t.Errorf("unexpected operation IDs -want/+got:\n%s", cmp.Diff(want, ids))
+	}
+}
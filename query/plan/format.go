@@ -1,8 +1,14 @@
 package plan
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/influxdata/ifql/query"
+)
 
-// TODO(nathanielc): Add better options for formatting plans as Graphviz dot format.
 type FormatOption func(*formatter)
 
 func Formatted(p PlanReader, opts ...FormatOption) fmt.Formatter {
@@ -21,14 +27,51 @@ func UseIDs() FormatOption {
 	}
 }
 
+// FmtDOT formats the plan in the Graphviz DOT language.
+// Each procedure is labeled with its kind and the fields of its spec, including any pushed down procedures,
+// the graph with the bounds and the resources of the plan.
+func FmtDOT() FormatOption {
+	return func(f *formatter) {
+		f.dot = true
+	}
+}
+
+// FmtDOTCluster formats the plan in the Graphviz DOT language as a cluster subgraph, to be embedded in another graph.
+// The IDs of the nodes are prefixed with the name of the cluster, so they are unique across clusters.
+func FmtDOTCluster(name string) FormatOption {
+	return func(f *formatter) {
+		f.dot = true
+		f.cluster = name
+	}
+}
+
+// FmtJSON formats the plan as indented JSON.
+func FmtJSON() FormatOption {
+	return func(f *formatter) {
+		f.json = true
+	}
+}
+
+// Annotate adds the lines returned by annotate to the label of each procedure, when formatting as DOT.
+func Annotate(annotate func(pr *Procedure) []string) FormatOption {
+	return func(f *formatter) {
+		f.annotate = annotate
+	}
+}
+
 type PlanReader interface {
 	Do(func(*Procedure))
 	lookup(id ProcedureID) *Procedure
 }
 
 type formatter struct {
-	p      PlanReader
-	useIDs bool
+	p       PlanReader
+	useIDs  bool
+	dot     bool
+	json    bool
+	cluster string
+
+	annotate func(pr *Procedure) []string
 }
 
 func (f formatter) Format(fs fmt.State, c rune) {
@@ -36,7 +79,20 @@ func (f formatter) Format(fs fmt.State, c rune) {
 		fmt.Fprintf(fs, "%#v", f.p)
 		return
 	}
-	f.format(fs)
+	switch {
+	case f.json:
+		f.formatJSON(fs)
+	case f.dot:
+		f.formatDOT(fs)
+	default:
+		f.format(fs)
+	}
+}
+
+func (f formatter) formatJSON(fs fmt.State) {
+	e := json.NewEncoder(fs)
+	e.SetIndent("", "  ")
+	e.Encode(f.p)
 }
 
 func (f formatter) format(fs fmt.State) {
@@ -62,3 +118,62 @@ func (f formatter) format(fs fmt.State) {
 	})
 	fmt.Fprintln(fs, "}")
 }
+
+func (f formatter) formatDOT(fs fmt.State) {
+	var (
+		name    string
+		details []string
+		results map[string]YieldSpec
+	)
+	switch p := f.p.(type) {
+	case *LogicalPlanSpec:
+		name = "LogicalPlanSpec"
+		details = query.SpecDetails(struct {
+			Resources query.ResourceManagement `json:"resources"`
+		}{p.Resources})
+	case *PlanSpec:
+		name = "PlanSpec"
+		details = query.SpecDetails(struct {
+			Bounds    BoundsSpec               `json:"bounds"`
+			Resources query.ResourceManagement `json:"resources"`
+		}{p.Bounds, p.Resources})
+		if !p.Now.IsZero() {
+			details = append([]string{"now=" + p.Now.Format(time.RFC3339Nano)}, details...)
+		}
+		results = p.Results
+	default:
+		name = "Plan"
+	}
+
+	if f.cluster != "" {
+		fmt.Fprintf(fs, "subgraph %s {\n", query.DOTID("cluster_"+f.cluster))
+	} else {
+		fmt.Fprintf(fs, "digraph %s {\n", name)
+	}
+	fmt.Fprintf(fs, "label=%s;\nlabeljust=l;\n", query.DOTLabel(name, details))
+	fmt.Fprint(fs, "node [shape=box];\n")
+	f.p.Do(func(pr *Procedure) {
+		id := query.DOTClusterID(f.cluster, pr.ID.String())
+		details := append([]string{"id=" + pr.ID.String()}, query.SpecDetails(pr.Spec)...)
+		if f.annotate != nil {
+			details = append(details, f.annotate(pr)...)
+		}
+		fmt.Fprintf(fs, "%s [label=%s];\n", id, query.DOTLabel(string(pr.Spec.Kind()), details))
+		for _, child := range pr.Children {
+			fmt.Fprintf(fs, "%s -> %s;\n", id, query.DOTClusterID(f.cluster, child.String()))
+		}
+	})
+
+	// Results are drawn as the sinks of the graph.
+	names := make([]string, 0, len(results))
+	for n := range results {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	for _, n := range names {
+		rid := query.DOTClusterID(f.cluster, "result:"+n)
+		fmt.Fprintf(fs, "%s [shape=ellipse, label=%s];\n", rid, query.DOTID(n))
+		fmt.Fprintf(fs, "%s -> %s;\n", query.DOTClusterID(f.cluster, results[n].ID.String()), rid)
+	}
+	fmt.Fprintln(fs, "}")
+}
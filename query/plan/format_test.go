@@ -0,0 +1,82 @@
+package plan_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/influxdata/ifql/functions"
+	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/plan"
+)
+
+func TestFormatted_DOT(t *testing.T) {
+	from := plan.ProcedureIDFromOperationID("from")
+	limit := plan.ProcedureIDFromOperationID("limit")
+	p := &plan.PlanSpec{
+		Now: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+		Bounds: plan.BoundsSpec{
+			Start: query.Time{IsRelative: true, Relative: -1 * time.Hour},
+			Stop:  query.Now,
+		},
+		Procedures: map[plan.ProcedureID]*plan.Procedure{
+			from: {
+				ID: from,
+				Spec: &functions.FromProcedureSpec{
+					Database:  "mydb",
+					BoundsSet: true,
+					Bounds: plan.BoundsSpec{
+						Start: query.Time{IsRelative: true, Relative: -1 * time.Hour},
+						Stop:  query.Now,
+					},
+				},
+				Children: []plan.ProcedureID{limit},
+			},
+			limit: {
+				ID:      limit,
+				Spec:    &functions.LimitProcedureSpec{N: 10},
+				Parents: []plan.ProcedureID{from},
+			},
+		},
+		Order: []plan.ProcedureID{from, limit},
+		Results: map[string]plan.YieldSpec{
+			plan.DefaultYieldName: {ID: limit},
+		},
+		Resources: query.ResourceManagement{
+			ConcurrencyQuota: 2,
+			MemoryBytesQuota: 1024,
+		},
+	}
+	annotate := plan.Annotate(func(pr *plan.Procedure) []string {
+		return []string{fmt.Sprintf("children: %d", len(pr.Children))}
+	})
+
+	want := fmt.Sprintf(`digraph PlanSpec {
+label="PlanSpec\lnow=2018-01-01T00:00:00Z\lbounds.start=\"-1h0m0s\"\lbounds.stop=\"now\"\lresources.concurrency_quota=2\lresources.memory_bytes_quota=1024\lresources.priority=\"high\"\l";
+labeljust=l;
+node [shape=box];
+"%[1]s" [label="from\lid=%[1]s\lBounds.start=\"-1h0m0s\"\lBounds.stop=\"now\"\lBoundsSet=true\lDatabase=\"mydb\"\lchildren: 1\l"];
+"%[1]s" -> "%[2]s";
+"%[2]s" [label="limit\lid=%[2]s\ln=10\lchildren: 0\l"];
+"result:_result" [shape=ellipse, label="_result"];
+"%[2]s" -> "result:_result";
+}
+`, from, limit)
+	if got := fmt.Sprint(plan.Formatted(p, plan.FmtDOT(), annotate)); got != want {
+		t.Errorf("unexpected DOT format:\n%s\nwant:\n%s", got, want)
+	}
+	wantCluster := fmt.Sprintf(`subgraph "cluster_physical" {
+label="PlanSpec\lnow=2018-01-01T00:00:00Z\lbounds.start=\"-1h0m0s\"\lbounds.stop=\"now\"\lresources.concurrency_quota=2\lresources.memory_bytes_quota=1024\lresources.priority=\"high\"\l";
+labeljust=l;
+node [shape=box];
+"physical:%[1]s" [label="from\lid=%[1]s\lBounds.start=\"-1h0m0s\"\lBounds.stop=\"now\"\lBoundsSet=true\lDatabase=\"mydb\"\l"];
+"physical:%[1]s" -> "physical:%[2]s";
+"physical:%[2]s" [label="limit\lid=%[2]s\ln=10\l"];
+"physical:result:_result" [shape=ellipse, label="_result"];
+"physical:%[2]s" -> "physical:result:_result";
+}
+`, from, limit)
+	if got := fmt.Sprint(plan.Formatted(p, plan.FmtDOTCluster("physical"))); got != wantCluster {
+		t.Errorf("unexpected DOT cluster format:\n%s\nwant:\n%s", got, wantCluster)
+	}
+}
@@ -11,9 +11,24 @@ var NilUUID uuid.UUID
 var RootUUID = NilUUID
 
 type LogicalPlanSpec struct {
-	Procedures map[ProcedureID]*Procedure
-	Order      []ProcedureID
-	Resources  query.ResourceManagement
+	Procedures map[ProcedureID]*Procedure `json:"procedures"`
+	Order      []ProcedureID              `json:"order"`
+	Resources  query.ResourceManagement   `json:"resources"`
+}
+
+// Copy returns a deep copy of the logical plan.
+// The physical planner modifies the procedures of the logical plan, a copy retains the plan as it was.
+func (lp *LogicalPlanSpec) Copy() *LogicalPlanSpec {
+	np := &LogicalPlanSpec{
+		Procedures: make(map[ProcedureID]*Procedure, len(lp.Procedures)),
+		Order:      make([]ProcedureID, len(lp.Order)),
+		Resources:  lp.Resources,
+	}
+	for id, pr := range lp.Procedures {
+		np.Procedures[id] = pr.Copy()
+	}
+	copy(np.Order, lp.Order)
+	return np
 }
 
 func (lp *LogicalPlanSpec) Do(f func(pr *Procedure)) {
@@ -1,11 +1,13 @@
 package plan_test
 
 import (
+	"encoding/json"
 	"strconv"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
 	"github.com/influxdata/ifql/query/plan"
@@ -183,6 +185,84 @@ func TestLogicalPlanner_Plan(t *testing.T) {
 	}
 }
 
+func TestLogicalPlanSpec_Copy(t *testing.T) {
+	q := &query.Spec{
+		Operations: []*query.Operation{
+			{
+				ID: "from",
+				Spec: &functions.FromOpSpec{
+					Database: "mydb",
+				},
+			},
+			{
+				ID: "range",
+				Spec: &functions.RangeOpSpec{
+					Start: query.Time{IsRelative: true, Relative: -1 * time.Hour},
+					Stop:  query.Now,
+				},
+			},
+			{
+				ID:   "count",
+				Spec: &functions.CountOpSpec{},
+			},
+		},
+		Edges: []query.Edge{
+			{Parent: "from", Child: "range"},
+			{Parent: "range", Child: "count"},
+		},
+	}
+	want, err := plan.NewLogicalPlanner().Plan(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lp, err := plan.NewLogicalPlanner().Plan(q)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := lp.Copy()
+
+	// The physical planner pushes the range and the count into the from, the copy must not change.
+	if _, err := plan.NewPlanner().Plan(lp, nil, time.Now()); err != nil {
+		t.Fatal(err)
+	}
+	opts := append(plantest.CmpOptions, cmpopts.EquateEmpty())
+	if !cmp.Equal(got, want, opts...) {
+		t.Errorf("unexpected logical plan -want/+got:\n%s", cmp.Diff(want, got, opts...))
+	}
+}
+
+func TestLogicalPlanSpec_JSON(t *testing.T) {
+	lp, err := plan.NewLogicalPlanner().Plan(benchmarkQuery)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(lp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Procedures map[plan.ProcedureID]struct {
+			ID       plan.ProcedureID   `json:"id"`
+			Kind     plan.ProcedureKind `json:"kind"`
+			Parents  []plan.ProcedureID `json:"parents"`
+			Children []plan.ProcedureID `json:"children"`
+		} `json:"procedures"`
+		Order []plan.ProcedureID `json:"order"`
+	}
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(got.Order, lp.Order) {
+		t.Errorf("unexpected order -want/+got:\n%s", cmp.Diff(lp.Order, got.Order))
+	}
+	for id, pr := range lp.Procedures {
+		g := got.Procedures[id]
+		if g.ID != pr.ID || g.Kind != pr.Spec.Kind() || !cmp.Equal(g.Parents, pr.Parents) || !cmp.Equal(g.Children, pr.Children) {
+			t.Errorf("unexpected procedure %v: got %s %v parents %v children %v", id, g.Kind, g.ID, g.Parents, g.Children)
+		}
+	}
+}
+
 var benchmarkQuery = &query.Spec{
 	Operations: []*query.Operation{
 		{
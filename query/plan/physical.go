@@ -15,20 +15,20 @@ const DefaultYieldName = "_result"
 
 type PlanSpec struct {
 	// Now represents the relative currentl time of the plan.
-	Now    time.Time
-	Bounds BoundsSpec
+	Now    time.Time  `json:"now"`
+	Bounds BoundsSpec `json:"bounds"`
 	// Procedures is a set of all operations
-	Procedures map[ProcedureID]*Procedure
-	Order      []ProcedureID
+	Procedures map[ProcedureID]*Procedure `json:"procedures"`
+	Order      []ProcedureID              `json:"order"`
 	// Results is a list of datasets that are the result of the plan
-	Results map[string]YieldSpec
+	Results map[string]YieldSpec `json:"results"`
 
-	Resources query.ResourceManagement
+	Resources query.ResourceManagement `json:"resources"`
 }
 
 // YieldSpec defines how data should be yielded.
 type YieldSpec struct {
-	ID ProcedureID
+	ID ProcedureID `json:"id"`
 }
 
 func (p *PlanSpec) Do(f func(pr *Procedure)) {
@@ -48,6 +48,8 @@ type Planner interface {
 type PlanRewriter interface {
 	IsolatePath(parent, child *Procedure) (*Procedure, error)
 	RemoveBranch(pr *Procedure) error
+	// RemoveProcedure removes the procedure, connecting its children to its parent.
+	RemoveProcedure(pr *Procedure) error
 	AddChild(parent *Procedure, childSpec ProcedureSpec)
 }
 
@@ -246,6 +248,10 @@ func (p *planner) AddChild(parent *Procedure, childSpec ProcedureSpec) {
 	p.plan.Order = insertAfter(p.plan.Order, parent.ID, child.ID)
 }
 
+func (p *planner) RemoveProcedure(pr *Procedure) error {
+	return p.removeProcedure(pr)
+}
+
 func (p *planner) removeProcedure(pr *Procedure) error {
 	// It only makes sense to remove a procedure that has a single parent.
 	if len(pr.Parents) > 1 {
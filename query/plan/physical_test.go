@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/influxdata/ifql/functions"
 	"github.com/influxdata/ifql/query"
+	"github.com/influxdata/ifql/query/execute"
 	"github.com/influxdata/ifql/query/plan"
 	"github.com/influxdata/ifql/query/plan/plantest"
 )
@@ -794,6 +795,451 @@ func TestPhysicalPlanner_Plan(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "sort with limit",
+			lp: &plan.LogicalPlanSpec{
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 1,
+					MemoryBytesQuota: 10000,
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("sort")},
+					},
+					plan.ProcedureIDFromOperationID("sort"): {
+						ID: plan.ProcedureIDFromOperationID("sort"),
+						Spec: &functions.SortProcedureSpec{
+							Cols: []string{"_value"},
+							Desc: true,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("limit")},
+					},
+					plan.ProcedureIDFromOperationID("limit"): {
+						ID: plan.ProcedureIDFromOperationID("limit"),
+						Spec: &functions.LimitProcedureSpec{
+							N: 5,
+						},
+						Parents: []plan.ProcedureID{plan.ProcedureIDFromOperationID("sort")},
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("sort"),
+					plan.ProcedureIDFromOperationID("limit"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 1,
+					MemoryBytesQuota: 10000,
+				},
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("sort")},
+					},
+					plan.ProcedureIDFromOperationID("sort"): {
+						ID: plan.ProcedureIDFromOperationID("sort"),
+						Spec: &functions.TopNProcedureSpec{
+							N:    5,
+							Cols: []string{"_value"},
+							Desc: true,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{},
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					"_result": {ID: plan.ProcedureIDFromOperationID("sort")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("sort"),
+				},
+			},
+		},
+		{
+			name: "window aggregate push down",
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration(time.Minute),
+								Period: query.Duration(time.Minute),
+							},
+							Triggering:    query.DefaultTrigger,
+							TimeCol:       "_time",
+							StartColLabel: "_start",
+							StopColLabel:  "_stop",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("sum")},
+					},
+					plan.ProcedureIDFromOperationID("sum"): {
+						ID: plan.ProcedureIDFromOperationID("sum"),
+						Spec: &functions.SumProcedureSpec{
+							AggregateConfig: execute.DefaultAggregateConfig,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("sum"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 1,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+							WindowSet: true,
+							Window: plan.WindowSpec{
+								Every:  query.Duration(time.Minute),
+								Period: query.Duration(time.Minute),
+							},
+							AggregateSet:    true,
+							AggregateMethod: "sum",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{},
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("from")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+				},
+			},
+		},
+		{
+			name: "window aggregate not pushed down with other columns",
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration(time.Minute),
+								Period: query.Duration(time.Minute),
+							},
+							Triggering:    query.DefaultTrigger,
+							TimeCol:       "_time",
+							StartColLabel: "_start",
+							StopColLabel:  "_stop",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("sum")},
+					},
+					plan.ProcedureIDFromOperationID("sum"): {
+						ID: plan.ProcedureIDFromOperationID("sum"),
+						Spec: &functions.SumProcedureSpec{
+							AggregateConfig: execute.AggregateConfig{
+								Columns: []string{"other"},
+								TimeSrc: "_stop",
+								TimeDst: "_time",
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("sum"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 3,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration(time.Minute),
+								Period: query.Duration(time.Minute),
+							},
+							Triggering:    query.DefaultTrigger,
+							TimeCol:       "_time",
+							StartColLabel: "_start",
+							StopColLabel:  "_stop",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("sum")},
+					},
+					plan.ProcedureIDFromOperationID("sum"): {
+						ID: plan.ProcedureIDFromOperationID("sum"),
+						Spec: &functions.SumProcedureSpec{
+							AggregateConfig: execute.AggregateConfig{
+								Columns: []string{"other"},
+								TimeSrc: "_stop",
+								TimeDst: "_time",
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("sum")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("sum"),
+				},
+			},
+		},
+		{
+			name: "window aggregate not pushed down for unsupported method",
+			lp: &plan.LogicalPlanSpec{
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database: "mydb",
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+					},
+					plan.ProcedureIDFromOperationID("range"): {
+						ID: plan.ProcedureIDFromOperationID("range"),
+						Spec: &functions.RangeProcedureSpec{
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration(time.Minute),
+								Period: query.Duration(time.Minute),
+							},
+							Triggering:    query.DefaultTrigger,
+							TimeCol:       "_time",
+							StartColLabel: "_start",
+							StopColLabel:  "_stop",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("range")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("mean")},
+					},
+					plan.ProcedureIDFromOperationID("mean"): {
+						ID: plan.ProcedureIDFromOperationID("mean"),
+						Spec: &functions.MeanProcedureSpec{
+							AggregateConfig: execute.DefaultAggregateConfig,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("range"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("mean"),
+				},
+			},
+			pp: &plan.PlanSpec{
+				Resources: query.ResourceManagement{
+					ConcurrencyQuota: 3,
+					MemoryBytesQuota: math.MaxInt64,
+				},
+				Now: time.Date(2017, 8, 8, 0, 0, 0, 0, time.UTC),
+				Bounds: plan.BoundsSpec{
+					Start: query.Time{
+						IsRelative: true,
+						Relative:   -1 * time.Hour,
+					},
+				},
+				Procedures: map[plan.ProcedureID]*plan.Procedure{
+					plan.ProcedureIDFromOperationID("from"): {
+						ID: plan.ProcedureIDFromOperationID("from"),
+						Spec: &functions.FromProcedureSpec{
+							Database:  "mydb",
+							BoundsSet: true,
+							Bounds: plan.BoundsSpec{
+								Start: query.Time{
+									IsRelative: true,
+									Relative:   -1 * time.Hour,
+								},
+							},
+						},
+						Parents:  nil,
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+					},
+					plan.ProcedureIDFromOperationID("window"): {
+						ID: plan.ProcedureIDFromOperationID("window"),
+						Spec: &functions.WindowProcedureSpec{
+							Window: plan.WindowSpec{
+								Every:  query.Duration(time.Minute),
+								Period: query.Duration(time.Minute),
+							},
+							Triggering:    query.DefaultTrigger,
+							TimeCol:       "_time",
+							StartColLabel: "_start",
+							StopColLabel:  "_stop",
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("from")},
+						Children: []plan.ProcedureID{plan.ProcedureIDFromOperationID("mean")},
+					},
+					plan.ProcedureIDFromOperationID("mean"): {
+						ID: plan.ProcedureIDFromOperationID("mean"),
+						Spec: &functions.MeanProcedureSpec{
+							AggregateConfig: execute.DefaultAggregateConfig,
+						},
+						Parents:  []plan.ProcedureID{plan.ProcedureIDFromOperationID("window")},
+						Children: nil,
+					},
+				},
+				Results: map[string]plan.YieldSpec{
+					plan.DefaultYieldName: {ID: plan.ProcedureIDFromOperationID("mean")},
+				},
+				Order: []plan.ProcedureID{
+					plan.ProcedureIDFromOperationID("from"),
+					plan.ProcedureIDFromOperationID("window"),
+					plan.ProcedureIDFromOperationID("mean"),
+				},
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
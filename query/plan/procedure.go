@@ -1,6 +1,7 @@
 package plan
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -14,6 +15,14 @@ func (id ProcedureID) String() string {
 	return uuid.UUID(id).String()
 }
 
+func (id ProcedureID) MarshalText() ([]byte, error) {
+	return uuid.UUID(id).MarshalText()
+}
+
+func (id *ProcedureID) UnmarshalText(text []byte) error {
+	return (*uuid.UUID)(id).UnmarshalText(text)
+}
+
 var ZeroProcedureID ProcedureID
 
 type Procedure struct {
@@ -24,6 +33,23 @@ type Procedure struct {
 	Spec     ProcedureSpec
 }
 
+// MarshalJSON encodes the procedure with the kind of its spec, so that plans can be explained.
+func (p *Procedure) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		ID       ProcedureID   `json:"id"`
+		Kind     ProcedureKind `json:"kind"`
+		Parents  []ProcedureID `json:"parents"`
+		Children []ProcedureID `json:"children"`
+		Spec     ProcedureSpec `json:"spec"`
+	}{
+		ID:       p.ID,
+		Kind:     p.Spec.Kind(),
+		Parents:  p.Parents,
+		Children: p.Children,
+		Spec:     p.Spec,
+	})
+}
+
 func (p *Procedure) Copy() *Procedure {
 	np := new(Procedure)
 	np.ID = p.ID
@@ -102,8 +128,8 @@ type PushDownRule struct {
 type ProcedureKind string
 
 type BoundsSpec struct {
-	Start query.Time
-	Stop  query.Time
+	Start query.Time `json:"start"`
+	Stop  query.Time `json:"stop"`
 }
 
 func (b BoundsSpec) Union(o BoundsSpec, now time.Time) (u BoundsSpec) {
@@ -3,6 +3,7 @@ package query
 import (
 	"errors"
 	"fmt"
+	"time"
 )
 
 // Spec specifies a query.
@@ -10,6 +11,11 @@ type Spec struct {
 	Operations []*Operation       `json:"operations"`
 	Edges      []Edge             `json:"edges"`
 	Resources  ResourceManagement `json:"resources"`
+	// Now is the time the query is relative to.
+	// The zero value means the query is relative to the time it is started.
+	Now time.Time `json:"now"`
+	// Options are the values of option statements that do not configure the spec itself.
+	Options map[string]interface{} `json:"options,omitempty"`
 
 	sorted   []*Operation
 	children map[OperationID][]*Operation
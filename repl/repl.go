@@ -32,6 +32,7 @@ type REPL struct {
 
 	scope        *interpreter.Scope
 	declarations semantic.DeclarationScope
+	importer     *query.Importer
 	c            *control.Controller
 
 	cancelMu   sync.Mutex
@@ -55,13 +56,14 @@ func addBuiltIn(script string, scope *interpreter.Scope, declarations semantic.D
 }
 
 func New(c *control.Controller, orgID id.ID) *REPL {
-	scope, declarations := query.BuiltIns()
+	scope, declarations, importer := query.BuiltInsWithImporter(c.SearchPath())
 	interpScope := interpreter.NewScopeWithValues(scope)
 	addBuiltIn("run = () => yield(table:_)", interpScope, declarations)
 	return &REPL{
 		orgID:        orgID,
 		scope:        interpScope,
 		declarations: declarations,
+		importer:     importer,
 		c:            c,
 	}
 }
@@ -160,8 +162,13 @@ func (r *REPL) executeLine(t string, expectYield bool) (values.Value, error) {
 		t = q
 	}
 
-	astProg, err := parser.NewAST(t)
-	if err != nil {
+	astProg, diagnostics := parser.NewASTWithDiagnostics(t)
+	if len(diagnostics) > 0 {
+		return nil, diagnostics
+	}
+
+	// Imported packages remain in scope for the rest of the session.
+	if err := r.importer.Import(astProg.Imports, r.scope, r.declarations); err != nil {
 		return nil, err
 	}
 
@@ -181,6 +188,9 @@ func (r *REPL) executeLine(t string, expectYield bool) (values.Value, error) {
 		t := v.(query.TableObject)
 		if !expectYield || (expectYield && t.Kind == functions.YieldKind) {
 			spec := t.ToSpec()
+			if err := spec.ApplyOptions(r.scope.Options()); err != nil {
+				return nil, err
+			}
 			// Do query
 			return nil, r.doQuery(spec)
 		}
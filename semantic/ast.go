@@ -0,0 +1,185 @@
+package semantic
+
+import (
+	"github.com/influxdata/ifql/ast"
+)
+
+// ToAST converts the semantic graph back into an abstract syntax tree.
+// External variable declarations have no syntax and are omitted,
+// a piped argument of a call becomes a named argument.
+func ToAST(n Node) ast.Node {
+	switch n := n.(type) {
+	case *Program:
+		p := new(ast.Program)
+		for _, s := range n.Body {
+			if s := toASTStatement(s); s != nil {
+				p.Body = append(p.Body, s)
+			}
+		}
+		return p
+	case Statement:
+		return toASTStatement(n)
+	case Expression:
+		return toASTExpression(n)
+	}
+	return nil
+}
+
+func toASTStatement(s Statement) ast.Statement {
+	switch s := s.(type) {
+	case *BlockStatement:
+		return toASTBlock(s)
+	case *ExpressionStatement:
+		return &ast.ExpressionStatement{
+			Expression: toASTExpression(s.Expression),
+		}
+	case *OptionStatement:
+		return &ast.OptionStatement{
+			Declaration: &ast.VariableDeclarator{
+				ID:   &ast.Identifier{Name: s.Declaration.Identifier.Name},
+				Init: toASTExpression(s.Declaration.Init),
+			},
+		}
+	case *ReturnStatement:
+		return &ast.ReturnStatement{
+			Argument: toASTExpression(s.Argument),
+		}
+	case *NativeVariableDeclaration:
+		return &ast.VariableDeclaration{
+			Declarations: []*ast.VariableDeclarator{{
+				ID:   &ast.Identifier{Name: s.Identifier.Name},
+				Init: toASTExpression(s.Init),
+			}},
+		}
+	}
+	return nil
+}
+
+func toASTBlock(b *BlockStatement) *ast.BlockStatement {
+	block := new(ast.BlockStatement)
+	for _, s := range b.Body {
+		if s := toASTStatement(s); s != nil {
+			block.Body = append(block.Body, s)
+		}
+	}
+	return block
+}
+
+func toASTExpression(e Expression) ast.Expression {
+	switch e := e.(type) {
+	case *ArrayExpression:
+		array := &ast.ArrayExpression{
+			Elements: make([]ast.Expression, len(e.Elements)),
+		}
+		for i, el := range e.Elements {
+			array.Elements[i] = toASTExpression(el)
+		}
+		return array
+	case *FunctionExpression:
+		f := &ast.ArrowFunctionExpression{
+			Params: make([]*ast.Property, len(e.Params)),
+		}
+		for i, p := range e.Params {
+			param := &ast.Property{
+				Key: &ast.Identifier{Name: p.Key.Name},
+			}
+			if p.Piped {
+				param.Value = &ast.PipeLiteral{}
+			} else if p.Default != nil {
+				param.Value = toASTExpression(p.Default)
+			}
+			f.Params[i] = param
+		}
+		switch body := e.Body.(type) {
+		case *BlockStatement:
+			f.Body = toASTBlock(body)
+		case Expression:
+			f.Body = toASTExpression(body)
+		}
+		return f
+	case *BinaryExpression:
+		return &ast.BinaryExpression{
+			Operator: e.Operator,
+			Left:     toASTExpression(e.Left),
+			Right:    toASTExpression(e.Right),
+		}
+	case *CallExpression:
+		call := &ast.CallExpression{
+			Callee: toASTExpression(e.Callee),
+		}
+		if e.Arguments != nil && len(e.Arguments.Properties) > 0 {
+			call.Arguments = []ast.Expression{toASTExpression(e.Arguments)}
+		}
+		return call
+	case *ConditionalExpression:
+		return &ast.ConditionalExpression{
+			Test:       toASTExpression(e.Test),
+			Consequent: toASTExpression(e.Consequent),
+			Alternate:  toASTExpression(e.Alternate),
+		}
+	case *LogicalExpression:
+		return &ast.LogicalExpression{
+			Operator: e.Operator,
+			Left:     toASTExpression(e.Left),
+			Right:    toASTExpression(e.Right),
+		}
+	case *MemberExpression:
+		m := &ast.MemberExpression{
+			Object: toASTExpression(e.Object),
+		}
+		if ast.IsIdentifier(e.Property) {
+			m.Property = &ast.Identifier{Name: e.Property}
+		} else {
+			m.Property = &ast.StringLiteral{Value: e.Property}
+		}
+		return m
+	case *ObjectExpression:
+		obj := &ast.ObjectExpression{
+			Properties: make([]*ast.Property, len(e.Properties)),
+		}
+		for i, p := range e.Properties {
+			obj.Properties[i] = &ast.Property{
+				Key:   &ast.Identifier{Name: p.Key.Name},
+				Value: toASTExpression(p.Value),
+			}
+		}
+		return obj
+	case *StringExpression:
+		str := &ast.StringExpression{
+			Parts: make([]ast.StringExpressionPart, len(e.Parts)),
+		}
+		for i, p := range e.Parts {
+			switch p := p.(type) {
+			case *TextPart:
+				str.Parts[i] = &ast.TextPart{Value: p.Value}
+			case *InterpolatedPart:
+				str.Parts[i] = &ast.InterpolatedPart{Expression: toASTExpression(p.Expression)}
+			}
+		}
+		return str
+	case *UnaryExpression:
+		return &ast.UnaryExpression{
+			Operator: e.Operator,
+			Argument: toASTExpression(e.Argument),
+		}
+	case *IdentifierExpression:
+		return &ast.Identifier{Name: e.Name}
+	case *BooleanLiteral:
+		return &ast.BooleanLiteral{Value: e.Value}
+	case *DateTimeLiteral:
+		return &ast.DateTimeLiteral{Value: e.Value}
+	case *DurationLiteral:
+		return &ast.DurationLiteral{Value: e.Value}
+	case *FloatLiteral:
+		return &ast.FloatLiteral{Value: e.Value}
+	case *IntegerLiteral:
+		return &ast.IntegerLiteral{Value: e.Value}
+	case *RegexpLiteral:
+		return &ast.RegexpLiteral{Value: e.Value}
+	case *StringLiteral:
+		return &ast.StringLiteral{Value: e.Value}
+	case *UnsignedIntegerLiteral:
+		return &ast.UnsignedIntegerLiteral{Value: e.Value}
+	}
+	return nil
+}
@@ -7,6 +7,16 @@ type binarySignature struct {
 	left, right Kind
 }
 
+// BinaryType returns the type of a binary expression whose operands are of the left and right kinds.
+// The type is Invalid if the operator is not defined for the kinds.
+func BinaryType(operator ast.OperatorKind, left, right Kind) Type {
+	return binaryTypesLookup[binarySignature{
+		operator: operator,
+		left:     left,
+		right:    right,
+	}]
+}
+
 var binaryTypesLookup = map[binarySignature]Kind{
 	//---------------
 	// Math Operators
@@ -117,4 +127,15 @@ var binaryTypesLookup = map[binarySignature]Kind{
 	{operator: ast.NotRegexpMatchOperator, left: Regexp, right: String}: Bool,
 
 	{operator: ast.AdditionOperator, left: String, right: String}: String,
+
+	//---------------
+	// Array Operators
+	//---------------
+
+	// InOperator
+
+	{operator: ast.InOperator, left: Int, right: Array}:    Bool,
+	{operator: ast.InOperator, left: UInt, right: Array}:   Bool,
+	{operator: ast.InOperator, left: Float, right: Array}:  Bool,
+	{operator: ast.InOperator, left: String, right: Array}: Bool,
 }
@@ -0,0 +1,97 @@
+package semantic
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// formatVerb is a verb of a printf style format that formats an argument.
+type formatVerb struct {
+	verb rune
+	// kinds are the kinds of values the verb can format, any kind if empty.
+	kinds []Kind
+}
+
+// formatVerbKinds are the verbs supported in formats and the kinds of values they can format.
+var formatVerbKinds = map[rune][]Kind{
+	'v': nil,
+	's': {String, Time, Duration, Regexp},
+	'q': {String, Time, Duration, Regexp},
+	'd': {Int, UInt},
+	'b': {Int, UInt},
+	'o': {Int, UInt},
+	'c': {Int, UInt},
+	'x': {Int, UInt, String},
+	'X': {Int, UInt, String},
+	'e': {Float},
+	'E': {Float},
+	'f': {Float},
+	'F': {Float},
+	'g': {Float},
+	'G': {Float},
+	't': {Bool},
+}
+
+// parseFormat returns the verbs of the printf style format that format an argument, in order.
+// Flags, width and precision are allowed, argument indexes and * widths are not.
+func parseFormat(format string) ([]formatVerb, error) {
+	var verbs []formatVerb
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+		if i < len(format) && format[i] == '%' {
+			continue
+		}
+		for i < len(format) && strings.IndexByte("+-# 0123456789.", format[i]) >= 0 {
+			i++
+		}
+		if i == len(format) {
+			return nil, fmt.Errorf("format %q ends with an incomplete verb", format)
+		}
+		if format[i] == '*' || format[i] == '[' {
+			return nil, fmt.Errorf("format %q uses %q, argument indexes and * widths are not supported", format, format[i])
+		}
+		verb, size := utf8.DecodeRuneInString(format[i:])
+		kinds, ok := formatVerbKinds[verb]
+		if !ok {
+			return nil, fmt.Errorf("format %q has unknown verb %%%c", format, verb)
+		}
+		verbs = append(verbs, formatVerb{verb: verb, kinds: kinds})
+		i += size - 1
+	}
+	return verbs, nil
+}
+
+// accepts reports whether the verb can format a value of kind k.
+// Null values are accepted by any verb.
+func (v formatVerb) accepts(k Kind) bool {
+	if len(v.kinds) == 0 || k == Nil {
+		return true
+	}
+	for _, kind := range v.kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckFormat checks that the printf style format has a verb for each argument that can format the kind of the argument.
+func CheckFormat(format string, args []Kind) error {
+	verbs, err := parseFormat(format)
+	if err != nil {
+		return err
+	}
+	if len(verbs) != len(args) {
+		return fmt.Errorf("format %q expects %d arguments, got %d", format, len(verbs), len(args))
+	}
+	for i, v := range verbs {
+		if !v.accepts(args[i]) {
+			return fmt.Errorf("verb %%%c of format %q cannot format argument %d of kind %v", v.verb, format, i, args[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package semantic_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/semantic"
+)
+
+func TestCheckFormat(t *testing.T) {
+	testCases := []struct {
+		format  string
+		args    []semantic.Kind
+		wantErr string
+	}{
+		{
+			format: "100%%",
+		},
+		{
+			format: "%s=%.2f %+5d %-x %t",
+			args:   []semantic.Kind{semantic.String, semantic.Float, semantic.Int, semantic.String, semantic.Bool},
+		},
+		{
+			format: "%v %v %s",
+			args:   []semantic.Kind{semantic.Array, semantic.Object, semantic.Time},
+		},
+		{
+			format: "%d",
+			args:   []semantic.Kind{semantic.Nil},
+		},
+		{
+			format:  "%d",
+			args:    []semantic.Kind{semantic.Float},
+			wantErr: `verb %d of format "%d" cannot format argument 0 of kind float`,
+		},
+		{
+			format:  "%s",
+			wantErr: `format "%s" expects 1 arguments, got 0`,
+		},
+		{
+			format:  "%5",
+			args:    []semantic.Kind{semantic.Int},
+			wantErr: `format "%5" ends with an incomplete verb`,
+		},
+		{
+			format:  "%*d",
+			args:    []semantic.Kind{semantic.Int, semantic.Int},
+			wantErr: `format "%*d" uses '*', argument indexes and * widths are not supported`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.format, func(t *testing.T) {
+			err := semantic.CheckFormat(tc.format, tc.args)
+			if err != nil {
+				if tc.wantErr == "" {
+					t.Fatal(err)
+				}
+				if got := err.Error(); got != tc.wantErr {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, got)
+				}
+				return
+			} else if tc.wantErr != "" {
+				t.Fatalf("expected error %q", tc.wantErr)
+			}
+		})
+	}
+}
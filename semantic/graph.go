@@ -24,6 +24,7 @@ func (*Program) node() {}
 
 func (*BlockStatement) node()              {}
 func (*ExpressionStatement) node()         {}
+func (*OptionStatement) node()             {}
 func (*ReturnStatement) node()             {}
 func (*NativeVariableDeclaration) node()   {}
 func (*ExternalVariableDeclaration) node() {}
@@ -37,8 +38,12 @@ func (*IdentifierExpression) node()  {}
 func (*LogicalExpression) node()     {}
 func (*MemberExpression) node()      {}
 func (*ObjectExpression) node()      {}
+func (*StringExpression) node()      {}
 func (*UnaryExpression) node()       {}
 
+func (*TextPart) node()         {}
+func (*InterpolatedPart) node() {}
+
 func (*Identifier) node()    {}
 func (*Property) node()      {}
 func (*FunctionParam) node() {}
@@ -59,6 +64,7 @@ type Statement interface {
 
 func (*BlockStatement) stmt()              {}
 func (*ExpressionStatement) stmt()         {}
+func (*OptionStatement) stmt()             {}
 func (*ReturnStatement) stmt()             {}
 func (*NativeVariableDeclaration) stmt()   {}
 func (*ExternalVariableDeclaration) stmt() {}
@@ -84,6 +90,7 @@ func (*LogicalExpression) expression()      {}
 func (*MemberExpression) expression()       {}
 func (*ObjectExpression) expression()       {}
 func (*RegexpLiteral) expression()          {}
+func (*StringExpression) expression()       {}
 func (*StringLiteral) expression()          {}
 func (*UnaryExpression) expression()        {}
 func (*UnsignedIntegerLiteral) expression() {}
@@ -170,6 +177,25 @@ func (s *ExpressionStatement) Copy() Node {
 	return ns
 }
 
+// OptionStatement sets a query-wide option, the option is also declared as a variable.
+type OptionStatement struct {
+	Declaration *NativeVariableDeclaration `json:"declaration"`
+}
+
+func (*OptionStatement) NodeType() string { return "OptionStatement" }
+
+func (s *OptionStatement) Copy() Node {
+	if s == nil {
+		return s
+	}
+	ns := new(OptionStatement)
+	*ns = *s
+
+	ns.Declaration = s.Declaration.Copy().(*NativeVariableDeclaration)
+
+	return ns
+}
+
 type ReturnStatement struct {
 	Argument Expression `json:"argument"`
 }
@@ -260,6 +286,7 @@ func (s *ExternalVariableDeclaration) Copy() Node {
 type ArrayExpression struct {
 	Elements []Expression `json:"elements"`
 	typ      atomic.Value //    Type
+	loc      *ast.SourceLocation
 }
 
 func (*ArrayExpression) NodeType() string { return "ArrayExpression" }
@@ -294,6 +321,7 @@ type FunctionExpression struct {
 	Params []*FunctionParam `json:"params"`
 	Body   Node             `json:"body"`
 	typ    atomic.Value     //Type
+	loc    *ast.SourceLocation
 }
 
 func (*FunctionExpression) NodeType() string { return "ArrowFunctionExpression" }
@@ -367,15 +395,12 @@ type BinaryExpression struct {
 	Operator ast.OperatorKind `json:"operator"`
 	Left     Expression       `json:"left"`
 	Right    Expression       `json:"right"`
+	loc      *ast.SourceLocation
 }
 
 func (*BinaryExpression) NodeType() string { return "BinaryExpression" }
 func (e *BinaryExpression) Type() Type {
-	return binaryTypesLookup[binarySignature{
-		operator: e.Operator,
-		left:     e.Left.Type().Kind(),
-		right:    e.Right.Type().Kind(),
-	}]
+	return BinaryType(e.Operator, e.Left.Type().Kind(), e.Right.Type().Kind())
 }
 
 func (e *BinaryExpression) Copy() Node {
@@ -394,11 +419,16 @@ func (e *BinaryExpression) Copy() Node {
 type CallExpression struct {
 	Callee    Expression        `json:"callee"`
 	Arguments *ObjectExpression `json:"arguments"`
+	loc       *ast.SourceLocation
 }
 
 func (*CallExpression) NodeType() string { return "CallExpression" }
 func (e *CallExpression) Type() Type {
-	return e.Callee.Type().ReturnType()
+	t := e.Callee.Type()
+	if t.Kind() != Function {
+		return Invalid
+	}
+	return t.ReturnType()
 }
 
 func (e *CallExpression) Copy() Node {
@@ -418,10 +448,21 @@ type ConditionalExpression struct {
 	Test       Expression `json:"test"`
 	Alternate  Expression `json:"alternate"`
 	Consequent Expression `json:"consequent"`
+	loc        *ast.SourceLocation
 }
 
 func (*ConditionalExpression) NodeType() string { return "ConditionalExpression" }
 
+// Type is the type of the branches of the conditional expression.
+// The type is Invalid if the branches do not have the same type.
+func (e *ConditionalExpression) Type() Type {
+	t := e.Consequent.Type()
+	if t != e.Alternate.Type() {
+		return Invalid
+	}
+	return t
+}
+
 func (e *ConditionalExpression) Copy() Node {
 	if e == nil {
 		return e
@@ -440,6 +481,7 @@ type LogicalExpression struct {
 	Operator ast.LogicalOperatorKind `json:"operator"`
 	Left     Expression              `json:"left"`
 	Right    Expression              `json:"right"`
+	loc      *ast.SourceLocation
 }
 
 func (*LogicalExpression) NodeType() string { return "LogicalExpression" }
@@ -461,6 +503,7 @@ func (e *LogicalExpression) Copy() Node {
 type MemberExpression struct {
 	Object   Expression `json:"object"`
 	Property string     `json:"property"`
+	loc      *ast.SourceLocation
 }
 
 func (*MemberExpression) NodeType() string { return "MemberExpression" }
@@ -488,6 +531,7 @@ func (e *MemberExpression) Copy() Node {
 type ObjectExpression struct {
 	Properties []*Property  `json:"properties"`
 	typ        atomic.Value //Type
+	loc        *ast.SourceLocation
 }
 
 func (*ObjectExpression) NodeType() string { return "ObjectExpression" }
@@ -518,14 +562,87 @@ func (e *ObjectExpression) Copy() Node {
 	return ne
 }
 
+type StringExpression struct {
+	Parts []StringExpressionPart `json:"parts"`
+	loc   *ast.SourceLocation
+}
+
+func (*StringExpression) NodeType() string { return "StringExpression" }
+func (*StringExpression) Type() Type       { return String }
+
+func (e *StringExpression) Copy() Node {
+	if e == nil {
+		return e
+	}
+	ne := new(StringExpression)
+	*ne = *e
+
+	if len(e.Parts) > 0 {
+		ne.Parts = make([]StringExpressionPart, len(e.Parts))
+		for i, p := range e.Parts {
+			ne.Parts[i] = p.Copy().(StringExpressionPart)
+		}
+	}
+
+	return ne
+}
+
+type StringExpressionPart interface {
+	Node
+	stringPart()
+}
+
+func (*TextPart) stringPart()         {}
+func (*InterpolatedPart) stringPart() {}
+
+type TextPart struct {
+	Value string `json:"value"`
+}
+
+func (*TextPart) NodeType() string { return "TextPart" }
+
+func (p *TextPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(TextPart)
+	*np = *p
+
+	return np
+}
+
+type InterpolatedPart struct {
+	Expression Expression `json:"expression"`
+}
+
+func (*InterpolatedPart) NodeType() string { return "InterpolatedPart" }
+
+func (p *InterpolatedPart) Copy() Node {
+	if p == nil {
+		return p
+	}
+	np := new(InterpolatedPart)
+	*np = *p
+
+	np.Expression = p.Expression.Copy().(Expression)
+
+	return np
+}
+
 type UnaryExpression struct {
 	Operator ast.OperatorKind `json:"operator"`
 	Argument Expression       `json:"argument"`
+	loc      *ast.SourceLocation
 }
 
 func (*UnaryExpression) NodeType() string { return "UnaryExpression" }
 func (e *UnaryExpression) Type() Type {
-	return e.Argument.Type()
+	switch e.Operator {
+	case ast.EmptyOperator, ast.NotEmptyOperator, ast.ExistsOperator:
+		return Bool
+	default:
+		return e.Argument.Type()
+	}
 }
 
 func (e *UnaryExpression) Copy() Node {
@@ -543,6 +660,7 @@ func (e *UnaryExpression) Copy() Node {
 type Property struct {
 	Key   *Identifier `json:"key"`
 	Value Expression  `json:"value"`
+	loc   *ast.SourceLocation
 }
 
 func (*Property) NodeType() string { return "Property" }
@@ -563,6 +681,7 @@ type IdentifierExpression struct {
 	Name string `json:"name"`
 	// declaration is the node that declares this identifier
 	declaration VariableDeclaration
+	loc         *ast.SourceLocation
 }
 
 func (*IdentifierExpression) NodeType() string { return "IdentifierExpression" }
@@ -744,23 +863,32 @@ func (l *UnsignedIntegerLiteral) Copy() Node {
 
 // New creates a semantic graph from the provided AST and builtin declarations
 // The declarations will be modified for any variable declaration found in the program.
+// The types of the program are inferred and a *TypeError is returned if they are inconsistent.
 func New(prog *ast.Program, declarations map[string]VariableDeclaration) (*Program, error) {
 	if declarations == nil {
 		// NOTE: Calls to New may expect modifications to declarations to persist outside the function.
 		// The check is against nil instead of len(declarations) == 0 for this reason.
 		declarations = make(map[string]VariableDeclaration)
 	}
-	return analyzeProgram(prog, DeclarationScope(declarations))
+	scope := DeclarationScope(declarations)
+	outer := scope.Copy()
+	p, err := analyzeProgram(prog, scope)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := InferTypes(p, outer); err != nil {
+		return nil, err
+	}
+	return p, nil
 }
 
-// SolveTypes inspects the expression and ensures that all sub expressions konw their type
+// SolveTypes binds the identifiers of the expression to their declarations,
+// so that all sub expressions know their concrete type once the types of the declarations are known.
+// Type checking is done by InferTypes.
 func SolveTypes(n Node, declarations DeclarationScope) {
 	if declarations == nil {
 		declarations = make(DeclarationScope)
 	}
-	// TODO(nathanielc): Use a formal type inference system like Hindley-Milner
-	// TODO(nathanielc): The current implementation only implements the code paths that the current tests and common use cases need.
-	// The implementation is by no means complete for all possible expressions.
 	v := solverVisitor{
 		declarations: declarations,
 	}
@@ -837,6 +965,8 @@ func analyzeStatment(s ast.Statement, declarations DeclarationScope) (Statement,
 		return analyzeBlockStatement(s, declarations)
 	case *ast.ExpressionStatement:
 		return analyzeExpressionStatement(s, declarations)
+	case *ast.OptionStatement:
+		return analyzeOptionStatement(s, declarations)
 	case *ast.ReturnStatement:
 		return analyzeReturnStatement(s, declarations)
 	case *ast.VariableDeclaration:
@@ -879,6 +1009,16 @@ func analyzeExpressionStatement(expr *ast.ExpressionStatement, declarations Decl
 	}, nil
 }
 
+func analyzeOptionStatement(option *ast.OptionStatement, declarations DeclarationScope) (*OptionStatement, error) {
+	decl, err := analyzeVariableDeclaration(option.Declaration, declarations)
+	if err != nil {
+		return nil, err
+	}
+	return &OptionStatement{
+		Declaration: decl,
+	}, nil
+}
+
 func analyzeReturnStatement(ret *ast.ReturnStatement, declarations DeclarationScope) (*ReturnStatement, error) {
 	arg, err := analyzeExpression(ret.Argument, declarations)
 	if err != nil {
@@ -926,6 +1066,10 @@ func analyzeExpression(expr ast.Expression, declarations DeclarationScope) (Expr
 		return analyzeObjectExpression(expr, declarations)
 	case *ast.ArrayExpression:
 		return analyzeArrayExpression(expr, declarations)
+	case *ast.StringExpression:
+		return analyzeStringExpression(expr, declarations)
+	case *ast.ConditionalExpression:
+		return analyzeConditionalExpression(expr, declarations)
 	case *ast.Identifier:
 		return analyzeIdentifierExpression(expr, declarations)
 	case ast.Literal:
@@ -964,6 +1108,7 @@ func analyzeArrowFunctionExpression(arrow *ast.ArrowFunctionExpression, declarat
 	declarations = declarations.Copy()
 	f := &FunctionExpression{
 		Params: make([]*FunctionParam, len(arrow.Params)),
+		loc:    arrow.Location(),
 	}
 	pipedCount := 0
 	for i, p := range arrow.Params {
@@ -1042,6 +1187,7 @@ func analyzeCallExpression(call *ast.CallExpression, declarations DeclarationSco
 	expr := &CallExpression{
 		Callee:    callee,
 		Arguments: args,
+		loc:       call.Location(),
 	}
 
 	declarations = declarations.Copy()
@@ -1111,6 +1257,7 @@ func analyzeMemberExpression(member *ast.MemberExpression, declarations Declarat
 	return &MemberExpression{
 		Object:   obj,
 		Property: propertyName,
+		loc:      member.Location(),
 	}, nil
 }
 
@@ -1140,6 +1287,7 @@ func analyzePipeExpression(pipe *ast.PipeExpression, declarations DeclarationSco
 	property := &Property{
 		Key:   &Identifier{Name: key},
 		Value: value,
+		loc:   pipe.Argument.Location(),
 	}
 
 	found := false
@@ -1191,6 +1339,7 @@ func analyzeBinaryExpression(binary *ast.BinaryExpression, declarations Declarat
 		Operator: binary.Operator,
 		Left:     left,
 		Right:    right,
+		loc:      binary.Location(),
 	}, nil
 }
 
@@ -1199,14 +1348,10 @@ func analyzeUnaryExpression(unary *ast.UnaryExpression, declarations Declaration
 	if err != nil {
 		return nil, err
 	}
-	// TODO(nathanielc): validate operand type once we have type inference working with functions.
-	//k := arg.Type().Kind()
-	//if k != Bool && k != Int && k != Float && k != Duration {
-	//	return nil, fmt.Errorf("invalid unary operator %v on type %v", unary.Operator, k)
-	//}
 	return &UnaryExpression{
 		Operator: unary.Operator,
 		Argument: arg,
+		loc:      unary.Location(),
 	}, nil
 }
 func analyzeLogicalExpression(logical *ast.LogicalExpression, declarations DeclarationScope) (*LogicalExpression, error) {
@@ -1214,26 +1359,66 @@ func analyzeLogicalExpression(logical *ast.LogicalExpression, declarations Decla
 	if err != nil {
 		return nil, err
 	}
-	// TODO(nathanielc): Validate operand types once we have type inference working with functions.
-	//if k := left.Type().Kind(); k != Bool {
-	//	return nil, fmt.Errorf("left operand to logical expression is not a boolean, got kind %v", k)
-	//}
 	right, err := analyzeExpression(logical.Right, declarations)
 	if err != nil {
 		return nil, err
 	}
-	//if k := right.Type().Kind(); k != Bool {
-	//	return nil, fmt.Errorf("right operand to logical expression is not a boolean, got kind %v", k)
-	//}
 	return &LogicalExpression{
 		Operator: logical.Operator,
 		Left:     left,
 		Right:    right,
+		loc:      logical.Location(),
 	}, nil
 }
+func analyzeConditionalExpression(cond *ast.ConditionalExpression, declarations DeclarationScope) (*ConditionalExpression, error) {
+	test, err := analyzeExpression(cond.Test, declarations)
+	if err != nil {
+		return nil, err
+	}
+	consequent, err := analyzeExpression(cond.Consequent, declarations)
+	if err != nil {
+		return nil, err
+	}
+	alternate, err := analyzeExpression(cond.Alternate, declarations)
+	if err != nil {
+		return nil, err
+	}
+	return &ConditionalExpression{
+		Test:       test,
+		Consequent: consequent,
+		Alternate:  alternate,
+		loc:        cond.Location(),
+	}, nil
+}
+func analyzeStringExpression(str *ast.StringExpression, declarations DeclarationScope) (*StringExpression, error) {
+	e := &StringExpression{
+		Parts: make([]StringExpressionPart, len(str.Parts)),
+		loc:   str.Location(),
+	}
+	for i, p := range str.Parts {
+		switch p := p.(type) {
+		case *ast.TextPart:
+			e.Parts[i] = &TextPart{
+				Value: p.Value,
+			}
+		case *ast.InterpolatedPart:
+			expr, err := analyzeExpression(p.Expression, declarations)
+			if err != nil {
+				return nil, err
+			}
+			e.Parts[i] = &InterpolatedPart{
+				Expression: expr,
+			}
+		default:
+			return nil, fmt.Errorf("unsupported string expression part %T", p)
+		}
+	}
+	return e, nil
+}
 func analyzeObjectExpression(obj *ast.ObjectExpression, declarations DeclarationScope) (*ObjectExpression, error) {
 	o := &ObjectExpression{
 		Properties: make([]*Property, len(obj.Properties)),
+		loc:        obj.Location(),
 	}
 	for i, p := range obj.Properties {
 		n, err := analyzeProperty(p, declarations)
@@ -1247,6 +1432,7 @@ func analyzeObjectExpression(obj *ast.ObjectExpression, declarations Declaration
 func analyzeArrayExpression(array *ast.ArrayExpression, declarations DeclarationScope) (*ArrayExpression, error) {
 	a := &ArrayExpression{
 		Elements: make([]Expression, len(array.Elements)),
+		loc:      array.Location(),
 	}
 	for i, e := range array.Elements {
 		n, err := analyzeExpression(e, declarations)
@@ -1268,6 +1454,7 @@ func analyzeIdentifierExpression(ident *ast.Identifier, declarations Declaration
 	return &IdentifierExpression{
 		Name:        ident.Name,
 		declaration: declarations[ident.Name],
+		loc:         ident.Location(),
 	}, nil
 }
 
@@ -1283,6 +1470,7 @@ func analyzeProperty(property *ast.Property, declarations DeclarationScope) (*Pr
 	return &Property{
 		Key:   key,
 		Value: value,
+		loc:   property.Location(),
 	}, nil
 }
 
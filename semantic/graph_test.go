@@ -119,6 +119,111 @@ func TestNew(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "string expression",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringExpression{
+							Parts: []ast.StringExpressionPart{
+								&ast.TextPart{Value: "n is "},
+								&ast.InterpolatedPart{
+									Expression: &ast.IntegerLiteral{Value: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: &semantic.Program{
+				Body: []semantic.Statement{
+					&semantic.ExpressionStatement{
+						Expression: &semantic.StringExpression{
+							Parts: []semantic.StringExpressionPart{
+								&semantic.TextPart{Value: "n is "},
+								&semantic.InterpolatedPart{
+									Expression: &semantic.IntegerLiteral{Value: 1},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional expression",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test:       &ast.BooleanLiteral{Value: true},
+							Consequent: &ast.IntegerLiteral{Value: 1},
+							Alternate:  &ast.IntegerLiteral{Value: 2},
+						},
+					},
+				},
+			},
+			want: &semantic.Program{
+				Body: []semantic.Statement{
+					&semantic.ExpressionStatement{
+						Expression: &semantic.ConditionalExpression{
+							Test:       &semantic.BooleanLiteral{Value: true},
+							Consequent: &semantic.IntegerLiteral{Value: 1},
+							Alternate:  &semantic.IntegerLiteral{Value: 2},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "conditional expression with non boolean test",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test:       &ast.IntegerLiteral{Value: 0},
+							Consequent: &ast.IntegerLiteral{Value: 1},
+							Alternate:  &ast.IntegerLiteral{Value: 2},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "conditional expression with mismatched branches",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.ConditionalExpression{
+							Test:       &ast.BooleanLiteral{Value: true},
+							Consequent: &ast.IntegerLiteral{Value: 1},
+							Alternate:  &ast.StringLiteral{Value: "2"},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "string expression interpolating an array",
+			program: &ast.Program{
+				Body: []ast.Statement{
+					&ast.ExpressionStatement{
+						Expression: &ast.StringExpression{
+							Parts: []ast.StringExpressionPart{
+								&ast.InterpolatedPart{
+									Expression: &ast.ArrayExpression{
+										Elements: []ast.Expression{&ast.IntegerLiteral{Value: 1}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -0,0 +1,1311 @@
+package semantic
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/influxdata/ifql/ast"
+)
+
+// TypeError is an error found while inferring the types of a program.
+type TypeError struct {
+	// Location is the source location of the expression with the invalid type, if known.
+	Location *ast.SourceLocation
+	Msg      string
+}
+
+func (e *TypeError) Error() string {
+	if e.Location == nil {
+		return "type error: " + e.Msg
+	}
+	return fmt.Sprintf("type error %d:%d-%d:%d: %s",
+		e.Location.Start.Line,
+		e.Location.Start.Column,
+		e.Location.End.Line,
+		e.Location.End.Column,
+		e.Msg,
+	)
+}
+
+// TypeSolution holds the types inferred for the expressions of a semantic graph.
+type TypeSolution struct {
+	types map[Expression]monotype
+}
+
+// TypeOf returns the inferred type of the expression.
+// Types that could not be determined are reported as type variables,
+// and objects only report the properties that are known to exist.
+// The type is Invalid if the expression was not part of the inferred node.
+func (s *TypeSolution) TypeOf(e Expression) Type {
+	t, ok := s.types[e]
+	if !ok {
+		return Invalid
+	}
+	return toType(t, newTypeNamer())
+}
+
+// InferTypes infers the types of all expressions in the node using constraint based
+// Hindley-Milner type inference.
+// The declarations provide the types of identifiers that are not declared within the node.
+// Functions bound to variables are polymorphic, each use of the variable may have different types.
+// A *TypeError is returned for the first expression whose type is inconsistent.
+func InferTypes(n Node, declarations DeclarationScope) (*TypeSolution, error) {
+	in := &inferrer{
+		declarations: declarations,
+		types:        make(map[Expression]monotype),
+	}
+	if err := in.inferNode(n, newTypeEnv(nil)); err != nil {
+		return nil, err
+	}
+	if err := in.solvePending(); err != nil {
+		return nil, err
+	}
+	return &TypeSolution{types: in.types}, nil
+}
+
+// monotype is a type term used during inference.
+// A monotype is one of Kind, *tvar, *tarray, *tobject or *tfunction.
+type monotype interface {
+	String() string
+}
+
+// tvar is a type variable.
+// A type variable is free until it is bound to an instance.
+type tvar struct {
+	id int
+	// level is the nesting depth of variable declarations where the variable was created,
+	// only variables created within a declaration are generalized by the declaration.
+	level    int
+	instance monotype
+}
+
+func (v *tvar) String() string {
+	return formatType(v, newTypeNamer())
+}
+
+type tarray struct {
+	element monotype
+}
+
+func (a *tarray) String() string {
+	return formatType(a, newTypeNamer())
+}
+
+// tobject is an object type.
+// The object is open if rest is a type variable, in which case the object may have more properties than are known.
+// An open object whose rest variable is bound to another object has the properties of both objects.
+type tobject struct {
+	properties map[string]monotype
+	rest       monotype
+}
+
+func (o *tobject) String() string {
+	return formatType(o, newTypeNamer())
+}
+
+// paramsMode describes how complete the parameters of a function type are.
+type paramsMode int
+
+const (
+	// exactParams are the parameters of a function literal, calls must not use any other parameters.
+	exactParams paramsMode = iota
+	// declaredParams are the parameters of a declared function signature, which may not list all of its parameters.
+	declaredParams
+	// inferredParams are only known from the calls of the function, parameters are added as they are used.
+	inferredParams
+)
+
+type tfunction struct {
+	params   map[string]monotype
+	optional map[string]bool
+	pipe     string
+	ret      monotype
+	mode     paramsMode
+	// formatParam and formatArgs are the format parameter and the array parameter it formats, see FunctionSignature.
+	formatParam string
+	formatArgs  string
+}
+
+func (f *tfunction) String() string {
+	return formatType(f, newTypeNamer())
+}
+
+// prune returns the instance of bound type variables.
+func prune(t monotype) monotype {
+	if v, ok := t.(*tvar); ok && v.instance != nil {
+		v.instance = prune(v.instance)
+		return v.instance
+	}
+	return t
+}
+
+// flattenObject returns all properties of the object including those of its bound rest variables,
+// and the free rest variable of the object, which is nil if the object is closed.
+func flattenObject(o *tobject) (map[string]monotype, *tvar) {
+	props := make(map[string]monotype, len(o.properties))
+	for {
+		for k, t := range o.properties {
+			props[k] = t
+		}
+		switch r := prune(o.rest).(type) {
+		case *tobject:
+			o = r
+		case *tvar:
+			return props, r
+		default:
+			return props, nil
+		}
+	}
+}
+
+// kindOf reports the kind of the type and whether it is known.
+func kindOf(t monotype) (Kind, bool) {
+	switch t := prune(t).(type) {
+	case Kind:
+		return t, true
+	case *tarray:
+		return Array, true
+	case *tobject:
+		return Object, true
+	case *tfunction:
+		return Function, true
+	default:
+		return Invalid, false
+	}
+}
+
+// scheme is a possibly polymorphic type.
+// Each use of a scheme instantiates fresh type variables for its quantified variables,
+// along with any constraints on those variables.
+type scheme struct {
+	vars        []*tvar
+	constraints []constraint
+	t           monotype
+}
+
+type typeEnv struct {
+	parent   *typeEnv
+	bindings map[string]*scheme
+}
+
+func newTypeEnv(parent *typeEnv) *typeEnv {
+	return &typeEnv{
+		parent:   parent,
+		bindings: make(map[string]*scheme),
+	}
+}
+
+func (e *typeEnv) lookup(name string) (*scheme, bool) {
+	for ; e != nil; e = e.parent {
+		if s, ok := e.bindings[name]; ok {
+			return s, true
+		}
+	}
+	return nil, false
+}
+
+// constraint is a requirement on types that cannot be solved by unification alone,
+// as it depends on types that may not be known yet.
+type constraint interface {
+	// solve reports whether the constraint is solved and will never fail,
+	// or returns an error if the constraint can never be satisfied.
+	solve(in *inferrer) (bool, error)
+	// substitute returns a copy of the constraint where types are substituted with f.
+	substitute(f func(monotype) monotype) constraint
+	// types returns the types the constraint depends on.
+	types() []monotype
+}
+
+// binaryConstraint requires the operator to be defined for the left and right types, with the type of the result.
+type binaryConstraint struct {
+	operator            ast.OperatorKind
+	left, right, result monotype
+	loc                 *ast.SourceLocation
+}
+
+func (c *binaryConstraint) solve(in *inferrer) (bool, error) {
+	op := c.operator
+	if op == ast.InOperator {
+		// The right type is the element type of the array,
+		// an element may be tested if it can be compared for equality.
+		op = ast.EqualOperator
+	}
+	lk, lok := kindOf(c.left)
+	rk, rok := kindOf(c.right)
+	var candidates []binarySignature
+	for sig := range binaryTypesLookup {
+		if sig.operator != op || lok && sig.left != lk || rok && sig.right != rk {
+			continue
+		}
+		candidates = append(candidates, sig)
+	}
+	if len(candidates) == 0 {
+		n := newTypeNamer()
+		switch {
+		case lok && rok:
+			return false, in.errorf(c.loc, "operator %v is not defined for %s and %s", c.operator, formatType(c.left, n), formatType(c.right, n))
+		case lok:
+			return false, in.errorf(c.loc, "operator %v is not defined for left operand of type %s", c.operator, formatType(c.left, n))
+		default:
+			return false, in.errorf(c.loc, "operator %v is not defined for right operand of type %s", c.operator, formatType(c.right, n))
+		}
+	}
+	sameResult, sameOperands := true, true
+	for _, sig := range candidates {
+		if binaryTypesLookup[sig] != binaryTypesLookup[candidates[0]] {
+			sameResult = false
+		}
+		if sig.left != sig.right {
+			sameOperands = false
+		}
+	}
+	if sameResult {
+		if err := in.unify(c.result, binaryTypesLookup[candidates[0]]); err != nil {
+			return false, in.errorf(c.loc, "result of operator %v: %v", c.operator, err)
+		}
+	}
+	if sameOperands {
+		if err := in.unify(c.left, c.right); err != nil {
+			return false, in.errorf(c.loc, "operands of %v must have the same type: %v", c.operator, err)
+		}
+	}
+	if len(candidates) == 1 {
+		if err := in.unify(c.left, candidates[0].left); err != nil {
+			return false, in.errorf(c.loc, "left operand of %v: %v", c.operator, err)
+		}
+		if err := in.unify(c.right, candidates[0].right); err != nil {
+			return false, in.errorf(c.loc, "right operand of %v: %v", c.operator, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (c *binaryConstraint) substitute(f func(monotype) monotype) constraint {
+	return &binaryConstraint{
+		operator: c.operator,
+		left:     f(c.left),
+		right:    f(c.right),
+		result:   f(c.result),
+		loc:      c.loc,
+	}
+}
+
+func (c *binaryConstraint) types() []monotype {
+	return []monotype{c.left, c.right, c.result}
+}
+
+// kindConstraint requires a type to be one of a set of kinds.
+type kindConstraint struct {
+	t     monotype
+	kinds []Kind
+	// format is the error message when the constraint fails, formatted with the type.
+	format string
+	loc    *ast.SourceLocation
+}
+
+func (c *kindConstraint) solve(in *inferrer) (bool, error) {
+	k, ok := kindOf(c.t)
+	if !ok {
+		return false, nil
+	}
+	for _, kind := range c.kinds {
+		if k == kind {
+			return true, nil
+		}
+	}
+	return false, in.errorf(c.loc, c.format, formatType(c.t, newTypeNamer()))
+}
+
+func (c *kindConstraint) substitute(f func(monotype) monotype) constraint {
+	return &kindConstraint{
+		t:      f(c.t),
+		kinds:  c.kinds,
+		format: c.format,
+		loc:    c.loc,
+	}
+}
+
+func (c *kindConstraint) types() []monotype {
+	return []monotype{c.t}
+}
+
+var (
+	numericKinds           = []Kind{Int, Float, Duration}
+	emptiableKinds         = []Kind{String, Array}
+	stringConvertibleKinds = []Kind{String, Int, UInt, Float, Bool, Time, Duration}
+)
+
+type inferrer struct {
+	declarations DeclarationScope
+	types        map[Expression]monotype
+	pending      []constraint
+	nextID       int
+	level        int
+}
+
+func (in *inferrer) errorf(loc *ast.SourceLocation, format string, a ...interface{}) error {
+	return &TypeError{
+		Location: loc,
+		Msg:      fmt.Sprintf(format, a...),
+	}
+}
+
+func (in *inferrer) fresh() *tvar {
+	in.nextID++
+	return &tvar{
+		id:    in.nextID,
+		level: in.level,
+	}
+}
+
+// constrain adds the constraint and solves it if possible.
+func (in *inferrer) constrain(c constraint) error {
+	in.pending = append(in.pending, c)
+	return in.solvePending()
+}
+
+// solvePending solves pending constraints until no more progress can be made.
+func (in *inferrer) solvePending() error {
+	for progress := true; progress; {
+		progress = false
+		pending := in.pending[:0]
+		for _, c := range in.pending {
+			solved, err := c.solve(in)
+			if err != nil {
+				return err
+			}
+			if solved {
+				progress = true
+				continue
+			}
+			pending = append(pending, c)
+		}
+		in.pending = pending
+	}
+	return nil
+}
+
+// unify makes the two types equal by binding their type variables.
+func (in *inferrer) unify(a, b monotype) error {
+	a, b = prune(a), prune(b)
+	if a == b {
+		return nil
+	}
+	if v, ok := a.(*tvar); ok {
+		return in.bind(v, b)
+	}
+	if v, ok := b.(*tvar); ok {
+		return in.bind(v, a)
+	}
+	switch a := a.(type) {
+	case *tarray:
+		if b, ok := b.(*tarray); ok {
+			if err := in.unify(a.element, b.element); err != nil {
+				return fmt.Errorf("array element: %v", err)
+			}
+			return nil
+		}
+	case *tobject:
+		if b, ok := b.(*tobject); ok {
+			return in.unifyObjects(a, b)
+		}
+	case *tfunction:
+		if b, ok := b.(*tfunction); ok {
+			return in.unifyFunctions(a, b)
+		}
+	}
+	return mismatch(a, b)
+}
+
+func mismatch(a, b monotype) error {
+	n := newTypeNamer()
+	return fmt.Errorf("expected %s but found %s", formatType(a, n), formatType(b, n))
+}
+
+func (in *inferrer) bind(v *tvar, t monotype) error {
+	if occurs(v, t) {
+		n := newTypeNamer()
+		return fmt.Errorf("type %s cannot contain itself, found %s", formatType(v, n), formatType(t, n))
+	}
+	adjustLevels(t, v.level)
+	v.instance = t
+	return nil
+}
+
+func occurs(v *tvar, t monotype) bool {
+	found := false
+	walkTypes(t, func(t monotype) {
+		if t == v {
+			found = true
+		}
+	})
+	return found
+}
+
+// adjustLevels lowers the level of the free type variables in t to at most level,
+// so that they are not generalized by a declaration that they escape from.
+func adjustLevels(t monotype, level int) {
+	walkTypes(t, func(t monotype) {
+		if v, ok := t.(*tvar); ok && v.level > level {
+			v.level = level
+		}
+	})
+}
+
+// walkTypes calls f for t and every type it contains, after pruning bound type variables.
+func walkTypes(t monotype, f func(monotype)) {
+	t = prune(t)
+	f(t)
+	switch t := t.(type) {
+	case *tarray:
+		walkTypes(t.element, f)
+	case *tobject:
+		for _, p := range t.properties {
+			walkTypes(p, f)
+		}
+		if t.rest != nil {
+			walkTypes(t.rest, f)
+		}
+	case *tfunction:
+		for _, p := range t.params {
+			walkTypes(p, f)
+		}
+		walkTypes(t.ret, f)
+	}
+}
+
+func (in *inferrer) unifyObjects(a, b *tobject) error {
+	ap, ar := flattenObject(a)
+	bp, br := flattenObject(b)
+	onlyA := make(map[string]monotype)
+	onlyB := make(map[string]monotype)
+	for _, k := range sortedKeys(ap) {
+		if bt, ok := bp[k]; ok {
+			if err := in.unify(ap[k], bt); err != nil {
+				return fmt.Errorf("property %q: %v", k, err)
+			}
+		} else {
+			onlyA[k] = ap[k]
+		}
+	}
+	for k, t := range bp {
+		if _, ok := ap[k]; !ok {
+			onlyB[k] = t
+		}
+	}
+	if ar == nil && len(onlyB) > 0 {
+		return fmt.Errorf("object %s has no property %q", formatType(a, newTypeNamer()), sortedKeys(onlyB)[0])
+	}
+	if br == nil && len(onlyA) > 0 {
+		return fmt.Errorf("object %s has no property %q", formatType(b, newTypeNamer()), sortedKeys(onlyA)[0])
+	}
+	switch {
+	case ar == nil && br == nil:
+		return nil
+	case ar == nil:
+		return in.bind(br, &tobject{properties: onlyA})
+	case br == nil:
+		return in.bind(ar, &tobject{properties: onlyB})
+	case ar == br:
+		if len(onlyA) > 0 || len(onlyB) > 0 {
+			return mismatch(a, b)
+		}
+		return nil
+	default:
+		rest := in.fresh()
+		if ar.level < br.level {
+			rest.level = ar.level
+		} else {
+			rest.level = br.level
+		}
+		if err := in.bind(ar, &tobject{properties: onlyB, rest: rest}); err != nil {
+			return err
+		}
+		return in.bind(br, &tobject{properties: onlyA, rest: rest})
+	}
+}
+
+func (in *inferrer) unifyFunctions(a, b *tfunction) error {
+	for _, k := range sortedKeys(a.params) {
+		bt, ok := b.params[k]
+		if !ok {
+			continue
+		}
+		if err := in.unify(a.params[k], bt); err != nil {
+			return fmt.Errorf("parameter %q: %v", k, err)
+		}
+	}
+	if err := addParams(a, b); err != nil {
+		return err
+	}
+	if err := addParams(b, a); err != nil {
+		return err
+	}
+	if err := in.unify(a.ret, b.ret); err != nil {
+		return fmt.Errorf("return value: %v", err)
+	}
+	// A function that was only known from its calls is now known to be the other function.
+	if a.mode == inferredParams && b.mode != inferredParams {
+		*a = *b
+	} else if b.mode == inferredParams && a.mode != inferredParams {
+		*b = *a
+	}
+	return nil
+}
+
+// addParams adds the parameters of from that are missing in to.
+func addParams(to, from *tfunction) error {
+	for _, k := range sortedKeys(from.params) {
+		if _, ok := to.params[k]; ok {
+			continue
+		}
+		switch to.mode {
+		case exactParams:
+			return fmt.Errorf("function %s has no parameter %q", formatType(to, newTypeNamer()), k)
+		case inferredParams:
+			to.params[k] = from.params[k]
+		}
+	}
+	return nil
+}
+
+// instantiate returns the type of the scheme with fresh type variables for its quantified variables.
+func (in *inferrer) instantiate(s *scheme) (monotype, error) {
+	if len(s.vars) == 0 {
+		return s.t, nil
+	}
+	fresh := make(map[*tvar]monotype, len(s.vars))
+	for _, v := range s.vars {
+		fresh[v] = in.fresh()
+	}
+	subst := func(t monotype) monotype {
+		return substitute(t, fresh)
+	}
+	t := subst(s.t)
+	for _, c := range s.constraints {
+		in.pending = append(in.pending, c.substitute(subst))
+	}
+	return t, in.solvePending()
+}
+
+// substitute copies the type replacing the type variables in vars.
+func substitute(t monotype, vars map[*tvar]monotype) monotype {
+	switch t := prune(t).(type) {
+	case *tvar:
+		if n, ok := vars[t]; ok {
+			return n
+		}
+		return t
+	case *tarray:
+		return &tarray{element: substitute(t.element, vars)}
+	case *tobject:
+		o := &tobject{
+			properties: make(map[string]monotype, len(t.properties)),
+		}
+		for k, p := range t.properties {
+			o.properties[k] = substitute(p, vars)
+		}
+		if t.rest != nil {
+			o.rest = substitute(t.rest, vars)
+		}
+		return o
+	case *tfunction:
+		f := &tfunction{
+			params:      make(map[string]monotype, len(t.params)),
+			optional:    t.optional,
+			pipe:        t.pipe,
+			ret:         substitute(t.ret, vars),
+			mode:        t.mode,
+			formatParam: t.formatParam,
+			formatArgs:  t.formatArgs,
+		}
+		for k, p := range t.params {
+			f.params[k] = substitute(p, vars)
+		}
+		return f
+	default:
+		return t
+	}
+}
+
+// generalize quantifies the free type variables of t that were created at a deeper level than the current level.
+// Pending constraints on those variables become part of the scheme.
+func (in *inferrer) generalize(t monotype) *scheme {
+	s := &scheme{t: t}
+	seen := make(map[*tvar]bool)
+	quantify := func(t monotype) bool {
+		found := false
+		walkTypes(t, func(t monotype) {
+			if v, ok := t.(*tvar); ok && v.level > in.level {
+				found = true
+				if !seen[v] {
+					seen[v] = true
+					s.vars = append(s.vars, v)
+				}
+			}
+		})
+		return found
+	}
+	quantify(t)
+	pending := in.pending[:0]
+	for _, c := range in.pending {
+		local := false
+		for _, t := range c.types() {
+			if quantify(t) {
+				local = true
+			}
+		}
+		if local {
+			s.constraints = append(s.constraints, c)
+		} else {
+			pending = append(pending, c)
+		}
+	}
+	in.pending = pending
+	return s
+}
+
+// fromType converts a declared type into a monotype.
+// Invalid types are unknown and become fresh type variables,
+// the same type variable of a signature is the same monotype.
+// The kinds of constrained type variables are checked at loc, the location of the use of the type.
+func (in *inferrer) fromType(t Type, vars map[Type]*tvar, loc *ast.SourceLocation) monotype {
+	if t == nil {
+		return in.fresh()
+	}
+	switch t.Kind() {
+	case Invalid, Nil:
+		return in.fresh()
+	case TypeVar:
+		v, ok := vars[t]
+		if !ok {
+			v = in.fresh()
+			vars[t] = v
+			if tv, ok := t.(*typeVar); ok && len(tv.kinds) > 0 {
+				// The variable is fresh so the constraint cannot be solved yet.
+				in.pending = append(in.pending, &kindConstraint{
+					t:      v,
+					kinds:  tv.kinds,
+					format: fmt.Sprintf("expected one of %v, found %%s", tv.kinds),
+					loc:    loc,
+				})
+			}
+		}
+		return v
+	case Array:
+		if _, ok := t.(Kind); ok {
+			return &tarray{element: in.fresh()}
+		}
+		return &tarray{element: in.fromType(t.ElementType(), vars, loc)}
+	case Object:
+		if _, ok := t.(Kind); ok {
+			return &tobject{properties: map[string]monotype{}, rest: in.fresh()}
+		}
+		props := t.Properties()
+		o := &tobject{properties: make(map[string]monotype, len(props))}
+		for k, p := range props {
+			o.properties[k] = in.fromType(p, vars, loc)
+		}
+		return o
+	case Function:
+		ft, ok := t.(*functionType)
+		if !ok {
+			return &tfunction{
+				params: make(map[string]monotype),
+				ret:    in.fresh(),
+				mode:   inferredParams,
+			}
+		}
+		f := &tfunction{
+			params:      make(map[string]monotype, len(ft.params)),
+			pipe:        ft.pipeArgument,
+			ret:         in.fromType(ft.returnType, vars, loc),
+			mode:        declaredParams,
+			formatParam: ft.formatParam,
+			formatArgs:  ft.formatArgs,
+		}
+		for k, p := range ft.params {
+			f.params[k] = in.fromType(p, vars, loc)
+		}
+		return f
+	default:
+		return t.Kind()
+	}
+}
+
+func (in *inferrer) inferNode(n Node, env *typeEnv) error {
+	switch n := n.(type) {
+	case *Program:
+		for _, s := range n.Body {
+			if err := in.inferNode(s, env); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *BlockStatement:
+		_, err := in.inferBlock(n, env)
+		return err
+	case *ExpressionStatement:
+		_, err := in.infer(n.Expression, env)
+		return err
+	case *ReturnStatement:
+		_, err := in.infer(n.Argument, env)
+		return err
+	case *OptionStatement:
+		return in.inferDeclaration(n.Declaration, env)
+	case *NativeVariableDeclaration:
+		return in.inferDeclaration(n, env)
+	case *ExternalVariableDeclaration:
+		env.bindings[n.Identifier.Name] = &scheme{t: in.fromType(n.Type, make(map[Type]*tvar), nil)}
+		return nil
+	case Expression:
+		_, err := in.infer(n, env)
+		return err
+	default:
+		return fmt.Errorf("cannot infer types of node %T", n)
+	}
+}
+
+// inferBlock infers the types of the statements of the block and returns the type of its return statement.
+func (in *inferrer) inferBlock(b *BlockStatement, env *typeEnv) (monotype, error) {
+	env = newTypeEnv(env)
+	var ret monotype = in.fresh()
+	for _, s := range b.Body {
+		if r, ok := s.(*ReturnStatement); ok {
+			t, err := in.infer(r.Argument, env)
+			if err != nil {
+				return nil, err
+			}
+			ret = t
+			continue
+		}
+		if err := in.inferNode(s, env); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// inferDeclaration binds the type of the declaration in the environment.
+// Declared functions are generalized, making them polymorphic.
+func (in *inferrer) inferDeclaration(d *NativeVariableDeclaration, env *typeEnv) error {
+	in.level++
+	t, err := in.infer(d.Init, env)
+	in.level--
+	if err != nil {
+		return err
+	}
+	if err := in.solvePending(); err != nil {
+		return err
+	}
+	s := &scheme{t: t}
+	if _, ok := d.Init.(*FunctionExpression); ok {
+		s = in.generalize(t)
+	}
+	env.bindings[d.Identifier.Name] = s
+	return nil
+}
+
+func (in *inferrer) infer(e Expression, env *typeEnv) (monotype, error) {
+	t, err := in.inferExpression(e, env)
+	if err != nil {
+		return nil, err
+	}
+	in.types[e] = t
+	return t, nil
+}
+
+func (in *inferrer) inferExpression(e Expression, env *typeEnv) (monotype, error) {
+	switch e := e.(type) {
+	case *BooleanLiteral, *DateTimeLiteral, *DurationLiteral, *FloatLiteral,
+		*IntegerLiteral, *RegexpLiteral, *StringLiteral, *UnsignedIntegerLiteral:
+		return e.Type().Kind(), nil
+	case *IdentifierExpression:
+		if s, ok := env.lookup(e.Name); ok {
+			return in.instantiate(s)
+		}
+		if d, ok := in.declarations[e.Name]; ok {
+			return in.fromType(d.InitType(), make(map[Type]*tvar), e.loc), nil
+		}
+		// Undeclared identifiers are reported when the program is evaluated.
+		return in.fresh(), nil
+	case *StringExpression:
+		for _, p := range e.Parts {
+			ip, ok := p.(*InterpolatedPart)
+			if !ok {
+				continue
+			}
+			t, err := in.infer(ip.Expression, env)
+			if err != nil {
+				return nil, err
+			}
+			if err := in.constrain(&kindConstraint{
+				t:      t,
+				kinds:  stringConvertibleKinds,
+				format: "cannot interpolate value of type %s into a string",
+				loc:    e.loc,
+			}); err != nil {
+				return nil, err
+			}
+		}
+		return String, nil
+	case *ArrayExpression:
+		elem := monotype(in.fresh())
+		for i, el := range e.Elements {
+			t, err := in.infer(el, env)
+			if err != nil {
+				return nil, err
+			}
+			if err := in.unify(elem, t); err != nil {
+				return nil, in.errorf(e.loc, "array element %d: %v", i, err)
+			}
+		}
+		return &tarray{element: elem}, nil
+	case *ObjectExpression:
+		o := &tobject{properties: make(map[string]monotype, len(e.Properties))}
+		for _, p := range e.Properties {
+			t, err := in.infer(p.Value, env)
+			if err != nil {
+				return nil, err
+			}
+			o.properties[p.Key.Name] = t
+		}
+		return o, nil
+	case *MemberExpression:
+		t, err := in.infer(e.Object, env)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := strconv.Atoi(e.Property); err == nil {
+			// Integer properties index into arrays.
+			switch a := prune(t).(type) {
+			case *tarray:
+				return a.element, nil
+			case *tvar:
+				return in.fresh(), nil
+			}
+		}
+		if o, ok := prune(t).(*tobject); ok {
+			if props, rest := flattenObject(o); rest == nil {
+				p, ok := props[e.Property]
+				if !ok {
+					return nil, in.errorf(e.loc, "object %s has no property %q", o, e.Property)
+				}
+				return p, nil
+			}
+		}
+		p := in.fresh()
+		if err := in.unify(t, &tobject{
+			properties: map[string]monotype{e.Property: p},
+			rest:       in.fresh(),
+		}); err != nil {
+			return nil, in.errorf(e.loc, "cannot access property %q: %v", e.Property, err)
+		}
+		return p, nil
+	case *UnaryExpression:
+		if e.Operator == ast.ExistsOperator {
+			// The operand of exists may be a property that is not present,
+			// so only the object of a member expression is inferred.
+			var arg Expression = e.Argument
+			if m, ok := arg.(*MemberExpression); ok {
+				arg = m.Object
+			}
+			if _, err := in.infer(arg, env); err != nil {
+				return nil, err
+			}
+			return Bool, nil
+		}
+		t, err := in.infer(e.Argument, env)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Operator {
+		case ast.NotOperator:
+			if err := in.unify(Bool, t); err != nil {
+				return nil, in.errorf(e.loc, "operand of %v: %v", e.Operator, err)
+			}
+			return Bool, nil
+		case ast.SubtractionOperator:
+			return t, in.constrain(&kindConstraint{
+				t:      t,
+				kinds:  numericKinds,
+				format: "cannot negate value of type %s",
+				loc:    e.loc,
+			})
+		case ast.EmptyOperator, ast.NotEmptyOperator:
+			return Bool, in.constrain(&kindConstraint{
+				t:      t,
+				kinds:  emptiableKinds,
+				format: "operand of " + e.Operator.String() + " must be a string or an array, found %s",
+				loc:    e.loc,
+			})
+		default:
+			return t, nil
+		}
+	case *BinaryExpression:
+		l, err := in.infer(e.Left, env)
+		if err != nil {
+			return nil, err
+		}
+		r, err := in.infer(e.Right, env)
+		if err != nil {
+			return nil, err
+		}
+		result := in.fresh()
+		if e.Operator == ast.InOperator {
+			elem := in.fresh()
+			if err := in.unify(&tarray{element: elem}, r); err != nil {
+				return nil, in.errorf(e.loc, "right operand of %v: %v", e.Operator, err)
+			}
+			r = elem
+		}
+		return result, in.constrain(&binaryConstraint{
+			operator: e.Operator,
+			left:     l,
+			right:    r,
+			result:   result,
+			loc:      e.loc,
+		})
+	case *LogicalExpression:
+		for _, operand := range []Expression{e.Left, e.Right} {
+			t, err := in.infer(operand, env)
+			if err != nil {
+				return nil, err
+			}
+			if err := in.unify(Bool, t); err != nil {
+				return nil, in.errorf(e.loc, "operand of %v: %v", e.Operator, err)
+			}
+		}
+		return Bool, nil
+	case *ConditionalExpression:
+		test, err := in.infer(e.Test, env)
+		if err != nil {
+			return nil, err
+		}
+		if err := in.unify(Bool, test); err != nil {
+			return nil, in.errorf(e.loc, "test of conditional expression: %v", err)
+		}
+		c, err := in.infer(e.Consequent, env)
+		if err != nil {
+			return nil, err
+		}
+		a, err := in.infer(e.Alternate, env)
+		if err != nil {
+			return nil, err
+		}
+		if err := in.unify(c, a); err != nil {
+			return nil, in.errorf(e.loc, "branches of conditional expression have different types: %v", err)
+		}
+		return c, nil
+	case *FunctionExpression:
+		return in.inferFunction(e, env)
+	case *CallExpression:
+		return in.inferCall(e, env)
+	default:
+		return nil, fmt.Errorf("cannot infer type of expression %T", e)
+	}
+}
+
+func (in *inferrer) inferFunction(e *FunctionExpression, env *typeEnv) (monotype, error) {
+	env = newTypeEnv(env)
+	f := &tfunction{
+		params:   make(map[string]monotype, len(e.Params)),
+		optional: make(map[string]bool),
+		mode:     exactParams,
+	}
+	for _, p := range e.Params {
+		var t monotype
+		if p.Default != nil {
+			d, err := in.infer(p.Default, env)
+			if err != nil {
+				return nil, err
+			}
+			t = d
+			f.optional[p.Key.Name] = true
+		} else {
+			t = in.fresh()
+		}
+		if p.Piped {
+			f.pipe = p.Key.Name
+		}
+		f.params[p.Key.Name] = t
+		env.bindings[p.Key.Name] = &scheme{t: t}
+	}
+	switch b := e.Body.(type) {
+	case Expression:
+		t, err := in.infer(b, env)
+		if err != nil {
+			return nil, err
+		}
+		f.ret = t
+	case *BlockStatement:
+		t, err := in.inferBlock(b, env)
+		if err != nil {
+			return nil, err
+		}
+		f.ret = t
+	default:
+		return nil, fmt.Errorf("unsupported function body %T", e.Body)
+	}
+	return f, nil
+}
+
+func (in *inferrer) inferCall(e *CallExpression, env *typeEnv) (monotype, error) {
+	callee, err := in.infer(e.Callee, env)
+	if err != nil {
+		return nil, err
+	}
+	var formatArgs string
+	if f, ok := prune(callee).(*tfunction); ok {
+		formatArgs = f.formatArgs
+	}
+	args := make(map[string]monotype)
+	argLocs := make(map[string]*ast.SourceLocation)
+	var formatElements []monotype
+	if e.Arguments != nil {
+		obj := &tobject{properties: args}
+		for _, p := range e.Arguments.Properties {
+			var t monotype
+			var err error
+			if formatArgs != "" && p.Key.Name == formatArgs {
+				t, formatElements, err = in.inferFormatArgs(p.Value, env)
+			} else {
+				t, err = in.infer(p.Value, env)
+			}
+			if err != nil {
+				return nil, err
+			}
+			args[p.Key.Name] = t
+			argLocs[p.Key.Name] = p.loc
+		}
+		in.types[e.Arguments] = obj
+	}
+	switch f := prune(callee).(type) {
+	case *tvar:
+		ret := in.fresh()
+		params := make(map[string]monotype, len(args))
+		for k, t := range args {
+			params[k] = t
+		}
+		if err := in.bind(f, &tfunction{
+			params: params,
+			ret:    ret,
+			mode:   inferredParams,
+		}); err != nil {
+			return nil, in.errorf(e.loc, "%v", err)
+		}
+		return ret, nil
+	case *tfunction:
+		for _, k := range sortedKeys(args) {
+			loc := argLocs[k]
+			if loc == nil {
+				loc = e.loc
+			}
+			p, ok := f.params[k]
+			if !ok {
+				switch f.mode {
+				case exactParams:
+					return nil, in.errorf(loc, "function %s has no parameter %q", f, k)
+				case inferredParams:
+					f.params[k] = args[k]
+				}
+				continue
+			}
+			if err := in.unify(p, args[k]); err != nil {
+				return nil, in.errorf(loc, "argument %q: %v", k, err)
+			}
+		}
+		if f.mode == exactParams {
+			for _, k := range sortedKeys(f.params) {
+				if _, ok := args[k]; !ok && !f.optional[k] {
+					return nil, in.errorf(e.loc, "missing required argument %q", k)
+				}
+			}
+		}
+		if f.formatArgs != "" {
+			if err := in.checkFormat(e, f, args, formatElements); err != nil {
+				return nil, err
+			}
+		}
+		return f.ret, in.solvePending()
+	default:
+		return nil, in.errorf(e.loc, "cannot call value of type %s", callee)
+	}
+}
+
+// inferFormatArgs infers the type of the array of arguments of a format.
+// The elements of an array literal may have different types, their types are returned to be checked against the verbs of the format.
+func (in *inferrer) inferFormatArgs(e Expression, env *typeEnv) (monotype, []monotype, error) {
+	a, ok := e.(*ArrayExpression)
+	if !ok {
+		t, err := in.infer(e, env)
+		return t, nil, err
+	}
+	elements := make([]monotype, len(a.Elements))
+	for i, el := range a.Elements {
+		t, err := in.infer(el, env)
+		if err != nil {
+			return nil, nil, err
+		}
+		elements[i] = t
+	}
+	t := &tarray{element: in.fresh()}
+	in.types[a] = t
+	return t, elements, nil
+}
+
+// checkFormat constrains the types of the format arguments of the call to the kinds its verbs can format.
+// Only formats given as string literals can be checked.
+// When the arguments are not an array literal each verb must be able to format the element type of the array.
+func (in *inferrer) checkFormat(e *CallExpression, f *tfunction, args map[string]monotype, elements []monotype) error {
+	var format *StringLiteral
+	loc := e.loc
+	for _, p := range e.Arguments.Properties {
+		if p.Key.Name == f.formatParam {
+			format, _ = p.Value.(*StringLiteral)
+			if p.loc != nil {
+				loc = p.loc
+			}
+		}
+	}
+	if format == nil {
+		return nil
+	}
+	verbs, err := parseFormat(format.Value)
+	if err != nil {
+		return in.errorf(loc, "%v", err)
+	}
+	if elements == nil {
+		a, ok := args[f.formatArgs]
+		if !ok {
+			return nil
+		}
+		elem := in.fresh()
+		if err := in.unify(&tarray{element: elem}, a); err != nil {
+			return in.errorf(loc, "argument %q: %v", f.formatArgs, err)
+		}
+		for range verbs {
+			elements = append(elements, elem)
+		}
+	} else if len(verbs) != len(elements) {
+		return in.errorf(loc, "format %q expects %d arguments, got %d", format.Value, len(verbs), len(elements))
+	}
+	for i, v := range verbs {
+		if len(v.kinds) == 0 {
+			continue
+		}
+		if err := in.constrain(&kindConstraint{
+			t:      elements[i],
+			kinds:  v.kinds,
+			format: fmt.Sprintf("verb %%%%%c cannot format argument %d of type %%s", v.verb, i),
+			loc:    loc,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]monotype) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// typeNamer names free type variables in the order they are found, so that formatted types are stable.
+type typeNamer struct {
+	names map[*tvar]string
+}
+
+func newTypeNamer() *typeNamer {
+	return &typeNamer{names: make(map[*tvar]string)}
+}
+
+func (n *typeNamer) name(v *tvar) string {
+	name, ok := n.names[v]
+	if !ok {
+		i := len(n.names)
+		name = string('A' + rune(i%26))
+		if i >= 26 {
+			name += fmt.Sprint(i / 26)
+		}
+		n.names[v] = name
+	}
+	return name
+}
+
+// formatType formats the type for error messages.
+func formatType(t monotype, n *typeNamer) string {
+	switch t := prune(t).(type) {
+	case *tvar:
+		return n.name(t)
+	case *tarray:
+		return "[" + formatType(t.element, n) + "]"
+	case *tobject:
+		props, rest := flattenObject(t)
+		var buf bytes.Buffer
+		buf.WriteRune('{')
+		for i, k := range sortedKeys(props) {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			fmt.Fprintf(&buf, "%s: %s", k, formatType(props[k], n))
+		}
+		if rest != nil {
+			if len(props) > 0 {
+				buf.WriteString(", ")
+			}
+			buf.WriteString("...")
+		}
+		buf.WriteRune('}')
+		return buf.String()
+	case *tfunction:
+		params := make([]string, 0, len(t.params))
+		for _, k := range sortedKeys(t.params) {
+			params = append(params, k+": "+formatType(t.params[k], n))
+		}
+		return "(" + strings.Join(params, ", ") + ") -> " + formatType(t.ret, n)
+	default:
+		return t.String()
+	}
+}
+
+// toType converts the monotype into a Type, free type variables become named type variables.
+func toType(t monotype, n *typeNamer) Type {
+	switch t := prune(t).(type) {
+	case *tvar:
+		return NewTypeVar(n.name(t))
+	case *tarray:
+		return NewArrayType(toType(t.element, n))
+	case *tobject:
+		props, _ := flattenObject(t)
+		types := make(map[string]Type, len(props))
+		for _, k := range sortedKeys(props) {
+			types[k] = toType(props[k], n)
+		}
+		return NewObjectType(types)
+	case *tfunction:
+		sig := FunctionSignature{
+			Params:       make(map[string]Type, len(t.params)),
+			PipeArgument: t.pipe,
+			FormatParam:  t.formatParam,
+			FormatArgs:   t.formatArgs,
+		}
+		for _, k := range sortedKeys(t.params) {
+			sig.Params[k] = toType(t.params[k], n)
+		}
+		sig.ReturnType = toType(t.ret, n)
+		return NewFunctionType(sig)
+	case Kind:
+		return t
+	default:
+		return Invalid
+	}
+}
@@ -0,0 +1,324 @@
+package semantic_test
+
+import (
+	"testing"
+
+	"github.com/influxdata/ifql/parser"
+	"github.com/influxdata/ifql/semantic"
+)
+
+func TestInferTypes(t *testing.T) {
+	declarations := semantic.DeclarationScope{
+		"string": semantic.NewExternalVariableDeclaration("string", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     map[string]semantic.Type{"v": semantic.NewTypeVar("T")},
+			ReturnType: semantic.String,
+		})),
+		"first": semantic.NewExternalVariableDeclaration("first", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     map[string]semantic.Type{"arr": semantic.NewArrayType(semantic.NewTypeVar("T"))},
+			ReturnType: semantic.NewTypeVar("T"),
+		})),
+		"sprintf": semantic.NewExternalVariableDeclaration("sprintf", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:      map[string]semantic.Type{"format": semantic.String, "args": semantic.Array},
+			ReturnType:  semantic.String,
+			FormatParam: "format",
+			FormatArgs:  "args",
+		})),
+		"abs": semantic.NewExternalVariableDeclaration("abs", semantic.NewFunctionType(semantic.FunctionSignature{
+			Params:     map[string]semantic.Type{"x": semantic.NewConstrainedTypeVar("N", semantic.Int, semantic.Float)},
+			ReturnType: semantic.NewConstrainedTypeVar("N", semantic.Int, semantic.Float),
+		})),
+	}
+	a, b := semantic.NewTypeVar("A"), semantic.NewTypeVar("B")
+	testCases := []struct {
+		name    string
+		program string
+		want    map[string]semantic.Type
+		wantErr string
+	}{
+		{
+			name: "literals",
+			program: `
+			i = 1
+			s = "a ${i}"
+			d = 1h
+			r = /a/
+			arr = [1.0, 2.0]
+			o = {a: 1, b: "b"}`,
+			want: map[string]semantic.Type{
+				"i":   semantic.Int,
+				"s":   semantic.String,
+				"d":   semantic.Duration,
+				"r":   semantic.Regexp,
+				"arr": semantic.NewArrayType(semantic.Float),
+				"o": semantic.NewObjectType(map[string]semantic.Type{
+					"a": semantic.Int,
+					"b": semantic.String,
+				}),
+			},
+		},
+		{
+			name: "polymorphic function",
+			program: `
+			identity = (v) => v
+			i = identity(v: 1)
+			s = identity(v: "a")`,
+			want: map[string]semantic.Type{
+				"identity": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params:     map[string]semantic.Type{"v": a},
+					ReturnType: a,
+				}),
+				"i": semantic.Int,
+				"s": semantic.String,
+			},
+		},
+		{
+			name: "overloaded operator",
+			program: `
+			add = (a, b) => a + b
+			i = add(a: 1, b: 2)
+			f = add(a: 1.0, b: 2.0)`,
+			want: map[string]semantic.Type{
+				"add": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params:     map[string]semantic.Type{"a": a, "b": a},
+					ReturnType: b,
+				}),
+				"i": semantic.Int,
+				"f": semantic.Float,
+			},
+		},
+		{
+			name: "record parameter",
+			program: `
+			f = (r) => r._value > 1 and r.host =~ /a/
+			g = (r) => r._value + 1`,
+			want: map[string]semantic.Type{
+				"f": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{
+						"r": semantic.NewObjectType(map[string]semantic.Type{
+							"_value": a,
+							"host":   semantic.String,
+						}),
+					},
+					ReturnType: semantic.Bool,
+				}),
+				"g": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params: map[string]semantic.Type{
+						"r": semantic.NewObjectType(map[string]semantic.Type{
+							"_value": semantic.Int,
+						}),
+					},
+					ReturnType: semantic.Int,
+				}),
+			},
+		},
+		{
+			name: "higher order function",
+			program: `
+			apply = (f, v) => f(x: v)
+			n = apply(f: (x) => x * 2, v: 3)`,
+			want: map[string]semantic.Type{
+				"n": semantic.Int,
+			},
+		},
+		{
+			name: "polymorphic signature",
+			program: `
+			s = string(v: 1.5)
+			f = first(arr: [1, 2])`,
+			want: map[string]semantic.Type{
+				"s": semantic.String,
+				"f": semantic.Int,
+			},
+		},
+		{
+			name: "block body",
+			program: `
+			f = (a) => {
+				b = a * 2.0
+				return b > 1.0
+			}`,
+			want: map[string]semantic.Type{
+				"f": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params:     map[string]semantic.Type{"a": semantic.Float},
+					ReturnType: semantic.Bool,
+				}),
+			},
+		},
+		{
+			name:    "invalid operands",
+			program: `x = 1 + "a"`,
+			wantErr: `type error 1:5-1:12: operator + is not defined for int and string`,
+		},
+		{
+			name: "invalid argument",
+			program: `f = (r) => r.a + 1
+f(r: {a: "x"})`,
+			wantErr: `type error 2:3-2:14: argument "r": property "a": expected int but found string`,
+		},
+		{
+			name: "invalid argument of polymorphic function",
+			program: `add = (a, b) => a + b
+add(a: 1, b: "b")`,
+			wantErr: `type error 2:11-2:17: argument "b": expected int but found string`,
+		},
+		{
+			name: "unknown argument",
+			program: `f = (a) => a
+f(b: 1)`,
+			wantErr: `type error 2:3-2:7: function (a: A) -> A has no parameter "b"`,
+		},
+		{
+			name: "missing argument",
+			program: `f = (a, b) => a
+f(a: 1)`,
+			wantErr: `type error 2:1-2:8: missing required argument "b"`,
+		},
+		{
+			name: "call non function",
+			program: `x = 1
+x()`,
+			wantErr: `type error 2:1-2:4: cannot call value of type int`,
+		},
+		{
+			name: "call non function argument",
+			program: `f = (g) => g()
+f(g: 1)`,
+			wantErr: `type error 2:3-2:7: argument "g": expected () -> A but found int`,
+		},
+		{
+			name: "missing property",
+			program: `o = {a: 1}
+o.b`,
+			wantErr: `type error 2:1-2:4: object {a: int} has no property "b"`,
+		},
+		{
+			name:    "non boolean test",
+			program: `if 1 then 2 else 3`,
+			wantErr: `type error 1:1-1:19: test of conditional expression: expected bool but found int`,
+		},
+		{
+			name:    "mixed array",
+			program: `[1, "a"]`,
+			wantErr: `type error 1:2-1:8: array element 1: expected int but found string`,
+		},
+		{
+			name: "logical operand",
+			program: `f = (r) => r.a and true
+f(r: {a: 1})`,
+			wantErr: `type error 2:3-2:12: argument "r": property "a": expected bool but found int`,
+		},
+		{
+			name:    "negate string",
+			program: `-"a"`,
+			wantErr: `type error 1:1-1:5: cannot negate value of type string`,
+		},
+		{
+			name: "interpolate array",
+			program: `f = (v) => "${v}"
+f(v: [1])`,
+			wantErr: `type error 1:12-1:18: cannot interpolate value of type [int] into a string`,
+		},
+		{
+			name: "format arguments",
+			program: `
+			s = sprintf(format: "%s=%.2f%%", args: ["cpu", 1.5])
+			f = (r) => sprintf(format: "%d %v", args: [r.a, r.b])
+			t = f(r: {a: 1, b: [true]})`,
+			want: map[string]semantic.Type{
+				"s": semantic.String,
+				"t": semantic.String,
+			},
+		},
+		{
+			name:    "format verb of wrong type",
+			program: `sprintf(format: "%s=%d", args: ["cpu", 1.5])`,
+			wantErr: `type error 1:9-1:24: verb %d cannot format argument 1 of type float`,
+		},
+		{
+			name: "format verb of wrong type in function",
+			program: `f = (r) => sprintf(format: "%d", args: [r.a])
+f(r: {a: "a"})`,
+			wantErr: `type error 1:20-1:32: verb %d cannot format argument 0 of type string`,
+		},
+		{
+			name:    "format argument count",
+			program: `sprintf(format: "%s %s", args: ["a"])`,
+			wantErr: `type error 1:9-1:24: format "%s %s" expects 2 arguments, got 1`,
+		},
+		{
+			name:    "format unknown verb",
+			program: `sprintf(format: "%y", args: [1])`,
+			wantErr: `type error 1:9-1:21: format "%y" has unknown verb %y`,
+		},
+		{
+			name: "format array variable",
+			program: `a = [1, 2]
+sprintf(format: "%d %s", args: a)`,
+			wantErr: `type error 2:9-2:24: verb %s cannot format argument 1 of type int`,
+		},
+		{
+			name: "constrained type variable",
+			program: `
+			i = abs(x: -1)
+			f = abs(x: 1.5)
+			g = (v) => abs(x: v) * 2.0`,
+			want: map[string]semantic.Type{
+				"i": semantic.Int,
+				"f": semantic.Float,
+				"g": semantic.NewFunctionType(semantic.FunctionSignature{
+					Params:     map[string]semantic.Type{"v": semantic.Float},
+					ReturnType: semantic.Float,
+				}),
+			},
+		},
+		{
+			name:    "constrained type variable of wrong kind",
+			program: `abs(x: "a")`,
+			wantErr: `type error 1:1-1:4: expected one of [int float], found string`,
+		},
+		{
+			name:    "in array of different type",
+			program: `1 in ["a"]`,
+			wantErr: `type error 1:1-1:11: operator in is not defined for int and string`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			program, err := parser.NewAST(tc.program)
+			if err != nil {
+				t.Fatal(err)
+			}
+			graph, err := semantic.New(program, declarations.Copy())
+			if err != nil {
+				if tc.wantErr == "" {
+					t.Fatal(err)
+				}
+				if got := err.Error(); got != tc.wantErr {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, got)
+				}
+				return
+			} else if tc.wantErr != "" {
+				t.Fatalf("expected error %q", tc.wantErr)
+			}
+
+			solution, err := semantic.InferTypes(graph, declarations)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range graph.Body {
+				decl, ok := s.(*semantic.NativeVariableDeclaration)
+				if !ok {
+					continue
+				}
+				want, ok := tc.want[decl.Identifier.Name]
+				if !ok {
+					continue
+				}
+				if got := solution.TypeOf(decl.Init); got != want {
+					t.Errorf("unexpected type of %q -want/+got:\n\t- %v\n\t+ %v", decl.Identifier.Name, want, got)
+				}
+			}
+		})
+	}
+}
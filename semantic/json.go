@@ -108,6 +108,17 @@ func (s *ExpressionStatement) UnmarshalJSON(data []byte) error {
 	s.Expression = e
 	return nil
 }
+func (s *OptionStatement) MarshalJSON() ([]byte, error) {
+	type Alias OptionStatement
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  s.NodeType(),
+		Alias: (*Alias)(s),
+	}
+	return json.Marshal(raw)
+}
 func (s *ReturnStatement) MarshalJSON() ([]byte, error) {
 	type Alias ReturnStatement
 	raw := struct {
@@ -369,6 +380,86 @@ func (e *UnaryExpression) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+func (e *StringExpression) MarshalJSON() ([]byte, error) {
+	type Alias StringExpression
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  e.NodeType(),
+		Alias: (*Alias)(e),
+	}
+	return json.Marshal(raw)
+}
+func (e *StringExpression) UnmarshalJSON(data []byte) error {
+	type Alias StringExpression
+	raw := struct {
+		*Alias
+		Parts []json.RawMessage `json:"parts"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*e = *(*StringExpression)(raw.Alias)
+	}
+
+	e.Parts = make([]StringExpressionPart, len(raw.Parts))
+	for i, r := range raw.Parts {
+		n, err := unmarshalNode(r)
+		if err != nil {
+			return err
+		}
+		p, ok := n.(StringExpressionPart)
+		if !ok {
+			return fmt.Errorf("node %q is not a string expression part", n.NodeType())
+		}
+		e.Parts[i] = p
+	}
+	return nil
+}
+func (p *TextPart) MarshalJSON() ([]byte, error) {
+	type Alias TextPart
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  p.NodeType(),
+		Alias: (*Alias)(p),
+	}
+	return json.Marshal(raw)
+}
+func (p *InterpolatedPart) MarshalJSON() ([]byte, error) {
+	type Alias InterpolatedPart
+	raw := struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Type:  p.NodeType(),
+		Alias: (*Alias)(p),
+	}
+	return json.Marshal(raw)
+}
+func (p *InterpolatedPart) UnmarshalJSON(data []byte) error {
+	type Alias InterpolatedPart
+	raw := struct {
+		*Alias
+		Expression json.RawMessage `json:"expression"`
+	}{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.Alias != nil {
+		*p = *(*InterpolatedPart)(raw.Alias)
+	}
+
+	e, err := unmarshalExpression(raw.Expression)
+	if err != nil {
+		return err
+	}
+	p.Expression = e
+	return nil
+}
 func (e *LogicalExpression) MarshalJSON() ([]byte, error) {
 	type Alias LogicalExpression
 	raw := struct {
@@ -808,6 +899,8 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(BlockStatement)
 	case "ExpressionStatement":
 		node = new(ExpressionStatement)
+	case "OptionStatement":
+		node = new(OptionStatement)
 	case "ReturnStatement":
 		node = new(ReturnStatement)
 	case "NativeVariableDeclaration":
@@ -828,6 +921,12 @@ func unmarshalNode(msg json.RawMessage) (Node, error) {
 		node = new(ConditionalExpression)
 	case "ArrayExpression":
 		node = new(ArrayExpression)
+	case "StringExpression":
+		node = new(StringExpression)
+	case "TextPart":
+		node = new(TextPart)
+	case "InterpolatedPart":
+		node = new(InterpolatedPart)
 	case "Identifier":
 		node = new(Identifier)
 	case "IdentifierExpression":
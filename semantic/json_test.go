@@ -120,6 +120,16 @@ func TestJSONMarshal(t *testing.T) {
 			},
 			want: `{"type":"ArrayExpression","elements":[{"type":"StringLiteral","value":"hello"}]}`,
 		},
+		{
+			name: "string expression",
+			node: &semantic.StringExpression{
+				Parts: []semantic.StringExpressionPart{
+					&semantic.TextPart{Value: "a is "},
+					&semantic.InterpolatedPart{Expression: &semantic.StringLiteral{Value: "hello"}},
+				},
+			},
+			want: `{"type":"StringExpression","parts":[{"type":"TextPart","value":"a is "},{"type":"InterpolatedPart","expression":{"type":"StringLiteral","value":"hello"}}]}`,
+		},
 		{
 			name: "object expression",
 			node: &semantic.ObjectExpression{
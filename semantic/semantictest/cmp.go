@@ -14,5 +14,13 @@ var CmpOptions = []cmp.Option{
 	cmpopts.IgnoreUnexported(semantic.FunctionExpression{}),
 	cmpopts.IgnoreUnexported(semantic.IdentifierExpression{}),
 	cmpopts.IgnoreUnexported(semantic.FunctionParam{}),
+	cmpopts.IgnoreUnexported(semantic.BinaryExpression{}),
+	cmpopts.IgnoreUnexported(semantic.CallExpression{}),
+	cmpopts.IgnoreUnexported(semantic.ConditionalExpression{}),
+	cmpopts.IgnoreUnexported(semantic.LogicalExpression{}),
+	cmpopts.IgnoreUnexported(semantic.MemberExpression{}),
+	cmpopts.IgnoreUnexported(semantic.StringExpression{}),
+	cmpopts.IgnoreUnexported(semantic.UnaryExpression{}),
+	cmpopts.IgnoreUnexported(semantic.Property{}),
 	cmp.Comparer(func(x, y *regexp.Regexp) bool { return x.String() == y.String() }),
 }
@@ -61,6 +61,7 @@ const (
 	Array
 	Object
 	Function
+	TypeVar
 )
 
 var kindNames = []string{
@@ -77,6 +78,7 @@ var kindNames = []string{
 	Array:    "array",
 	Object:   "object",
 	Function: "function",
+	TypeVar:  "typevar",
 }
 
 func (k Kind) String() string {
@@ -86,6 +88,17 @@ func (k Kind) String() string {
 	return "kind" + strconv.Itoa(int(k))
 }
 
+// IsStringConvertible reports whether values of the kind can be converted to a string,
+// either with the string function or by string interpolation.
+func IsStringConvertible(k Kind) bool {
+	switch k {
+	case String, Int, UInt, Float, Bool, Time, Duration:
+		return true
+	default:
+		return false
+	}
+}
+
 func (k Kind) Kind() Kind {
 	return k
 }
@@ -106,6 +119,100 @@ func (k Kind) ReturnType() Type {
 }
 func (k Kind) typ() {}
 
+type typeVar struct {
+	name string
+	// kinds are the kinds the type variable may be, any kind if empty.
+	kinds []Kind
+}
+
+func (t *typeVar) String() string {
+	return t.name
+}
+
+func (t *typeVar) Kind() Kind {
+	return TypeVar
+}
+func (t *typeVar) PropertyType(name string) Type {
+	panic(fmt.Errorf("cannot get property type of kind %s", t.Kind()))
+}
+func (t *typeVar) Properties() map[string]Type {
+	panic(fmt.Errorf("cannot get properties type of kind %s", t.Kind()))
+}
+func (t *typeVar) ElementType() Type {
+	panic(fmt.Errorf("cannot get element type of kind %s", t.Kind()))
+}
+func (t *typeVar) PipeArgument() string {
+	panic(fmt.Errorf("cannot get pipe argument name from kind %s", t.Kind()))
+}
+func (t *typeVar) ReturnType() Type {
+	panic(fmt.Errorf("cannot get return type of kind %s", t.Kind()))
+}
+
+func (t *typeVar) typ() {}
+
+// typeVarCache caches *typeVar values keyed by their name.
+var typeVarCache struct {
+	sync.Mutex // Guards stores (but not loads) on m.
+
+	// m is a map[string]*typeVar keyed by the name of the type variable and its kinds.
+	// Elements in m are append-only and thus safe for concurrent reading.
+	m sync.Map
+}
+
+// NewTypeVar returns the type variable with the given name.
+// Type variables describe polymorphic function signatures,
+// each occurrence of the same type variable within a signature must be the same type,
+// while each call to the function may use a different type.
+func NewTypeVar(name string) Type {
+	if t, ok := typeVarCache.m.Load(name); ok {
+		return t.(*typeVar)
+	}
+
+	typeVarCache.Lock()
+	defer typeVarCache.Unlock()
+
+	if t, ok := typeVarCache.m.Load(name); ok {
+		return t.(*typeVar)
+	}
+	tv := &typeVar{
+		name: name,
+	}
+	typeVarCache.m.Store(name, tv)
+
+	return tv
+}
+
+// NewConstrainedTypeVar returns the type variable with the given name that may only be one of the kinds.
+func NewConstrainedTypeVar(name string, kinds ...Kind) Type {
+	key := fmt.Sprintf("%s%v", name, kinds)
+	if t, ok := typeVarCache.m.Load(key); ok {
+		return t.(*typeVar)
+	}
+
+	typeVarCache.Lock()
+	defer typeVarCache.Unlock()
+
+	if t, ok := typeVarCache.m.Load(key); ok {
+		return t.(*typeVar)
+	}
+	tv := &typeVar{
+		name:  name,
+		kinds: kinds,
+	}
+	typeVarCache.m.Store(key, tv)
+
+	return tv
+}
+
+// TypeVarKinds returns the kinds a constrained type variable may be,
+// it returns nil if t is not a type variable or may be of any kind.
+func TypeVarKinds(t Type) []Kind {
+	if tv, ok := t.(*typeVar); ok {
+		return tv.kinds
+	}
+	return nil
+}
+
 type arrayType struct {
 	elementType Type
 }
@@ -325,6 +432,8 @@ type functionType struct {
 	params       map[string]Type
 	returnType   Type
 	pipeArgument string
+	formatParam  string
+	formatArgs   string
 }
 
 func (t *functionType) String() string {
@@ -371,6 +480,10 @@ func (t *functionType) equal(o *functionType) bool {
 		return false
 	}
 
+	if t.formatParam != o.formatParam || t.formatArgs != o.formatArgs {
+		return false
+	}
+
 	if len(t.params) != len(o.params) {
 		return false
 	}
@@ -428,6 +541,93 @@ type FunctionSignature struct {
 	Params       map[string]Type
 	ReturnType   Type
 	PipeArgument string
+	// FormatParam is the name of a string parameter holding a printf style format,
+	// used to format the elements of the array parameter FormatArgs.
+	// The elements of an array literal passed as FormatArgs may have different types,
+	// each is checked against its verb in the format.
+	FormatParam string
+	FormatArgs  string
+}
+
+// FormatParams reports the names of the format parameter and of the array parameter it formats of a function type.
+// Both are empty if the function does not format its arguments.
+func FormatParams(t Type) (format, args string) {
+	if ft, ok := t.(*functionType); ok {
+		return ft.formatParam, ft.formatArgs
+	}
+	return "", ""
+}
+
+// CallReturnType returns the return type of a call to a function of type t with arguments of the given types.
+// The type variables of the signature are bound to the types of the arguments,
+// an error is returned if an argument is not of a kind allowed by its type variable.
+// Null arguments are allowed, a type variable bound only to null is null.
+func CallReturnType(t Type, args map[string]Type) (Type, error) {
+	ft, ok := t.(*functionType)
+	if !ok {
+		return nil, fmt.Errorf("cannot call value of type %v", t)
+	}
+	names := make([]string, 0, len(args))
+	for k := range args {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	vars := make(map[*typeVar]Type)
+	for _, k := range names {
+		p, ok := ft.params[k]
+		if !ok {
+			continue
+		}
+		if err := bindTypeVars(p, args[k], vars); err != nil {
+			return nil, fmt.Errorf("argument %q: %v", k, err)
+		}
+	}
+	return substituteTypeVars(ft.returnType, vars), nil
+}
+
+func bindTypeVars(p, a Type, vars map[*typeVar]Type) error {
+	switch p := p.(type) {
+	case *typeVar:
+		if a.Kind() == Nil {
+			if _, ok := vars[p]; !ok {
+				vars[p] = Nil
+			}
+			return nil
+		}
+		if len(p.kinds) > 0 && !containsKind(p.kinds, a.Kind()) {
+			return fmt.Errorf("expected one of %v, found %v", p.kinds, a)
+		}
+		if b, ok := vars[p]; ok && b != Nil && b != a {
+			return fmt.Errorf("type variable %v cannot be both %v and %v", p, b, a)
+		}
+		vars[p] = a
+	case *arrayType:
+		if a, ok := a.(*arrayType); ok {
+			return bindTypeVars(p.elementType, a.elementType, vars)
+		}
+	}
+	return nil
+}
+
+func substituteTypeVars(t Type, vars map[*typeVar]Type) Type {
+	switch t := t.(type) {
+	case *typeVar:
+		if b, ok := vars[t]; ok {
+			return b
+		}
+	case *arrayType:
+		return NewArrayType(substituteTypeVars(t.elementType, vars))
+	}
+	return t
+}
+
+func containsKind(kinds []Kind, k Kind) bool {
+	for _, kind := range kinds {
+		if kind == k {
+			return true
+		}
+	}
+	return false
 }
 
 func NewFunctionType(sig FunctionSignature) Type {
@@ -439,6 +639,8 @@ func NewFunctionType(sig FunctionSignature) Type {
 
 	sum := fnv.New32a()
 	sum.Write([]byte(sig.PipeArgument))
+	sum.Write([]byte(sig.FormatParam))
+	sum.Write([]byte(sig.FormatArgs))
 	for _, p := range paramNames {
 		// track hash of parameter names and kinds
 		sum.Write([]byte(p))
@@ -451,6 +653,8 @@ func NewFunctionType(sig FunctionSignature) Type {
 		params:       sig.Params,
 		returnType:   sig.ReturnType,
 		pipeArgument: sig.PipeArgument,
+		formatParam:  sig.FormatParam,
+		formatArgs:   sig.FormatArgs,
 	}
 
 	// Simple linear search after hash lookup
@@ -216,3 +216,82 @@ func TestTypes_Comparable(t *testing.T) {
 		})
 	}
 }
+
+func TestCallReturnType(t *testing.T) {
+	n := semantic.NewConstrainedTypeVar("N", semantic.Int, semantic.Float)
+	ft := semantic.NewFunctionType(semantic.FunctionSignature{
+		Params: map[string]semantic.Type{
+			"x":   n,
+			"y":   n,
+			"arr": semantic.NewArrayType(semantic.NewTypeVar("T")),
+		},
+		ReturnType: n,
+	})
+	first := semantic.NewFunctionType(semantic.FunctionSignature{
+		Params:     map[string]semantic.Type{"arr": semantic.NewArrayType(semantic.NewTypeVar("T"))},
+		ReturnType: semantic.NewTypeVar("T"),
+	})
+	testCases := []struct {
+		name    string
+		t       semantic.Type
+		args    map[string]semantic.Type
+		want    semantic.Type
+		wantErr string
+	}{
+		{
+			name: "int",
+			t:    ft,
+			args: map[string]semantic.Type{"x": semantic.Int, "y": semantic.Int},
+			want: semantic.Int,
+		},
+		{
+			name: "null and float",
+			t:    ft,
+			args: map[string]semantic.Type{"x": semantic.Nil, "y": semantic.Float},
+			want: semantic.Float,
+		},
+		{
+			name: "array element",
+			t:    first,
+			args: map[string]semantic.Type{"arr": semantic.NewArrayType(semantic.String)},
+			want: semantic.String,
+		},
+		{
+			name:    "kind not allowed",
+			t:       ft,
+			args:    map[string]semantic.Type{"x": semantic.String},
+			wantErr: `argument "x": expected one of [int float], found string`,
+		},
+		{
+			name:    "different types",
+			t:       ft,
+			args:    map[string]semantic.Type{"x": semantic.Int, "y": semantic.Float},
+			wantErr: `argument "y": type variable N cannot be both int and float`,
+		},
+		{
+			name:    "not a function",
+			t:       semantic.Int,
+			wantErr: `cannot call value of type int`,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := semantic.CallReturnType(tc.t, tc.args)
+			if err != nil {
+				if tc.wantErr == "" {
+					t.Fatal(err)
+				}
+				if got := err.Error(); got != tc.wantErr {
+					t.Fatalf("unexpected error -want/+got:\n\t- %s\n\t+ %s", tc.wantErr, got)
+				}
+				return
+			} else if tc.wantErr != "" {
+				t.Fatalf("expected error %q", tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("unexpected return type: want: %v got: %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -31,6 +31,11 @@ func walk(v Visitor, n Node) {
 		if w != nil {
 			walk(w, n.Expression)
 		}
+	case *OptionStatement:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Declaration)
+		}
 	case *ReturnStatement:
 		w := v.Visit(n)
 		if w != nil {
@@ -108,6 +113,20 @@ func walk(v Visitor, n Node) {
 				walk(w, p)
 			}
 		}
+	case *StringExpression:
+		w := v.Visit(n)
+		if w != nil {
+			for _, p := range n.Parts {
+				walk(w, p)
+			}
+		}
+	case *TextPart:
+		v.Visit(n)
+	case *InterpolatedPart:
+		w := v.Visit(n)
+		if w != nil {
+			walk(w, n.Expression)
+		}
 	case *UnaryExpression:
 		w := v.Visit(n)
 		if w != nil {
@@ -451,3 +451,31 @@ var binaryFuncLookup = map[BinaryFuncSignature]BinaryFunction{
 		return NewStringValue(l + r)
 	},
 }
+
+func init() {
+	// The in operator is defined for any pair of types that can be compared with the equal operator,
+	// where the right hand side is an array of the second type.
+	in := make(map[BinaryFuncSignature]BinaryFunction)
+	for sig, eq := range binaryFuncLookup {
+		if sig.Operator != ast.EqualOperator {
+			continue
+		}
+		eq := eq
+		in[BinaryFuncSignature{
+			Operator: ast.InOperator,
+			Left:     sig.Left,
+			Right:    semantic.NewArrayType(sig.Right),
+		}] = func(lv, rv Value) Value {
+			arr := rv.Array()
+			for i, l := 0, arr.Len(); i < l; i++ {
+				if eq(lv, arr.Get(i)).Bool() {
+					return NewBoolValue(true)
+				}
+			}
+			return NewBoolValue(false)
+		}
+	}
+	for sig, f := range in {
+		binaryFuncLookup[sig] = f
+	}
+}
@@ -0,0 +1,69 @@
+package values
+
+import (
+	"regexp"
+
+	"github.com/influxdata/ifql/semantic"
+)
+
+// function is a function implemented in Go.
+type function struct {
+	name string
+	t    semantic.Type
+	call func(args Object) (Value, error)
+}
+
+// NewFunction returns a function implemented in Go with the given function type.
+// The function must not have side effects, so that it can be called from compiled row functions.
+func NewFunction(name string, t semantic.Type, call func(args Object) (Value, error)) Function {
+	return function{
+		name: name,
+		t:    t,
+		call: call,
+	}
+}
+
+func (f function) Type() semantic.Type {
+	return f.t
+}
+func (f function) Str() string {
+	panic(UnexpectedKind(semantic.Function, semantic.String))
+}
+func (f function) Int() int64 {
+	panic(UnexpectedKind(semantic.Function, semantic.Int))
+}
+func (f function) UInt() uint64 {
+	panic(UnexpectedKind(semantic.Function, semantic.UInt))
+}
+func (f function) Float() float64 {
+	panic(UnexpectedKind(semantic.Function, semantic.Float))
+}
+func (f function) Bool() bool {
+	panic(UnexpectedKind(semantic.Function, semantic.Bool))
+}
+func (f function) Time() Time {
+	panic(UnexpectedKind(semantic.Function, semantic.Time))
+}
+func (f function) Duration() Duration {
+	panic(UnexpectedKind(semantic.Function, semantic.Duration))
+}
+func (f function) Regexp() *regexp.Regexp {
+	panic(UnexpectedKind(semantic.Function, semantic.Regexp))
+}
+func (f function) Array() Array {
+	panic(UnexpectedKind(semantic.Function, semantic.Array))
+}
+func (f function) Object() Object {
+	panic(UnexpectedKind(semantic.Function, semantic.Object))
+}
+func (f function) Function() Function {
+	return f
+}
+
+func (f function) Call(args Object) (Value, error) {
+	return f.call(args)
+}
+
+func (f function) String() string {
+	return f.name
+}
@@ -3,6 +3,7 @@ package values
 import (
 	"fmt"
 	"regexp"
+	"strconv"
 
 	"github.com/influxdata/ifql/semantic"
 )
@@ -26,6 +27,29 @@ type Value interface {
 	Function() Function
 }
 
+// ToString formats a value as a string according to its literal representation.
+// Only values of kinds for which semantic.IsStringConvertible reports true can be formatted.
+func ToString(v Value) (string, error) {
+	switch k := v.Type().Kind(); k {
+	case semantic.String:
+		return v.Str(), nil
+	case semantic.Int:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case semantic.UInt:
+		return strconv.FormatUint(v.UInt(), 10), nil
+	case semantic.Float:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case semantic.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case semantic.Time:
+		return v.Time().String(), nil
+	case semantic.Duration:
+		return v.Duration().String(), nil
+	default:
+		return "", fmt.Errorf("cannot convert %v to string", v.Type())
+	}
+}
+
 // Function represents a callable type
 type Function interface {
 	Value
@@ -88,6 +112,14 @@ func (v value) Function() Function {
 // InvalidValue is a non nil value who's type is semantic.Invalid
 var InvalidValue = value{t: semantic.Invalid}
 
+// Null is the value of a missing or unknown value, its type is semantic.Nil.
+var Null = value{t: semantic.Nil}
+
+// IsNull reports whether v is a null or missing value.
+func IsNull(v Value) bool {
+	return v == nil || v.Type() == semantic.Nil
+}
+
 func NewStringValue(v string) Value {
 	return value{
 		t: semantic.String,